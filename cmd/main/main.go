@@ -1,15 +1,50 @@
 package main // Mendefinisikan package utama untuk aplikasi
 
 import ( // Mengimpor package yang dibutuhkan
-	"log"                                  // Package untuk logging
+	"context"                              // Package untuk context shutdown
+	"errors"                               // Package untuk pengecekan error
+	"log/slog"                             // Package structured logging bawaan Go
+	"net/http"                             // Package server HTTP
+	"os"                                   // Package untuk exit code
+	"os/signal"                            // Package untuk menangkap sinyal berhenti
+	"rest-api-go/internal/migration"       // Daftar model untuk pemeriksaan migrasi
+	"rest-api-go/internal/module/attribute" // Modul attribute dari aplikasi
+	auditModule "rest-api-go/internal/module/audit" // Modul audit dari aplikasi
+	"rest-api-go/internal/module/cart"     // Modul cart dari aplikasi
 	"rest-api-go/internal/module/category" // Modul category dari aplikasi
+	"rest-api-go/internal/module/event"    // Modul event dari aplikasi
+	"rest-api-go/internal/module/inventory" // Modul inventory dari aplikasi
+	"rest-api-go/internal/module/job"      // Modul job dari aplikasi
+	"rest-api-go/internal/module/order"    // Modul order dari aplikasi
 	"rest-api-go/internal/module/product"  // Modul product dari aplikasi
+	realtimeModule "rest-api-go/internal/module/realtime" // Modul realtime (WebSocket) dari aplikasi
+	realtimeService "rest-api-go/internal/module/realtime/service" // Aturan nama dan hak akses topik WebSocket
 	"rest-api-go/internal/module/user"     // Modul user dari aplikasi
+	"rest-api-go/internal/module/webhook"  // Modul webhook dari aplikasi
+	webhookService "rest-api-go/internal/module/webhook/service" // Pengaturan pengiriman webhook
+	"rest-api-go/pkg/audit"                // Package plugin audit log
+	"rest-api-go/pkg/auth"                 // Package token bearer
+	"rest-api-go/pkg/cache"                // Package cache hasil baca
 	"rest-api-go/pkg/config"               // Package konfigurasi
 	"rest-api-go/pkg/database"             // Package database
+	"rest-api-go/pkg/events"               // Package domain event dan outbox
+	"rest-api-go/pkg/health"               // Package health check
+	"rest-api-go/pkg/imaging"              // Package pemrosesan gambar
+	"rest-api-go/pkg/jobs"                 // Package job latar belakang
+	"rest-api-go/pkg/logger"               // Package logger
+	"rest-api-go/pkg/metrics"              // Package metrics Prometheus
 	"rest-api-go/pkg/middleware"           // Package middleware
+	"rest-api-go/pkg/money"                // Package tipe harga dengan mata uang
+	"rest-api-go/pkg/realtime"             // Package hub WebSocket
+	"rest-api-go/pkg/storage"              // Package penyimpanan file
+	"rest-api-go/pkg/tracing"              // Package tracing OpenTelemetry
+	"rest-api-go/pkg/validation"           // Package validator bersama
+	"rest-api-go/pkg/version"              // Package informasi build
+	"strings"                              // Package untuk memeriksa awalan URL
+	"syscall"                              // Package untuk konstanta sinyal
 
 	"github.com/gin-gonic/gin" // Framework web Gin
+	"go.opentelemetry.io/contrib/instrumentation/github.com/gin-gonic/gin/otelgin" // Middleware tracing untuk Gin
 )                                             
 
 func main() {                                 // Fungsi utama yang dijalankan saat program dimulai
@@ -17,24 +52,227 @@ func main() {                                 // Fungsi utama yang dijalankan sa
 	// Load config                            
 	cfg := config.LoadConfig()                // Memuat konfigurasi aplikasi
 
+	// Setup logger
+	log := logger.New(cfg)                    // Membuat logger slog sesuai LOG_LEVEL dan LOG_FORMAT
+	slog.SetDefault(log)                      // Menjadikan logger ini default untuk seluruh aplikasi
+
+	// Setup validator
+	validation.Register()                     // Gin dan utils.BindJSON memakai validator bersama dengan pesan terjemahan
+	money.DefaultCurrency = cfg.DefaultCurrency  // Mata uang untuk harga yang dikirim tanpa currency
+
+	// Setup authentication
+	if cfg.AuthSecret == "" {
+		log.Warn("AUTH_SECRET is not set, tokens will be invalid after restart")
+	}
+	tokens := auth.NewManager(cfg.AuthSecret, cfg.AuthTokenTTL)  // Membuat dan memeriksa token bearer
+	auth.SetDefault(tokens)                   // Dipakai endpoint login di modul user
+
+	// Setup tracing
+	shutdownTracing, err := tracing.Setup(context.Background(), cfg)  // Memasang TracerProvider sesuai TRACING_EXPORTER
+	if err != nil {
+		log.Error("failed to setup tracing", "error", err)
+		os.Exit(1)
+	}
+	defer shutdownTracing(context.Background()) // Mengirim span yang tersisa saat aplikasi berhenti
+
 	// Connect to database                    
 	db := database.Connect()                  // Menghubungkan ke database
+	if err := db.Use(metrics.NewGormPlugin()); err != nil {  // Memasang plugin metric durasi dan error query
+		log.Error("failed to register GORM metrics plugin", "error", err)
+		os.Exit(1)
+	}
+	if err := db.Use(tracing.NewGormPlugin("mysql", cfg.DBName)); err != nil {  // Memasang plugin span untuk setiap query
+		log.Error("failed to register GORM tracing plugin", "error", err)
+		os.Exit(1)
+	}
+	if err := db.Use(audit.NewGormPlugin(map[string]string{  // Mencatat setiap create, update, dan delete pada tabel ini di audit_logs
+		"products":   "product",
+		"categories": "category",
+		"users":      "user",
+	})); err != nil {
+		log.Error("failed to register GORM audit plugin", "error", err)
+		os.Exit(1)
+	}
+	var responseCache *cache.Cache            // nil berarti service selalu membaca dari database
+	switch cfg.CacheDriver {
+	case "memory":
+		responseCache = cache.New(cache.NewLRU(int(cfg.CacheMaxEntries)), cache.Options{TTL: cfg.CacheTTL})
+	case "none":
+	default:
+		log.Error("unknown CACHE_DRIVER (use memory or none)", "driver", cfg.CacheDriver)
+		os.Exit(1)
+	}
+	if err := db.Use(cache.NewGormPlugin(responseCache, map[string][]string{  // Perubahan tabel ini menginvalidasi namespace cache; category memuat product
+		"products":         {"products", "categories"},
+		"product_images":   {"products"},
+		"product_variants": {"products"},
+		"variant_values":   {"products"},
+		"attributes":       {"products"},
+		"categories":       {"categories", "products"},  // Product menyertakan kategori dengan ?include=category
+	})); err != nil {
+		log.Error("failed to register GORM cache plugin", "error", err)
+		os.Exit(1)
+	}
+	if err := metrics.RegisterDBStats(db, cfg.DBName); err != nil {  // Mengekspos statistik connection pool
+		log.Error("failed to register DB stats collector", "error", err)
+		os.Exit(1)
+	}
+
+	// Setup file storage
+	store, err := storage.New(cfg)            // Folder lokal atau bucket S3 sesuai STORAGE_DRIVER
+	if err != nil {
+		log.Error("failed to setup storage", "error", err)
+		os.Exit(1)
+	}
+	imageSizes, err := imaging.ParseSizes(cfg.ImageSizes)  // Ukuran thumbnail dari IMAGE_SIZES
+	if err != nil {
+		log.Error("invalid IMAGE_SIZES", "error", err)
+		os.Exit(1)
+	}
+
+	// Setup background jobs
+	jobQueues, err := jobs.ParseQueues(cfg.JobQueues)  // Antrean dan jumlah worker dari JOB_QUEUES
+	if err != nil {
+		log.Error("invalid JOB_QUEUES", "error", err)
+		os.Exit(1)
+	}
+	runner := jobs.NewRunner(db, jobs.Options{  // Modul mendaftarkan tipe job sebelum runner dijalankan
+		Queues:       jobQueues,
+		PollInterval: cfg.JobPollInterval,
+		Timeout:      cfg.JobTimeout,
+		MaxAttempts:  int(cfg.JobMaxAttempts),
+		Retention:    cfg.JobRetention,
+	})
+
+	// Setup domain events
+	dispatcher := events.NewDispatcher(db, runner, events.Options{  // Meneruskan event dari outbox ke sink lewat job events.deliver
+		PollInterval: cfg.EventPollInterval,
+		Retention:    cfg.EventRetention,
+	})
+	bus := events.NewBus()                    // Subscriber di proses yang sama
+	dispatcher.AddSink(bus)
+	if cfg.EventHTTPURL != "" {
+		dispatcher.AddSink(events.NewHTTPSink(cfg.EventHTTPURL, strings.Split(cfg.EventHTTPTypes, ","), cfg.EventHTTPTimeout))
+	}
+	stream := events.NewStream(db, events.StreamOptions{  // Setiap instance membaca outbox sendiri untuk client SSE
+		PollInterval: cfg.EventPollInterval,
+		BufferSize:   int(cfg.SSEReplaySize),
+	})
+	hub := realtime.NewHub(realtime.Options{  // Koneksi WebSocket dengan langganan topik untuk terminal POS
+		SendBuffer:   int(cfg.WSSendBuffer),
+		WriteTimeout: cfg.WSWriteTimeout,
+		PingInterval: cfg.WSPingInterval,
+		MaxTopics:    int(cfg.WSMaxTopics),
+		ValidTopic:   realtimeService.ValidTopic,
+		Authorize:    realtimeService.Authorizer(db),  // order:<id> hanya untuk pemilik, orders hanya untuk staff dan terminal
+	})
 
 	// Setup router                           
-	r := gin.Default()                        // Membuat router Gin dengan konfigurasi default
+	gin.SetMode(cfg.GinMode)                  // Mengatur mode Gin (debug/release/test)
+	r := gin.New()                            // Membuat router Gin tanpa logger teks bawaan
+	r.Use(otelgin.Middleware(cfg.ServiceName)) // Membuat span untuk setiap request dan membaca header traceparent
+	r.Use(middleware.RequestID())             // Memberikan request ID pada setiap request
+	r.Use(middleware.Logger(log))             // Menulis access log terstruktur melalui slog
+	r.Use(middleware.Metrics())               // Mencatat metric Prometheus untuk setiap request
+	r.Use(middleware.Recovery(log, middleware.ErrorReporterFunc(metrics.ReportPanic)))  // Menangkap panic dan mengembalikan respons JSON standar
 	r.Use(middleware.CORS())                  // Menggunakan middleware CORS
+	r.Use(middleware.BodyLimit(cfg.MaxBodyBytes))  // Membatasi ukuran body request secara global
+	r.Use(middleware.Authenticate(tokens))    // Membaca token bearer (opsional) dan mengisi user_id
+	r.Use(middleware.AuditActor())            // Menyimpan user dan IP ke context request untuk audit log
+
+	// Metrics endpoint
+	r.GET("/metrics", metrics.Handler())      // Endpoint untuk di-scrape oleh Prometheus
+
+	// Health and version endpoints
+	checker := health.NewChecker()            // Membuat checker untuk readiness
+	checker.Add("database", health.DBPing(db))  // Memeriksa koneksi database
+	checker.Add("migrations", health.Migrations(db, migration.Models()...))  // Memeriksa semua tabel sudah dibuat
+	r.GET("/healthz", checker.Liveness)       // Endpoint liveness: proses hidup
+	r.GET("/readyz", checker.Readiness)       // Endpoint readiness: siap menerima traffic
+	r.GET("/version", version.Handler)        // Endpoint informasi build
+
+	// Uploaded files
+	if cfg.StorageDriver == "local" && strings.HasPrefix(cfg.StoragePublicURL, "/") {  // URL absolut berarti file disajikan server lain (misal CDN)
+		r.Static(cfg.StoragePublicURL, cfg.StorageLocalDir)  // Menyajikan gambar yang disimpan di folder lokal
+	}
 
 	// API routes                             
 	api := r.Group("/api")                    // Membuat grup route dengan prefix "/api"
+	cacheControl := middleware.CacheControl(cfg.HTTPCacheMaxAge)  // Header Cache-Control untuk GET product dan category
 
 	// Initialize modules                     
 	user.Initialize(db, api)                  // Menginisialisasi modul user
-	product.Initialize(db, api)               // Menginisialisasi modul product
-	category.Initialize(db, api)              // Menginisialisasi modul category
+	product.Initialize(db, api, store, imaging.Options{MaxBytes: cfg.MaxImageBytes, Thumbnails: imageSizes}, runner, responseCache, cacheControl)  // Menginisialisasi modul product beserta gambar
+	category.Initialize(db, api, responseCache, cacheControl)  // Menginisialisasi modul category
+	attribute.Initialize(db, api)             // Menginisialisasi modul attribute (atribut varian dan template kategori)
+	inventory.Initialize(db, api)             // Menginisialisasi modul inventory (stok product)
+	order.Initialize(db, api)                 // Menginisialisasi modul order (checkout)
+	cart.Initialize(db, api, cfg.CartTTL, runner)  // Menginisialisasi modul cart
+	job.Initialize(db, api)                   // Menginisialisasi modul job (status job latar belakang)
+	auditModule.Initialize(db, api)           // Menginisialisasi modul audit (riwayat perubahan)
+	event.Initialize(api, stream, cfg.SSEHeartbeat)  // Menginisialisasi modul event (stream SSE perubahan katalog)
+	realtimeModule.Initialize(api, hub, stream)  // Menginisialisasi modul realtime (WebSocket stok, harga, dan order)
+	webhook.Initialize(db, api, runner, dispatcher, webhookService.Options{  // Menginisialisasi modul webhook (notifikasi ke partner)
+		Timeout:      cfg.WebhookTimeout,
+		MaxAttempts:  int(cfg.WebhookMaxAttempts),
+		DisableAfter: int(cfg.WebhookDisableAfter),
+		Retention:    cfg.WebhookRetention,
+		AllowPrivate: cfg.WebhookAllowPrivate,
+	})
+
+	// Background workers
+	if err := runner.Start(context.Background()); err != nil {  // Menjalankan worker dan jadwal cron setelah semua modul mendaftarkan job
+		log.Error("failed to start job runner", "error", err)
+		os.Exit(1)
+	}
+	if err := dispatcher.Start(context.Background()); err != nil {  // Meneruskan event yang dicatat service
+		log.Error("failed to start event dispatcher", "error", err)
+		os.Exit(1)
+	}
+	if err := stream.Start(context.Background()); err != nil {  // Membaca event baru untuk client SSE
+		log.Error("failed to start event stream", "error", err)
+		os.Exit(1)
+	}
+	go responseCache.Follow(stream, map[string][]string{  // Invalidasi ulang setelah commit dan untuk perubahan dari instance lain
+		"product.*":  {"products", "categories"},
+		"category.*": {"categories", "products"},
+	})
 
 	// Start server                           
-	log.Printf("🚀 Server running on port %s", cfg.ServerPort)  // Menampilkan pesan server berjalan
-	r.Run(":" + cfg.ServerPort)               // Menjalankan server pada port yang ditentukan
+	srv := &http.Server{Addr: ":" + cfg.ServerPort, Handler: r}  // Membuat server HTTP dengan router Gin
+	go func() {                               // Menjalankan server di goroutine terpisah
+		log.Info("🚀 Server running", "port", cfg.ServerPort, "version", version.Version)  // Menampilkan pesan server berjalan
+		if err := srv.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {  // Menjalankan server pada port yang ditentukan
+			log.Error("server stopped", "error", err)  // Mencatat error jika server gagal berjalan
+			shutdownTracing(context.Background()) // os.Exit tidak menjalankan defer, flush span secara manual
+			os.Exit(1)                        // Keluar dengan status error
+		}
+	}()
+
+	// Graceful shutdown
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)  // Menunggu Ctrl+C atau SIGTERM dari orchestrator
+	defer stop()
+	<-ctx.Done()                              // Blok sampai sinyal diterima
+
+	log.Info("shutting down server")          // Mencatat proses berhenti
+	checker.SetShuttingDown()                 // Readiness langsung gagal agar traffic baru dialihkan
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), cfg.ShutdownTimeout)  // Batas waktu menunggu request aktif dan job yang berjalan
+	defer cancel()
+	jobsStopped := make(chan error, 1)
+	go func() { jobsStopped <- errors.Join(dispatcher.Shutdown(shutdownCtx), runner.Shutdown(shutdownCtx)) }()  // Berhenti meneruskan event dan mengambil job baru, tunggu job yang berjalan, bersamaan dengan server
+	if err := hub.Shutdown(shutdownCtx); err != nil {  // Mengirim close frame ke koneksi WebSocket, yang tidak ditunggu srv.Shutdown
+		log.Error("websocket hub shutdown failed", "error", err)
+	}
+	if err := stream.Shutdown(shutdownCtx); err != nil {  // Memutus client SSE lebih dulu, jika tidak server menunggu sampai SHUTDOWN_TIMEOUT
+		log.Error("event stream shutdown failed", "error", err)
+	}
+	if err := srv.Shutdown(shutdownCtx); err != nil {  // Berhenti menerima koneksi baru dan tunggu request aktif selesai
+		log.Error("server shutdown failed", "error", err)
+	}
+	if err := <-jobsStopped; err != nil {
+		log.Error("job runner shutdown incomplete", "error", err)
+	}
+	log.Info("server stopped")                // Server berhenti dengan bersih
 }
 
 
@@ -60,16 +298,27 @@ Setiap modul (user, product, category) memiliki struktur yang sama:
 ### 3. Package Pendukung
 - Config : Konfigurasi aplikasi
 - Database : Koneksi database
-- Middleware : Fungsi middleware seperti CORS
+- Middleware : Fungsi middleware seperti request ID, access log, dan CORS
+- Logger : Logger terstruktur (slog) dengan format JSON atau text
+- Metrics : Metric Prometheus yang diekspos di GET /metrics
+- Tracing : OpenTelemetry tracing untuk request HTTP, service, dan query GORM
+- Health : Endpoint /healthz, /readyz, dan /version untuk orchestrator
+- Jobs : Antrean job latar belakang di database dengan worker, retry, dan jadwal cron
+- Events : Domain event yang dicatat service di outbox dan diteruskan ke sink (subscriber lokal, HTTP, broker) lewat job, serta dibaca Stream untuk SSE dan WebSocket
+- Realtime : Hub WebSocket dengan langganan topik, backpressure per koneksi, dan shutdown yang mengirim close frame
+- Fieldset : Whitelist ?fields= dan ?include= per entitas untuk endpoint baca product dan category
+- Cache : Cache hasil baca product dan category (LRU di memori, interface Store kompatibel Redis) dengan invalidasi per namespace dan singleflight
+- Audit : Plugin GORM yang mencatat siapa mengubah product, category, dan user beserta nilai sebelum dan sesudahnya
 - Utils : Fungsi utilitas seperti format response
 ### Alur Kerja Aplikasi
 1. Inisialisasi : main.go memuat konfigurasi dan menghubungkan ke database
 2. Setup Router : Membuat router Gin dan menerapkan middleware
 3. Registrasi Route : Setiap modul mendaftarkan route-nya sendiri
 4. Menjalankan Server : Server HTTP dijalankan pada port yang ditentukan
+5. Graceful Shutdown : Saat menerima SIGTERM, readiness gagal, koneksi WebSocket ditutup, client SSE diputus, server berhenti menerima koneksi baru, runner job berhenti mengambil job baru, lalu request aktif dan job yang berjalan ditunggu sampai SHUTDOWN_TIMEOUT
 ### Cara Kerja Request
 1. Request masuk ke router Gin
-2. Middleware diproses (seperti CORS)
+2. Middleware diproses (request ID, access log, recovery, CORS, autentikasi, actor audit)
 3. Request diteruskan ke handler yang sesuai
 4. Handler memanggil service untuk logika bisnis
 5. Service berinteraksi dengan database melalui entity
@@ -2,6 +2,7 @@ package main                        // Mendefinisikan package utama untuk aplika
 
 import (
 	"log"                       // Package untuk logging
+	"rest-api-go/internal/migration" // Mengimpor package migration untuk tabel tanpa data awal
 	"rest-api-go/internal/seed" // Mengimpor package seed yang berisi fungsi-fungsi seeding
 	"rest-api-go/pkg/database"  // Mengimpor package database untuk koneksi
 )
@@ -15,6 +16,11 @@ func main() {                       // Fungsi utama yang dijalankan saat program
 	seed.Categories(db)         // Menjalankan fungsi seeding untuk kategori (termasuk migrasi tabel)
 	seed.Products(db)           // Menjalankan fungsi seeding untuk produk (termasuk migrasi tabel)
 	seed.Users(db)              // Menjalankan fungsi seeding untuk pengguna (termasuk migrasi tabel)
+
+	// Migrate remaining tables
+	if err := migration.Run(db); err != nil {  // Memastikan semua tabel dari migration.Models() tersedia
+		log.Fatal("Error running migrations:", err)  // Log error dan hentikan program jika gagal
+	}
 	
 	log.Println("✅ All data migrated and seeded successfully") // Menampilkan pesan sukses setelah semua data berhasil di-seed
 }
@@ -33,7 +39,8 @@ File ini adalah program terpisah yang berfungsi untuk mengisi database dengan da
 	- Menghubungkan ke database
 	- Menjalankan fungsi seeding untuk kategori terlebih dahulu
 	- Kemudian menjalankan fungsi seeding untuk produk (karena produk memiliki foreign key ke kategori)
-	- Terakhir menjalankan fungsi seeding untuk pengguna
+	- Kemudian menjalankan fungsi seeding untuk pengguna
+	- Terakhir menjalankan migration.Run untuk membuat tabel lain yang tidak memiliki data awal
 3. Urutan Seeding : Urutan ini penting karena adanya relasi foreign key. Kategori harus dibuat terlebih dahulu sebelum produk, karena produk mereferensikan kategori.
 4. Migrasi Tabel : Setiap fungsi seeding juga melakukan migrasi tabel (membuat atau memperbarui struktur tabel) sebelum mengisi data.
 ## Cara Menjalankan Seeder
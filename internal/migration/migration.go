@@ -0,0 +1,109 @@
+package migration                             // Mendefinisikan package migration
+
+import (
+    "fmt"                                     // Package untuk formatting pesan error
+    attributeEntity "rest-api-go/internal/module/attribute/entity"  // Mengimpor entity attribute
+    cartEntity "rest-api-go/internal/module/cart/entity"          // Mengimpor entity cart
+    categoryEntity "rest-api-go/internal/module/category/entity"  // Mengimpor entity category
+    inventoryEntity "rest-api-go/internal/module/inventory/entity"  // Mengimpor entity inventory
+    orderEntity "rest-api-go/internal/module/order/entity"          // Mengimpor entity order
+    productEntity "rest-api-go/internal/module/product/entity"    // Mengimpor entity product
+    userEntity "rest-api-go/internal/module/user/entity"          // Mengimpor entity user
+    webhookEntity "rest-api-go/internal/module/webhook/entity"    // Mengimpor entity webhook
+    "rest-api-go/pkg/audit"                   // Mengimpor tabel audit log
+    "rest-api-go/pkg/events"                  // Mengimpor tabel outbox event
+    "rest-api-go/pkg/jobs"                    // Mengimpor tabel job latar belakang
+    "rest-api-go/pkg/slug"                    // Mengimpor tabel riwayat slug
+    "strings"                                 // Package untuk menggabungkan daftar email ganda
+
+    "gorm.io/gorm"                            // Mengimpor ORM GORM
+)
+
+// Models - daftar semua model yang harus memiliki tabel di database
+func Models() []interface{} {                 // Fungsi untuk mendapatkan daftar model aplikasi
+    return []interface{}{                     // Urutan mengikuti foreign key: category sebelum product
+        &slug.Redirect{},
+        &jobs.Job{},
+        &jobs.Schedule{},
+        &audit.Entry{},
+        &events.Event{},
+        &categoryEntity.Category{},
+        &attributeEntity.Attribute{},
+        &attributeEntity.CategoryAttribute{},
+        &productEntity.Product{},
+        &productEntity.ProductImage{},
+        &productEntity.ProductVariant{},
+        &productEntity.VariantValue{},
+        &productEntity.ProductImport{},
+        &productEntity.ProductImportError{},
+        &inventoryEntity.StockMovement{},
+        &userEntity.User{},
+        &orderEntity.Order{},
+        &orderEntity.OrderLine{},
+        &cartEntity.Cart{},
+        &cartEntity.CartItem{},
+        &webhookEntity.Subscription{},
+        &webhookEntity.Delivery{},
+    }
+}
+
+// Run - menjalankan AutoMigrate untuk semua model
+func Run(db *gorm.DB) error {                 // Fungsi untuk membuat atau memperbarui tabel
+    if db.Migrator().HasIndex(&cartEntity.CartItem{}, "idx_cart_product") {  // Index lama (cart_id, product_id) diganti idx_cart_item yang menyertakan variant_id
+        if err := db.Migrator().DropIndex(&cartEntity.CartItem{}, "idx_cart_product"); err != nil {
+            return err
+        }
+    }
+    if err := checkUniqueEmails(db); err != nil {
+        return err
+    }
+    if err := db.AutoMigrate(Models()...); err != nil {  // AutoMigrate hanya menambah tabel/kolom, tidak menghapus data
+        return err
+    }
+    if err := slug.Backfill(db, "categories", "name", "category"); err != nil {  // Isi slug untuk kategori lama
+        return err
+    }
+    return slug.Backfill(db, "products", "title", "product")  // Isi slug untuk product lama
+}
+
+func checkUniqueEmails(db *gorm.DB) error {   // Fungsi untuk menolak migrasi jika index unik email tidak bisa dibuat karena data lama berisi email ganda
+    user := &userEntity.User{}
+    if !db.Migrator().HasTable(user) || db.Migrator().HasIndex(user, "idx_users_email") {
+        return nil                            // Tabel baru atau index sudah ada
+    }
+    var emails []string
+    if err := db.Model(user).Group("email").Having("COUNT(*) > 1").Pluck("email", &emails).Error; err != nil {
+        return err
+    }
+    if len(emails) > 0 {
+        return fmt.Errorf("cannot add unique index on users.email, resolve duplicate emails first: %s", strings.Join(emails, ", "))
+    }
+    return nil
+}
+
+
+
+// {{{ Penjelasan Package Migration }}}
+
+/*
+## Penjelasan Detail
+File migration.go ini berisi daftar model yang dimigrasikan oleh aplikasi. Berikut penjelasan detailnya:
+
+1. Tujuan : Menyediakan satu daftar model yang dipakai bersama oleh seeder dan pemeriksaan readiness.
+2. Fungsi Models :
+
+    - Mengembalikan pointer ke setiap entity yang memiliki tabel
+    - Saat menambah entity baru, cukup tambahkan ke daftar ini
+3. Fungsi Run :
+
+    - Menghapus index lama yang sudah diganti (idx_cart_product menjadi idx_cart_item dengan variant_id)
+    - Sebelum index unik users.email (idx_users_email) dibuat, email ganda di data lama dilaporkan dan migrasi dihentikan agar admin memilih user mana yang dipertahankan
+    - Menjalankan db.AutoMigrate untuk semua model
+    - Mengisi slug untuk category dan product yang dibuat sebelum kolom slug ada
+    - Dipanggil oleh cmd/seed setelah seeding sehingga tabel tanpa data awal juga dibuat
+4. Hubungan dengan Readiness :
+
+    - GET /readyz memeriksa bahwa tabel untuk setiap model di Models() sudah ada
+    - Jika seeder/migrasi belum dijalankan, readiness gagal dengan daftar tabel yang hilang
+Dengan satu daftar ini, seeder dan readiness check tidak akan berbeda pendapat tentang tabel apa saja yang dibutuhkan.
+*/
@@ -0,0 +1,39 @@
+package attribute                              // Mendefinisikan package attribute
+
+import (
+	"rest-api-go/internal/module/attribute/handler"  // Mengimpor package handler dari modul attribute
+	"rest-api-go/internal/module/attribute/service"  // Mengimpor package service dari modul attribute
+
+	"github.com/gin-gonic/gin"                     // Mengimpor framework web Gin
+	"gorm.io/gorm"                                 // Mengimpor ORM GORM
+)
+
+// Initialize - Fungsi untuk menginisialisasi modul attribute
+func Initialize(db *gorm.DB, router *gin.RouterGroup) {  // Fungsi untuk inisialisasi modul dengan parameter database dan router
+	// Initialize service
+	attributeService := service.NewAttributeService(db)  // Membuat instance service attribute dengan menyuntikkan database
+
+	// Initialize handler
+	attributeHandler := handler.NewAttributeHandler(attributeService)  // Membuat instance handler dengan menyuntikkan service
+
+	// Register routes
+	handler.RegisterRoutes(router, attributeHandler)   // Mendaftarkan route untuk modul attribute
+}
+
+
+// {{{ Penjelasan Fungsi Initialize }}}
+
+/*
+## Penjelasan Detail
+File bootstrap.go ini berfungsi sebagai titik masuk (entry point) untuk modul attribute. Berikut penjelasan detailnya:
+
+1. Tujuan : Menginisialisasi service dan handler untuk definisi atribut varian dan template atribut kategori.
+2. Alur Kerja :
+
+	- Membuat instance service dengan menyuntikkan database
+	- Membuat instance handler dengan menyuntikkan service
+	- Mendaftarkan route /attributes dan /categories/:id/attributes
+3. Hubungan dengan Modul Lain :
+
+	- Modul product membuat AttributeService sendiri untuk memeriksa varian terhadap template kategori
+*/
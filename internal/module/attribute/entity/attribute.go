@@ -0,0 +1,84 @@
+package entity                                // Mendefinisikan package entity untuk modul attribute
+
+import (
+    "rest-api-go/pkg/validation"              // Package validation dengan validator bersama
+    "time"                                    // Package time untuk tipe data waktu
+)
+
+type Attribute struct {                       // Mendefinisikan struct Attribute (definisi atribut varian, misal ukuran atau warna)
+    ID        uint      `json:"id" gorm:"primaryKey"`  // ID atribut sebagai primary key
+    Code      string    `json:"code" gorm:"size:64;uniqueIndex;not null" binding:"required,slug,max=64"`  // Kode unik, dipakai pada filter ?attr[code]=nilai
+    Name      string    `json:"name" gorm:"size:255;not null" binding:"required,notblank,max=255"`  // Nama atribut untuk ditampilkan
+    Values    []string  `json:"values" gorm:"serializer:json;type:text" binding:"omitempty,max=100,unique,dive,required,notblank,max=64"`  // Nilai yang diizinkan, kosong berarti bebas
+    CreatedAt time.Time `json:"created_at"`  // Waktu pembuatan record
+    UpdatedAt time.Time `json:"updated_at"`  // Waktu pembaruan record
+}
+
+func (a *Attribute) Validate() error {        // Method untuk validasi struct Attribute
+    return validation.Struct(a)               // Memvalidasi struct berdasarkan tag binding dengan validator bersama
+}
+
+// Allows - memeriksa apakah nilai termasuk nilai yang diizinkan
+func (a *Attribute) Allows(value string) bool {
+    if len(a.Values) == 0 {
+        return true                           // Atribut tanpa daftar nilai menerima nilai apa pun
+    }
+    for _, allowed := range a.Values {
+        if allowed == value {
+            return true
+        }
+    }
+    return false
+}
+
+type CategoryAttribute struct {               // Mendefinisikan struct CategoryAttribute (template atribut kategori)
+    CategoryID  uint `json:"category_id" gorm:"primaryKey;autoIncrement:false"`  // ID kategori pemilik template
+    AttributeID uint `json:"attribute_id" gorm:"primaryKey;autoIncrement:false;index"`  // ID atribut dalam template
+    Required    bool `json:"required" gorm:"not null;default:false"`  // Varian product di kategori ini wajib mengisi atribut ini
+    Position    int  `json:"position" gorm:"not null;default:0"`  // Urutan atribut dalam template
+}
+
+type TemplateRequest struct {                 // Mendefinisikan struct body request untuk mengganti template kategori
+    Attributes []TemplateItem `json:"attributes" binding:"max=50,dive"`  // Daftar atribut, kosong untuk menghapus template
+}
+
+type TemplateItem struct {                    // Mendefinisikan struct satu atribut dalam TemplateRequest
+    AttributeID uint `json:"attribute_id" binding:"required"`  // ID atribut
+    Required    bool `json:"required"`          // Wajib diisi oleh setiap varian
+}
+
+func (r *TemplateRequest) Validate() error {  // Method untuk validasi struct TemplateRequest
+    return validation.Struct(r)               // Memvalidasi struct berdasarkan tag binding dengan validator bersama
+}
+
+type TemplateAttribute struct {               // Mendefinisikan struct respons satu atribut dalam template efektif
+    Attribute                                 // Definisi atribut
+    Required   bool `json:"required"`         // Wajib diisi oleh setiap varian
+    CategoryID uint `json:"category_id"`      // Kategori asal template, berbeda dengan kategori yang diminta jika diwarisi dari induk
+}
+
+
+//  {{{ Penjelasan Struktur Attribute }}}
+
+/*
+## Penjelasan Detail
+File attribute.go ini mendefinisikan struktur data untuk atribut varian product. Berikut penjelasan detailnya:
+
+1. Attribute :
+
+    - Definisi atribut seperti ukuran (code "size") atau warna (code "color")
+    - Code memakai format slug dan dipakai pada filter daftar product, misal /api/products?attr[color]=red
+    - Values adalah daftar nilai yang diizinkan, misal ["S", "M", "L"]; daftar kosong berarti nilai bebas
+    - Values disimpan sebagai JSON dengan serializer bawaan GORM
+2. CategoryAttribute :
+
+    - Template atribut kategori: atribut apa saja yang dipakai varian product di kategori tersebut
+    - Required menandai atribut yang wajib diisi setiap varian
+    - Template diwarisi oleh sub-kategori, misal template "Pakaian" berlaku juga untuk "Kaos"
+3. TemplateRequest :
+
+    - Body untuk mengganti seluruh template sebuah kategori, urutan daftar menjadi Position
+4. TemplateAttribute :
+
+    - Respons template efektif: atribut dari kategori itu sendiri dan seluruh induknya, CategoryID menunjukkan asalnya
+*/
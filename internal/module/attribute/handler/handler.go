@@ -0,0 +1,155 @@
+package handler                                // Mendefinisikan package handler untuk modul attribute
+
+import (
+    "net/http"                                 // Package untuk konstanta HTTP
+    "rest-api-go/internal/module/attribute/entity"   // Mengimpor entity attribute
+    "rest-api-go/internal/module/attribute/service"  // Mengimpor service attribute
+    "rest-api-go/pkg/utils"                    // Mengimpor utilitas aplikasi
+    "strconv"                                  // Package untuk konversi string
+
+    "github.com/gin-gonic/gin"                 // Framework web Gin
+)
+
+type AttributeHandler struct {                 // Mendefinisikan struct handler
+    service *service.AttributeService          // Dependency service
+}
+
+func NewAttributeHandler(service *service.AttributeService) *AttributeHandler {  // Constructor untuk handler
+    return &AttributeHandler{service}          // Mengembalikan instance handler dengan service yang diinjeksi
+}
+
+func (h *AttributeHandler) Create(c *gin.Context) {  // Handler untuk membuat atribut baru
+    var attribute entity.Attribute             // Variabel untuk menampung data atribut dari request
+    if err := utils.BindJSON(c, &attribute); err != nil {  // Binding JSON request ke struct secara ketat
+        utils.HandleError(c, http.StatusBadRequest, err)  // Respons error jika binding gagal (400, 413, atau 415)
+        return
+    }
+
+    if err := h.service.Create(c.Request.Context(), &attribute); err != nil {  // Memanggil service untuk membuat atribut
+        utils.HandleError(c, http.StatusInternalServerError, err)  // Respons error jika gagal
+        return
+    }
+
+    c.JSON(http.StatusCreated, utils.SuccessResponse(attribute))  // Respons sukses dengan data atribut
+}
+
+func (h *AttributeHandler) GetByID(c *gin.Context) {  // Handler untuk mendapatkan atribut berdasarkan ID
+    id, err := strconv.ParseUint(c.Param("id"), 10, 32)  // Mengambil dan mengkonversi parameter ID
+    if err != nil {
+        utils.ErrorJSON(c, http.StatusBadRequest, "Invalid ID")  // Respons error jika ID tidak valid
+        return
+    }
+
+    attribute, err := h.service.GetByID(c.Request.Context(), uint(id))  // Memanggil service untuk mendapatkan atribut
+    if err != nil {
+        utils.HandleError(c, http.StatusInternalServerError, err)  // Respons error (404 atau 500)
+        return
+    }
+
+    c.JSON(http.StatusOK, utils.SuccessResponse(attribute))  // Respons sukses dengan data atribut
+}
+
+func (h *AttributeHandler) GetAll(c *gin.Context) {  // Handler untuk mendapatkan semua atribut
+    attributes, err := h.service.GetAll(c.Request.Context())  // Memanggil service untuk mendapatkan semua atribut
+    if err != nil {
+        utils.HandleError(c, http.StatusInternalServerError, err)  // Respons error jika gagal
+        return
+    }
+
+    c.JSON(http.StatusOK, utils.SuccessResponse(attributes))  // Respons sukses dengan data atribut
+}
+
+func (h *AttributeHandler) Update(c *gin.Context) {  // Handler untuk memperbarui atribut
+    id, err := strconv.ParseUint(c.Param("id"), 10, 32)  // Mengambil dan mengkonversi parameter ID
+    if err != nil {
+        utils.ErrorJSON(c, http.StatusBadRequest, "Invalid ID")  // Respons error jika ID tidak valid
+        return
+    }
+
+    var attribute entity.Attribute             // Variabel untuk menampung data atribut dari request
+    if err := utils.BindJSON(c, &attribute); err != nil {  // Binding JSON request ke struct secara ketat
+        utils.HandleError(c, http.StatusBadRequest, err)  // Respons error jika binding gagal (400, 413, atau 415)
+        return
+    }
+    attribute.ID = uint(id)                    // Mengatur ID atribut dari parameter URL
+
+    if err := h.service.Update(c.Request.Context(), &attribute); err != nil {  // Memanggil service untuk memperbarui atribut
+        utils.HandleError(c, http.StatusInternalServerError, err)  // Respons error jika gagal
+        return
+    }
+
+    c.JSON(http.StatusOK, utils.SuccessResponse(attribute))  // Respons sukses dengan data atribut yang diperbarui
+}
+
+func (h *AttributeHandler) Delete(c *gin.Context) {  // Handler untuk menghapus atribut
+    id, err := strconv.ParseUint(c.Param("id"), 10, 32)  // Mengambil dan mengkonversi parameter ID
+    if err != nil {
+        utils.ErrorJSON(c, http.StatusBadRequest, "Invalid ID")  // Respons error jika ID tidak valid
+        return
+    }
+
+    if err := h.service.Delete(c.Request.Context(), uint(id)); err != nil {  // Memanggil service untuk menghapus atribut
+        utils.HandleError(c, http.StatusInternalServerError, err)  // Respons error jika gagal
+        return
+    }
+
+    c.JSON(http.StatusOK, utils.SuccessResponse("Attribute deleted successfully"))  // Respons sukses dengan pesan
+}
+
+func (h *AttributeHandler) GetTemplate(c *gin.Context) {  // Handler untuk mendapatkan template atribut kategori
+    id, err := strconv.ParseUint(c.Param("id"), 10, 32)  // Mengambil dan mengkonversi parameter ID kategori
+    if err != nil {
+        utils.ErrorJSON(c, http.StatusBadRequest, "Invalid ID")  // Respons error jika ID tidak valid
+        return
+    }
+
+    template, err := h.service.Template(c.Request.Context(), uint(id))  // Memanggil service untuk mendapatkan template efektif
+    if err != nil {
+        utils.HandleError(c, http.StatusInternalServerError, err)  // Respons error jika gagal
+        return
+    }
+
+    c.JSON(http.StatusOK, utils.SuccessResponse(template))  // Respons sukses dengan template efektif
+}
+
+func (h *AttributeHandler) SetTemplate(c *gin.Context) {  // Handler untuk mengganti template atribut kategori
+    id, err := strconv.ParseUint(c.Param("id"), 10, 32)  // Mengambil dan mengkonversi parameter ID kategori
+    if err != nil {
+        utils.ErrorJSON(c, http.StatusBadRequest, "Invalid ID")  // Respons error jika ID tidak valid
+        return
+    }
+
+    var req entity.TemplateRequest             // Variabel untuk menampung template dari request
+    if err := utils.BindJSON(c, &req); err != nil {  // Binding JSON request ke struct secara ketat
+        utils.HandleError(c, http.StatusBadRequest, err)  // Respons error jika binding gagal (400, 413, atau 415)
+        return
+    }
+
+    template, err := h.service.SetTemplate(c.Request.Context(), uint(id), &req)  // Memanggil service untuk menyimpan template
+    if err != nil {
+        utils.HandleError(c, http.StatusInternalServerError, err)  // Respons error jika gagal
+        return
+    }
+
+    c.JSON(http.StatusOK, utils.SuccessResponse(template))  // Respons sukses dengan template efektif
+}
+
+
+// {{{ Penjelasan Fungsi Handler }}}
+
+/*
+## Penjelasan Detail
+File handler.go ini berisi implementasi handler HTTP untuk modul Attribute. Berikut penjelasan detailnya:
+
+1. CRUD Atribut :
+
+    - Create, GetByID, GetAll, Update, Delete untuk definisi atribut seperti ukuran dan warna
+2. Template Kategori :
+
+    - GetTemplate : Template efektif kategori, termasuk atribut yang diwarisi dari induk
+    - SetTemplate : Mengganti template milik kategori itu sendiri
+3. Penanganan Error :
+
+    - Error binding JSON: 400, 413, atau 415
+    - Error dari service sudah membawa status sendiri (400, 404, 409) dan dikirim melalui utils.HandleError
+*/
@@ -0,0 +1,46 @@
+package handler                                // Mendefinisikan package handler untuk modul attribute
+
+import (
+    "github.com/gin-gonic/gin"                 // Mengimpor framework web Gin
+)
+
+func RegisterRoutes(router *gin.RouterGroup, handler *AttributeHandler) {  // Fungsi untuk mendaftarkan route
+    attributes := router.Group("/attributes")  // Membuat grup route dengan prefix "/attributes"
+    {
+        attributes.POST("", handler.Create)    // Mendaftarkan endpoint POST untuk membuat atribut baru
+        attributes.GET("", handler.GetAll)     // Mendaftarkan endpoint GET untuk mendapatkan semua atribut
+        attributes.GET("/:id", handler.GetByID)  // Mendaftarkan endpoint GET untuk mendapatkan atribut berdasarkan ID
+        attributes.PUT("/:id", handler.Update)   // Mendaftarkan endpoint PUT untuk memperbarui atribut
+        attributes.DELETE("/:id", handler.Delete)  // Mendaftarkan endpoint DELETE untuk menghapus atribut
+    }
+
+    templates := router.Group("/categories/:id/attributes")  // Template berada di bawah resource category
+    {
+        templates.GET("", handler.GetTemplate)  // Mendaftarkan endpoint GET untuk template efektif kategori
+        templates.PUT("", handler.SetTemplate)  // Mendaftarkan endpoint PUT untuk mengganti template kategori
+    }
+}
+
+
+// {{{ Penjelasan Fungsi RegisterRoutes }}}
+
+/*
+## Penjelasan Detail
+File route.go ini berisi konfigurasi routing untuk modul Attribute. Berikut penjelasan detailnya:
+
+1. Endpoint API :
+
+    - POST /attributes : Membuat atribut baru
+    - GET /attributes : Mendapatkan semua atribut
+    - GET /attributes/:id : Mendapatkan atribut berdasarkan ID
+    - PUT /attributes/:id : Memperbarui atribut
+    - DELETE /attributes/:id : Menghapus atribut yang tidak dipakai
+    - GET /categories/:id/attributes : Mendapatkan template atribut efektif kategori
+    - PUT /categories/:id/attributes : Mengganti template atribut kategori
+2. Parameter URL :
+
+    - :id : ID atribut, atau ID kategori untuk route template
+3. Route Template :
+
+    - Didaftarkan oleh modul ini walaupun berada di bawah /categories, sama seperti /products/:id/stock di modul inventory
+*/
@@ -0,0 +1,343 @@
+package service                                // Mendefinisikan package service untuk modul attribute
+
+import (
+    "context"                                 // Package untuk context request
+    "errors"                                  // Package untuk pengecekan error
+    "fmt"                                     // Package untuk formatting pesan error
+    "net/http"                                // Package untuk konstanta HTTP
+    "rest-api-go/internal/module/attribute/entity"  // Mengimpor entity attribute
+    "rest-api-go/pkg/tracing"                 // Mengimpor package tracing untuk span service
+    "rest-api-go/pkg/utils"                   // Mengimpor utilitas aplikasi untuk HTTPError
+    "sort"                                    // Package untuk mengurutkan template
+
+    "gorm.io/gorm"                            // Mengimpor ORM GORM
+    "gorm.io/gorm/clause"                     // Klausa SELECT ... FOR UPDATE
+)
+
+const maxCategoryDepth = 32                   // Sama dengan batas kedalaman pohon di modul category
+
+const variantValuesTable = "variant_values"   // Tabel nilai atribut varian di modul product
+
+var (
+    ErrAttributeNotFound = utils.NewHTTPError(http.StatusNotFound, "Attribute not found")  // Atribut tidak ada
+    ErrCategoryNotFound  = utils.NewHTTPError(http.StatusNotFound, "Category not found")   // Kategori template tidak ada
+    ErrCodeTaken         = utils.NewHTTPError(http.StatusConflict, "code is already in use")  // Kode atribut sudah dipakai
+    ErrAttributeInUse    = utils.NewHTTPError(http.StatusConflict, "attribute is used by a category template or product variant")  // Atribut tidak dapat dihapus
+    ErrDuplicateAttribute = utils.NewHTTPError(http.StatusBadRequest, "attributes must not contain the same attribute twice")  // Atribut ganda dalam template
+)
+
+type AttributeService struct {                 // Mendefinisikan struct service
+    db *gorm.DB                               // Dependency database
+}
+
+func NewAttributeService(db *gorm.DB) *AttributeService {  // Constructor untuk service
+    return &AttributeService{db}              // Mengembalikan instance service dengan database yang diinjeksi
+}
+
+func (s *AttributeService) Create(ctx context.Context, attribute *entity.Attribute) error {  // Method untuk membuat atribut baru
+    ctx, span := tracing.Start(ctx, "AttributeService.Create")  // Membuat child span untuk method service
+    defer span.End()                          // Menutup span saat method selesai
+
+    if err := attribute.Validate(); err != nil {  // Validasi data atribut
+        return err
+    }
+
+    return s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+        if err := s.checkCode(tx, 0, attribute.Code); err != nil {
+            return err
+        }
+        return tx.Create(attribute).Error     // Menyimpan atribut ke database
+    })
+}
+
+func (s *AttributeService) GetByID(ctx context.Context, id uint) (*entity.Attribute, error) {  // Method untuk mendapatkan atribut berdasarkan ID
+    ctx, span := tracing.Start(ctx, "AttributeService.GetByID")  // Membuat child span untuk method service
+    defer span.End()                          // Menutup span saat method selesai
+
+    var attribute entity.Attribute
+    err := s.db.WithContext(ctx).First(&attribute, id).Error
+    if errors.Is(err, gorm.ErrRecordNotFound) {
+        return nil, ErrAttributeNotFound
+    }
+    if err != nil {
+        return nil, err
+    }
+    return &attribute, nil
+}
+
+func (s *AttributeService) GetAll(ctx context.Context) ([]entity.Attribute, error) {  // Method untuk mendapatkan semua atribut
+    ctx, span := tracing.Start(ctx, "AttributeService.GetAll")  // Membuat child span untuk method service
+    defer span.End()                          // Menutup span saat method selesai
+
+    attributes := []entity.Attribute{}
+    err := s.db.WithContext(ctx).Order("name, id").Find(&attributes).Error
+    return attributes, err
+}
+
+func (s *AttributeService) Update(ctx context.Context, attribute *entity.Attribute) error {  // Method untuk memperbarui atribut
+    ctx, span := tracing.Start(ctx, "AttributeService.Update")  // Membuat child span untuk method service
+    defer span.End()                          // Menutup span saat method selesai
+
+    if err := attribute.Validate(); err != nil {  // Validasi data atribut
+        return err
+    }
+
+    return s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+        var existing entity.Attribute
+        if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&existing, attribute.ID).Error; err != nil {
+            if errors.Is(err, gorm.ErrRecordNotFound) {
+                return ErrAttributeNotFound
+            }
+            return err
+        }
+        if err := s.checkCode(tx, attribute.ID, attribute.Code); err != nil {
+            return err
+        }
+        if len(attribute.Values) > 0 {        // Nilai yang masih dipakai varian tidak boleh dihapus dari daftar
+            var used []string
+            if err := tx.Table(variantValuesTable).Where("attribute_id = ? AND value NOT IN ?", attribute.ID, attribute.Values).Limit(1).Pluck("value", &used).Error; err != nil {
+                return err
+            }
+            if len(used) > 0 {
+                return utils.NewHTTPError(http.StatusConflict, fmt.Sprintf("value %q is used by a product variant", used[0]))
+            }
+        }
+        attribute.CreatedAt = existing.CreatedAt
+        return tx.Save(attribute).Error       // Menyimpan perubahan atribut
+    })
+}
+
+func (s *AttributeService) Delete(ctx context.Context, id uint) error {  // Method untuk menghapus atribut yang tidak dipakai
+    ctx, span := tracing.Start(ctx, "AttributeService.Delete")  // Membuat child span untuk method service
+    defer span.End()                          // Menutup span saat method selesai
+
+    return s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+        var attribute entity.Attribute
+        if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&attribute, id).Error; err != nil {
+            if errors.Is(err, gorm.ErrRecordNotFound) {
+                return ErrAttributeNotFound
+            }
+            return err
+        }
+        var templates, values int64
+        if err := tx.Model(&entity.CategoryAttribute{}).Where("attribute_id = ?", id).Count(&templates).Error; err != nil {
+            return err
+        }
+        if err := tx.Table(variantValuesTable).Where("attribute_id = ?", id).Count(&values).Error; err != nil {
+            return err
+        }
+        if templates > 0 || values > 0 {
+            return ErrAttributeInUse
+        }
+        return tx.Delete(&attribute).Error
+    })
+}
+
+func (s *AttributeService) Template(ctx context.Context, categoryID uint) ([]entity.TemplateAttribute, error) {  // Method untuk mendapatkan template efektif kategori
+    ctx, span := tracing.Start(ctx, "AttributeService.Template")  // Membuat child span untuk method service
+    defer span.End()                          // Menutup span saat method selesai
+
+    db := s.db.WithContext(ctx)
+    if err := s.categoryExists(db, categoryID); err != nil {
+        return nil, err
+    }
+    return s.Effective(db, categoryID)
+}
+
+func (s *AttributeService) SetTemplate(ctx context.Context, categoryID uint, req *entity.TemplateRequest) ([]entity.TemplateAttribute, error) {  // Method untuk mengganti template milik kategori
+    ctx, span := tracing.Start(ctx, "AttributeService.SetTemplate")  // Membuat child span untuk method service
+    defer span.End()                          // Menutup span saat method selesai
+
+    if err := req.Validate(); err != nil {    // Validasi data request
+        return nil, err
+    }
+
+    var template []entity.TemplateAttribute
+    err := s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+        if err := s.categoryExists(tx, categoryID); err != nil {
+            return err
+        }
+
+        rows := make([]entity.CategoryAttribute, 0, len(req.Attributes))
+        ids := make([]uint, 0, len(req.Attributes))
+        seen := make(map[uint]bool, len(req.Attributes))
+        for position, item := range req.Attributes {
+            if seen[item.AttributeID] {
+                return ErrDuplicateAttribute
+            }
+            seen[item.AttributeID] = true
+            ids = append(ids, item.AttributeID)
+            rows = append(rows, entity.CategoryAttribute{CategoryID: categoryID, AttributeID: item.AttributeID, Required: item.Required, Position: position})
+        }
+        if len(ids) > 0 {
+            var found []uint
+            if err := tx.Model(&entity.Attribute{}).Where("id IN ?", ids).Pluck("id", &found).Error; err != nil {
+                return err
+            }
+            if len(found) != len(ids) {
+                return utils.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("attribute %d not found", missing(ids, found)))
+            }
+        }
+
+        if err := tx.Where("category_id = ?", categoryID).Delete(&entity.CategoryAttribute{}).Error; err != nil {  // Template lama diganti seluruhnya
+            return err
+        }
+        if len(rows) > 0 {
+            if err := tx.Create(&rows).Error; err != nil {
+                return err
+            }
+        }
+
+        var err error
+        template, err = s.Effective(tx, categoryID)
+        return err
+    })
+    if err != nil {
+        return nil, err
+    }
+    return template, nil
+}
+
+// Effective - template kategori beserta template yang diwarisi dari seluruh induknya, induk paling atas lebih dulu
+func (s *AttributeService) Effective(db *gorm.DB, categoryID uint) ([]entity.TemplateAttribute, error) {
+    chain, err := ancestry(db, categoryID)
+    if err != nil {
+        return nil, err
+    }
+    depth := make(map[uint]int, len(chain))
+    for i, id := range chain {
+        depth[id] = i
+    }
+
+    var rows []entity.CategoryAttribute
+    if err := db.Where("category_id IN ?", chain).Find(&rows).Error; err != nil {
+        return nil, err
+    }
+    sort.SliceStable(rows, func(i, j int) bool {  // Induk lebih dulu, lalu berdasarkan Position
+        if depth[rows[i].CategoryID] != depth[rows[j].CategoryID] {
+            return depth[rows[i].CategoryID] < depth[rows[j].CategoryID]
+        }
+        return rows[i].Position < rows[j].Position
+    })
+
+    ids := make([]uint, 0, len(rows))
+    for _, row := range rows {
+        ids = append(ids, row.AttributeID)
+    }
+    attributes := make(map[uint]entity.Attribute, len(ids))
+    if len(ids) > 0 {
+        var found []entity.Attribute
+        if err := db.Where("id IN ?", ids).Find(&found).Error; err != nil {
+            return nil, err
+        }
+        for _, attribute := range found {
+            attributes[attribute.ID] = attribute
+        }
+    }
+
+    template := []entity.TemplateAttribute{}
+    index := make(map[uint]int, len(rows))
+    for _, row := range rows {
+        if i, ok := index[row.AttributeID]; ok {  // Atribut yang sama di beberapa tingkat: wajib jika salah satu mewajibkan
+            template[i].Required = template[i].Required || row.Required
+            continue
+        }
+        index[row.AttributeID] = len(template)
+        template = append(template, entity.TemplateAttribute{Attribute: attributes[row.AttributeID], Required: row.Required, CategoryID: row.CategoryID})
+    }
+    return template, nil
+}
+
+// ByCode - mencari atribut berdasarkan kode, kode yang tidak dikenal tidak ada di map hasil
+func (s *AttributeService) ByCode(db *gorm.DB, codes []string) (map[string]entity.Attribute, error) {
+    result := make(map[string]entity.Attribute, len(codes))
+    if len(codes) == 0 {
+        return result, nil
+    }
+    var found []entity.Attribute
+    if err := db.Where("code IN ?", codes).Find(&found).Error; err != nil {
+        return nil, err
+    }
+    for _, attribute := range found {
+        result[attribute.Code] = attribute
+    }
+    return result, nil
+}
+
+func (s *AttributeService) checkCode(tx *gorm.DB, id uint, code string) error {  // Fungsi untuk memastikan kode atribut unik
+    var count int64
+    if err := tx.Model(&entity.Attribute{}).Where("code = ? AND id <> ?", code, id).Count(&count).Error; err != nil {
+        return err
+    }
+    if count > 0 {
+        return ErrCodeTaken
+    }
+    return nil
+}
+
+func (s *AttributeService) categoryExists(db *gorm.DB, categoryID uint) error {  // Fungsi untuk memeriksa keberadaan kategori
+    var count int64
+    if err := db.Table("categories").Where("id = ?", categoryID).Count(&count).Error; err != nil {  // Tabel dibaca langsung karena modul category mengimpor modul lain
+        return err
+    }
+    if count == 0 {
+        return ErrCategoryNotFound
+    }
+    return nil
+}
+
+func ancestry(db *gorm.DB, categoryID uint) ([]uint, error) {  // Fungsi untuk mengumpulkan ID kategori dari paling atas sampai kategori itu sendiri
+    chain := []uint{categoryID}
+    current := categoryID
+    for depth := 0; depth < maxCategoryDepth; depth++ {
+        var parents []*uint
+        if err := db.Table("categories").Where("id = ?", current).Pluck("parent_id", &parents).Error; err != nil {
+            return nil, err
+        }
+        if len(parents) == 0 || parents[0] == nil {
+            break                             // Kategori paling atas
+        }
+        current = *parents[0]
+        chain = append([]uint{current}, chain...)
+    }
+    return chain, nil
+}
+
+func missing(ids, found []uint) uint {        // Fungsi untuk mencari ID pertama yang tidak ditemukan
+    exists := make(map[uint]bool, len(found))
+    for _, id := range found {
+        exists[id] = true
+    }
+    for _, id := range ids {
+        if !exists[id] {
+            return id
+        }
+    }
+    return 0
+}
+
+
+// {{{ Penjelasan Fungsi Service }}}
+
+/*
+## Penjelasan Detail
+File service.go ini berisi logika bisnis untuk modul Attribute. Berikut penjelasan detailnya:
+
+1. CRUD Atribut :
+
+    - Code harus unik (409 jika sudah dipakai)
+    - Update menolak penghapusan nilai dari Values jika nilai tersebut masih dipakai varian product (409)
+    - Delete menolak atribut yang masih dipakai template kategori atau varian product (409)
+2. Template Kategori :
+
+    - SetTemplate mengganti seluruh template milik kategori; urutan di body menjadi Position
+    - Template dan Effective mengembalikan template efektif: template kategori itu sendiri ditambah template seluruh induknya
+    - Urutan: atribut dari kategori paling atas lebih dulu, lalu berdasarkan Position
+    - Atribut yang muncul di beberapa tingkat digabung; atribut wajib jika salah satu tingkat mewajibkannya
+3. Dipakai Modul Product :
+
+    - Effective dan ByCode dipanggil service varian product dengan transaksi yang sedang berjalan
+4. Akses Tabel Lain :
+
+    - Tabel categories dan variant_values dibaca dengan db.Table() agar modul ini tidak mengimpor modul category dan product
+*/
@@ -0,0 +1,38 @@
+package audit                                  // Mendefinisikan package audit
+
+import (
+	"rest-api-go/internal/module/audit/handler"    // Mengimpor package handler dari modul audit
+	"rest-api-go/internal/module/audit/service"    // Mengimpor package service dari modul audit
+
+	"github.com/gin-gonic/gin"                     // Mengimpor framework web Gin
+	"gorm.io/gorm"                                 // Mengimpor ORM GORM
+)
+
+// Initialize - Fungsi untuk menginisialisasi modul audit
+func Initialize(db *gorm.DB, router *gin.RouterGroup) {  // Fungsi untuk inisialisasi modul dengan parameter database dan router
+	// Initialize service
+	auditService := service.NewAuditService(db)    // Membuat instance service audit dengan menyuntikkan database
+
+	// Initialize handler
+	auditHandler := handler.NewAuditHandler(auditService)  // Membuat instance handler dengan menyuntikkan service
+
+	// Register routes
+	handler.RegisterRoutes(router, auditHandler)       // Mendaftarkan route untuk modul audit
+}
+
+
+// {{{ Penjelasan Fungsi Initialize }}}
+
+/*
+## Penjelasan Detail
+File bootstrap.go ini berfungsi sebagai titik masuk (entry point) untuk modul audit. Berikut penjelasan detailnya:
+
+1. Tujuan : Menghubungkan service, handler, dan route modul audit dengan pola Dependency Injection.
+2. Hubungan dengan pkg/audit :
+
+	- Modul ini hanya menyediakan endpoint baca di bawah /audit
+	- Entri ditulis oleh plugin GORM pkg/audit yang dipasang di main.go, bukan oleh modul lain
+3. Hubungan dengan Aplikasi Utama :
+
+	- Fungsi Initialize dipanggil dari main.go
+*/
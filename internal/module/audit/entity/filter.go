@@ -0,0 +1,36 @@
+package entity                                // Mendefinisikan package entity untuk modul audit
+
+import (
+    "rest-api-go/pkg/audit"                   // Mengimpor package audit untuk action
+)
+
+const (
+    DefaultListLimit = 50                     // Jumlah entri per halaman jika ?limit tidak diisi
+    MaxListLimit     = 500                    // Jumlah entri maksimum per halaman
+)
+
+type ListFilter struct {                      // Mendefinisikan struct filter audit log
+    Entity   string                           // ?entity=product
+    EntityID uint                             // ?id=42, hanya bersama ?entity
+    ActorID  uint                             // ?actor_id=7
+    Action   audit.Action                     // ?action=update, kosong berarti semua action
+    BeforeID uint                             // ?before_id=, halaman berikutnya dimulai dari ID terkecil halaman sebelumnya
+    Limit    int                              // ?limit=, 1 sampai MaxListLimit
+}
+
+
+//  {{{ Penjelasan Struktur ListFilter }}}
+
+/*
+## Penjelasan Detail
+File filter.go ini mendefinisikan filter audit log. Berikut penjelasan detailnya:
+
+1. Model Entry :
+
+    - Model audit.Entry didefinisikan di pkg/audit karena ditulis langsung oleh plugin GORM
+    - Modul audit hanya membaca entri lewat API
+2. ListFilter :
+
+    - Semua field opsional dan digabung dengan AND
+    - Entri diurutkan dari ID terbesar (terbaru); BeforeID dipakai untuk halaman berikutnya tanpa OFFSET
+*/
@@ -0,0 +1,86 @@
+package handler                                // Mendefinisikan package handler untuk modul audit
+
+import (
+    "fmt"                                      // Package untuk formatting pesan error
+    "net/http"                                 // Package untuk konstanta HTTP
+    "rest-api-go/internal/module/audit/entity" // Mengimpor entity audit
+    "rest-api-go/internal/module/audit/service" // Mengimpor service audit
+    "rest-api-go/pkg/audit"                    // Mengimpor action audit
+    "rest-api-go/pkg/utils"                    // Mengimpor utilitas aplikasi
+    "slices"                                   // Package untuk memeriksa action
+    "strconv"                                  // Package untuk konversi string
+
+    "github.com/gin-gonic/gin"                 // Framework web Gin
+)
+
+type AuditHandler struct {                     // Mendefinisikan struct handler
+    service *service.AuditService              // Dependency service
+}
+
+func NewAuditHandler(service *service.AuditService) *AuditHandler {  // Constructor untuk handler
+    return &AuditHandler{service}              // Mengembalikan instance handler dengan service yang diinjeksi
+}
+
+func (h *AuditHandler) List(c *gin.Context) {  // Handler untuk mendapatkan audit log
+    filter := entity.ListFilter{
+        Entity: c.Query("entity"),
+        Action: audit.Action(c.Query("action")),
+        Limit:  entity.DefaultListLimit,
+    }
+    if filter.Action != "" && !slices.Contains(audit.Actions, filter.Action) {
+        utils.ErrorJSON(c, http.StatusBadRequest, fmt.Sprintf("action must be one of %v", audit.Actions))
+        return
+    }
+    for _, param := range []struct {
+        name   string
+        target *uint
+    }{{"id", &filter.EntityID}, {"actor_id", &filter.ActorID}, {"before_id", &filter.BeforeID}} {
+        raw := c.Query(param.name)
+        if raw == "" {
+            continue
+        }
+        id, err := strconv.ParseUint(raw, 10, 32)
+        if err != nil {
+            utils.ErrorJSON(c, http.StatusBadRequest, fmt.Sprintf("%s must be a positive integer", param.name))
+            return
+        }
+        *param.target = uint(id)
+    }
+    if filter.EntityID > 0 && filter.Entity == "" {  // ID saja ambigu: product 42 dan user 42 berbeda
+        utils.ErrorJSON(c, http.StatusBadRequest, "id requires entity")
+        return
+    }
+    if raw := c.Query("limit"); raw != "" {
+        limit, err := strconv.Atoi(raw)
+        if err != nil || limit < 1 || limit > entity.MaxListLimit {
+            utils.ErrorJSON(c, http.StatusBadRequest, fmt.Sprintf("limit must be between 1 and %d", entity.MaxListLimit))
+            return
+        }
+        filter.Limit = limit
+    }
+
+    list, err := h.service.List(c.Request.Context(), filter)  // Memanggil service untuk mendapatkan audit log
+    if err != nil {
+        utils.HandleError(c, http.StatusInternalServerError, err)  // Respons error jika gagal
+        return
+    }
+
+    c.JSON(http.StatusOK, utils.SuccessResponse(list))  // Respons sukses dengan daftar entri audit
+}
+
+
+// {{{ Penjelasan Fungsi Handler }}}
+
+/*
+## Penjelasan Detail
+File handler.go ini berisi implementasi handler HTTP untuk modul Audit. Berikut penjelasan detailnya:
+
+1. Endpoint :
+
+    - List : Audit log terbaru dengan ?entity, ?id, ?actor_id, ?action, ?limit (1-500, default 50), dan ?before_id
+    - Contoh: GET /api/audit?entity=product&id=42 untuk riwayat perubahan product 42
+2. Penanganan Error :
+
+    - Query string tidak valid atau ?id tanpa ?entity: Status 400 Bad Request
+    - Error internal: Status 500 Internal Server Error
+*/
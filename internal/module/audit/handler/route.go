@@ -0,0 +1,29 @@
+package handler                                // Mendefinisikan package handler untuk modul audit
+
+import (
+    "rest-api-go/pkg/middleware"               // Mengimpor middleware RequireAuth
+
+    "github.com/gin-gonic/gin"                 // Mengimpor framework web Gin
+)
+
+func RegisterRoutes(router *gin.RouterGroup, handler *AuditHandler) {  // Fungsi untuk mendaftarkan route
+    audit := router.Group("/audit", middleware.RequireAuth())  // Membuat grup route dengan prefix "/audit", wajib login karena berisi data user dan IP
+    {
+        audit.GET("", handler.List)            // Mendaftarkan endpoint GET untuk mendapatkan audit log
+    }
+}
+
+
+// {{{ Penjelasan Fungsi RegisterRoutes }}}
+
+/*
+## Penjelasan Detail
+File route.go ini berisi konfigurasi routing untuk modul Audit. Berikut penjelasan detailnya:
+
+1. Endpoint API :
+
+    - GET /audit : Mendapatkan audit log dengan filter, misal ?entity=product&id=42
+2. Autentikasi :
+
+    - Endpoint memakai middleware.RequireAuth, request tanpa token bearer yang valid dijawab 401
+*/
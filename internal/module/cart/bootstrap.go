@@ -0,0 +1,62 @@
+package cart                                   // Mendefinisikan package cart
+
+import (
+	"context"                                      // Package untuk context job
+	"log/slog"                                     // Package structured logging bawaan Go
+	"rest-api-go/internal/module/cart/handler"     // Mengimpor package handler dari modul cart
+	"rest-api-go/internal/module/cart/service"     // Mengimpor package service dari modul cart
+	"rest-api-go/pkg/jobs"                         // Mengimpor runner job latar belakang
+	"time"                                         // Package untuk TTL cart
+
+	"github.com/gin-gonic/gin"                     // Mengimpor framework web Gin
+	"gorm.io/gorm"                                 // Mengimpor ORM GORM
+)
+
+const (
+	PurgeExpiredJob = "cart.purge_expired"     // Tipe job untuk menghapus cart terbengkalai
+	purgeSchedule   = "@hourly"                // Seberapa sering cart terbengkalai dihapus
+)
+
+// Initialize - Fungsi untuk menginisialisasi modul cart
+func Initialize(db *gorm.DB, router *gin.RouterGroup, ttl time.Duration, runner *jobs.Runner) {  // Fungsi untuk inisialisasi modul dengan parameter database, router, TTL cart, dan runner job
+	// Initialize service
+	cartService := service.NewCartService(db, ttl)  // Membuat instance service cart dengan menyuntikkan database dan TTL
+
+	// Initialize handler
+	cartHandler := handler.NewCartHandler(cartService, ttl)  // Membuat instance handler dengan menyuntikkan service
+
+	// Register routes
+	handler.RegisterRoutes(router, cartHandler)        // Mendaftarkan route untuk modul cart
+
+	// Register jobs
+	runner.Register(PurgeExpiredJob, func(ctx context.Context, _ *jobs.Job) error {  // Menghapus cart terbengkalai
+		purged, err := cartService.PurgeExpired(ctx)
+		if purged > 0 {
+			slog.InfoContext(ctx, "expired carts purged", "count", purged)
+		}
+		return err
+	}, jobs.TypeOptions{})
+	runner.Schedule(PurgeExpiredJob, purgeSchedule, PurgeExpiredJob, nil)  // Satu kali per jam untuk semua instance aplikasi
+}
+
+
+// {{{ Penjelasan Fungsi Initialize }}}
+
+/*
+## Penjelasan Detail
+File bootstrap.go ini berfungsi sebagai titik masuk (entry point) untuk modul cart. Berikut penjelasan detailnya:
+
+1. Initialize :
+
+	- Menerima TTL cart (CART_TTL) selain database dan router
+	- Membuat service, handler, dan mendaftarkan route di bawah /cart
+2. Job cart.purge_expired :
+
+	- Menghapus cart yang tidak diubah lebih lama dari TTL setiap jam
+	- Dijadwalkan dengan runner.Schedule, sehingga hanya satu instance yang menjalankannya walau aplikasi berjalan di beberapa instance
+	- Jika gagal, job dicoba lagi dengan backoff oleh runner
+3. Hubungan dengan Aplikasi Utama :
+
+	- Fungsi Initialize dipanggil dari main.go
+Cart yang kedaluwarsa juga langsung dihapus saat diakses, sehingga job ini hanya membersihkan cart yang tidak pernah dibuka lagi.
+*/
@@ -0,0 +1,124 @@
+package entity                                // Mendefinisikan package entity untuk modul cart
+
+import (
+    "rest-api-go/pkg/money"                   // Package money untuk harga dengan mata uang
+    "rest-api-go/pkg/validation"              // Package validation dengan validator bersama
+    "time"                                    // Package time untuk tipe data waktu
+
+    "gorm.io/gorm"                            // Mengimpor ORM GORM untuk hook
+)
+
+type Cart struct {                            // Mendefinisikan struct Cart
+    ID        uint       `json:"id" gorm:"primaryKey"`  // ID cart sebagai primary key
+    UserID    *uint      `json:"user_id" gorm:"uniqueIndex"`  // Pemilik cart jika user sudah login, satu cart per user
+    SessionID *string    `json:"-" gorm:"size:64;uniqueIndex"`  // ID sesi anonim, tidak pernah dikirim di body respons
+    Items     []CartItem `json:"items" gorm:"foreignKey:CartID"`  // Relasi one-to-many dengan CartItem
+    CreatedAt time.Time  `json:"created_at"`  // Waktu pembuatan record
+    UpdatedAt time.Time  `json:"updated_at"`  // Waktu aktivitas terakhir, dipakai untuk menentukan cart terbengkalai
+}
+
+type CartItem struct {                        // Mendefinisikan struct CartItem
+    ID           uint        `json:"id" gorm:"primaryKey"`  // ID item sebagai primary key
+    CartID       uint        `json:"cart_id" gorm:"uniqueIndex:idx_cart_item;not null"`  // ID cart pemilik item
+    ProductID    uint        `json:"product_id" gorm:"uniqueIndex:idx_cart_item;not null"`  // ID product
+    VariantID    uint        `json:"variant_id" gorm:"uniqueIndex:idx_cart_item;not null;default:0"`  // ID varian, 0 untuk product tanpa varian; satu baris per product dan varian dalam cart
+    Quantity     int64       `json:"quantity" gorm:"not null"`  // Jumlah barang
+    AddedPrice   money.Money `json:"added_price" gorm:"-"`  // Harga product saat item ditambahkan, untuk mendeteksi perubahan harga
+    AddedAmount  string      `json:"-" gorm:"column:added_price;type:decimal(19,4);not null"`  // Kolom bayangan: harga sebagai DECIMAL
+    Currency     string      `json:"-" gorm:"column:currency;type:char(3);not null"`  // Kolom bayangan: kode mata uang ISO 4217
+    CreatedAt    time.Time   `json:"created_at"`  // Waktu pembuatan record
+    UpdatedAt    time.Time   `json:"updated_at"`  // Waktu pembaruan record
+}
+
+type ItemRequest struct {                     // Mendefinisikan struct body request untuk menambah item
+    ProductID uint  `json:"product_id" binding:"required"`  // ID product
+    VariantID *uint `json:"variant_id"`       // ID varian, wajib untuk product yang memiliki varian
+    Quantity  int64 `json:"quantity" binding:"required,gt=0,lte=999"`  // Jumlah yang ditambahkan
+}
+
+type QuantityRequest struct {                 // Mendefinisikan struct body request untuk mengubah jumlah item
+    Quantity int64 `json:"quantity" binding:"required,gt=0,lte=999"`  // Jumlah baru
+}
+
+func (r *ItemRequest) Validate() error {      // Method untuk validasi struct ItemRequest
+    return validation.Struct(r)               // Memvalidasi struct berdasarkan tag binding dengan validator bersama
+}
+
+func (r *QuantityRequest) Validate() error {  // Method untuk validasi struct QuantityRequest
+    return validation.Struct(r)               // Memvalidasi struct berdasarkan tag binding dengan validator bersama
+}
+
+type ItemStatus string                        // Status item setelah dibandingkan dengan product saat ini
+
+const (
+    ItemOK                ItemStatus = "ok"                  // Product masih ada dan harga tidak berubah
+    ItemPriceChanged      ItemStatus = "price_changed"       // Harga product berubah sejak item ditambahkan
+    ItemUnavailable       ItemStatus = "unavailable"         // Product atau varian sudah dihapus
+    ItemInsufficientStock ItemStatus = "insufficient_stock"  // Stok product kurang dari quantity
+)
+
+type CartView struct {                        // Mendefinisikan struct respons cart dengan harga terkini
+    ID        uint           `json:"id"`      // ID cart (0 jika cart belum dibuat)
+    UserID    *uint          `json:"user_id"` // Pemilik cart
+    Items     []ItemView     `json:"items"`   // Item dengan harga terkini
+    Subtotal  *money.Money   `json:"subtotal"`  // Jumlah total item yang masih tersedia, null jika cart kosong
+    HasIssues bool           `json:"has_issues"`  // True jika ada item yang berubah, hilang, atau stoknya kurang
+    ExpiresAt *time.Time     `json:"expires_at"`  // Waktu cart dianggap terbengkalai dan dihapus
+}
+
+type ItemView struct {                        // Mendefinisikan struct satu item dalam CartView
+    ProductID  uint         `json:"product_id"`  // ID product
+    VariantID  *uint        `json:"variant_id"`  // ID varian, null untuk product tanpa varian
+    Title      string       `json:"title"`       // Judul product saat ini, beserta label varian
+    Quantity   int64        `json:"quantity"`    // Jumlah barang
+    UnitPrice  *money.Money `json:"unit_price"`  // Harga product atau varian saat ini (null jika dihapus)
+    LineTotal  *money.Money `json:"line_total"`  // UnitPrice x Quantity
+    AddedPrice money.Money  `json:"added_price"` // Harga saat item ditambahkan
+    Stock      int64        `json:"stock"`       // Stok product atau varian saat ini
+    Status     ItemStatus   `json:"status"`      // Hasil perbandingan dengan product saat ini
+}
+
+func (i *CartItem) BeforeSave(tx *gorm.DB) error {  // Hook GORM sebelum create/update
+    i.AddedAmount = i.AddedPrice.Decimal(money.StorageScale)  // Salin harga ke kolom DECIMAL(19,4)
+    i.Currency = i.AddedPrice.Currency        // Salin mata uang ke kolom currency
+    return nil
+}
+
+func (i *CartItem) AfterFind(tx *gorm.DB) error {  // Hook GORM setelah data dibaca dari database
+    price, err := money.Parse(i.AddedAmount, i.Currency)  // Ubah DECIMAL menjadi minor unit tanpa float
+    if err != nil {
+        return err
+    }
+    i.AddedPrice = price
+    return nil
+}
+
+
+//  {{{ Penjelasan Struktur Cart }}}
+
+/*
+## Penjelasan Detail
+File cart.go ini mendefinisikan struktur data untuk modul Cart. Berikut penjelasan detailnya:
+
+1. Cart :
+
+    - Dimiliki oleh satu user (UserID) atau satu sesi anonim (SessionID), keduanya unik
+    - UpdatedAt diperbarui setiap kali item berubah dan dipakai untuk menghapus cart terbengkalai
+2. CartItem :
+
+    - Satu baris per product dan varian dalam cart (unique index cart_id + product_id + variant_id)
+    - VariantID bernilai 0 untuk product tanpa varian agar unique index tetap berlaku (NULL tidak dianggap sama oleh MySQL)
+    - AddedPrice menyimpan harga saat item ditambahkan, disimpan sebagai DECIMAL seperti harga Product
+3. CartView dan ItemView :
+
+    - Respons API yang selalu dihitung ulang dari harga product saat ini
+    - Status item: ok, price_changed, unavailable (product atau varian dihapus), insufficient_stock
+    - Item varian memakai harga khusus dan stok varian, judulnya ditambah label varian, misal "Kaos (Red / M)"
+    - Subtotal hanya menghitung item yang product-nya masih ada
+    - HasIssues memberi tahu client bahwa cart perlu ditinjau sebelum checkout
+4. Request :
+
+    - ItemRequest : Menambah product ke cart (quantity ditambahkan ke quantity yang sudah ada), variant_id wajib untuk product bervarian
+    - QuantityRequest : Mengganti quantity item
+Cart tidak menyimpan harga yang dibayar; harga final dibekukan saat checkout di modul order.
+*/
@@ -0,0 +1,210 @@
+package handler                                // Mendefinisikan package handler untuk modul cart
+
+import (
+    "crypto/rand"                              // Package untuk membuat ID sesi acak
+    "encoding/hex"                             // Package untuk encoding ID sesi
+    "net/http"                                 // Package untuk konstanta HTTP
+    "rest-api-go/internal/module/cart/entity"  // Mengimpor entity cart
+    "rest-api-go/internal/module/cart/service" // Mengimpor service cart
+    "rest-api-go/pkg/middleware"               // Mengimpor helper user yang login
+    "rest-api-go/pkg/utils"                    // Mengimpor utilitas aplikasi
+    "strconv"                                  // Package untuk konversi string
+    "time"                                     // Package untuk umur cookie
+
+    "github.com/gin-gonic/gin"                 // Framework web Gin
+)
+
+const (
+    SessionHeader = "X-Cart-Session"           // Header untuk ID sesi cart anonim
+    SessionCookie = "cart_session"             // Cookie untuk ID sesi cart anonim (untuk browser)
+)
+
+type CartHandler struct {                      // Mendefinisikan struct handler
+    service *service.CartService               // Dependency service
+    ttl     time.Duration                      // Umur cookie sesi, sama dengan TTL cart
+}
+
+func NewCartHandler(service *service.CartService, ttl time.Duration) *CartHandler {  // Constructor untuk handler
+    return &CartHandler{service, ttl}          // Mengembalikan instance handler dengan service yang diinjeksi
+}
+
+func (h *CartHandler) Get(c *gin.Context) {    // Handler untuk mendapatkan cart
+    cart, err := h.service.Get(c.Request.Context(), h.owner(c, false))  // Memanggil service untuk mendapatkan cart
+    if err != nil {
+        utils.HandleError(c, http.StatusInternalServerError, err)  // Respons error jika gagal
+        return
+    }
+
+    c.JSON(http.StatusOK, utils.SuccessResponse(cart))  // Respons sukses dengan data cart
+}
+
+func (h *CartHandler) AddItem(c *gin.Context) {  // Handler untuk menambah product ke cart
+    var req entity.ItemRequest                 // Variabel untuk menampung data item dari request
+    if err := utils.BindJSON(c, &req); err != nil {  // Binding JSON request ke struct secara ketat
+        utils.HandleError(c, http.StatusBadRequest, err)  // Respons error jika binding gagal
+        return
+    }
+
+    cart, err := h.service.AddItem(c.Request.Context(), h.owner(c, true), &req)  // Memanggil service untuk menambah item
+    if err != nil {
+        utils.HandleError(c, http.StatusInternalServerError, err)  // Respons error (404 atau 500)
+        return
+    }
+
+    c.JSON(http.StatusOK, utils.SuccessResponse(cart))  // Respons sukses dengan data cart terbaru
+}
+
+func (h *CartHandler) UpdateItem(c *gin.Context) {  // Handler untuk mengganti quantity item
+    productID, err := strconv.ParseUint(c.Param("productId"), 10, 32)  // Mengambil dan mengkonversi parameter productId
+    if err != nil {
+        utils.ErrorJSON(c, http.StatusBadRequest, "Invalid Product ID")  // Respons error jika ID tidak valid
+        return
+    }
+    variantID, ok := variantParam(c)           // Varian opsional ?variant_id=
+    if !ok {
+        return
+    }
+
+    var req entity.QuantityRequest             // Variabel untuk menampung quantity baru
+    if err := utils.BindJSON(c, &req); err != nil {  // Binding JSON request ke struct secara ketat
+        utils.HandleError(c, http.StatusBadRequest, err)  // Respons error jika binding gagal
+        return
+    }
+
+    cart, err := h.service.UpdateItem(c.Request.Context(), h.owner(c, false), uint(productID), variantID, &req)  // Memanggil service untuk mengubah item
+    if err != nil {
+        utils.HandleError(c, http.StatusInternalServerError, err)  // Respons error (404 atau 500)
+        return
+    }
+
+    c.JSON(http.StatusOK, utils.SuccessResponse(cart))  // Respons sukses dengan data cart terbaru
+}
+
+func (h *CartHandler) RemoveItem(c *gin.Context) {  // Handler untuk menghapus item dari cart
+    productID, err := strconv.ParseUint(c.Param("productId"), 10, 32)  // Mengambil dan mengkonversi parameter productId
+    if err != nil {
+        utils.ErrorJSON(c, http.StatusBadRequest, "Invalid Product ID")  // Respons error jika ID tidak valid
+        return
+    }
+    variantID, ok := variantParam(c)           // Varian opsional ?variant_id=
+    if !ok {
+        return
+    }
+
+    cart, err := h.service.RemoveItem(c.Request.Context(), h.owner(c, false), uint(productID), variantID)  // Memanggil service untuk menghapus item
+    if err != nil {
+        utils.HandleError(c, http.StatusInternalServerError, err)  // Respons error (404 atau 500)
+        return
+    }
+
+    c.JSON(http.StatusOK, utils.SuccessResponse(cart))  // Respons sukses dengan data cart terbaru
+}
+
+func (h *CartHandler) Clear(c *gin.Context) {  // Handler untuk mengosongkan cart
+    if err := h.service.Clear(c.Request.Context(), h.owner(c, false)); err != nil {  // Memanggil service untuk mengosongkan cart
+        utils.HandleError(c, http.StatusInternalServerError, err)  // Respons error jika gagal
+        return
+    }
+
+    c.JSON(http.StatusOK, utils.SuccessResponse("Cart cleared successfully"))  // Respons sukses dengan pesan
+}
+
+func (h *CartHandler) Merge(c *gin.Context) {  // Handler untuk menggabungkan cart anonim ke cart user setelah login
+    userID, _ := middleware.CurrentUserID(c)   // Route ini dilindungi RequireAuth
+    cart, err := h.service.Merge(c.Request.Context(), userID, sessionFromRequest(c))  // Memanggil service untuk menggabungkan cart
+    if err != nil {
+        utils.HandleError(c, http.StatusInternalServerError, err)  // Respons error jika gagal
+        return
+    }
+
+    c.SetCookie(SessionCookie, "", -1, "/", "", false, true)  // Sesi anonim sudah tidak dipakai
+    c.JSON(http.StatusOK, utils.SuccessResponse(cart))  // Respons sukses dengan data cart user
+}
+
+func (h *CartHandler) owner(c *gin.Context, create bool) service.Owner {  // Fungsi untuk menentukan pemilik cart dari request
+    if userID, ok := middleware.CurrentUserID(c); ok {  // User yang login selalu memakai cart miliknya
+        return service.Owner{UserID: userID}
+    }
+
+    session := sessionFromRequest(c)
+    if session == "" && create {              // Sesi baru untuk item pertama dari client anonim
+        session = newSessionID()
+    }
+    if session != "" {                        // Kirim kembali sesi agar client dapat menyimpannya
+        c.Header(SessionHeader, session)
+        c.SetSameSite(http.SameSiteLaxMode)
+        c.SetCookie(SessionCookie, session, int(h.ttl.Seconds()), "/", "", false, true)
+    }
+    return service.Owner{SessionID: session}
+}
+
+func variantParam(c *gin.Context) (uint, bool) {  // Fungsi untuk membaca ?variant_id=, 0 jika tidak diisi
+    raw := c.Query("variant_id")
+    if raw == "" {
+        return 0, true                        // Item product tanpa varian
+    }
+    id, err := strconv.ParseUint(raw, 10, 32)
+    if err != nil || id == 0 {
+        utils.ErrorJSON(c, http.StatusBadRequest, "Invalid Variant ID")  // Respons error jika ID tidak valid
+        return 0, false
+    }
+    return uint(id), true
+}
+
+func sessionFromRequest(c *gin.Context) string {  // Fungsi untuk membaca ID sesi dari header atau cookie
+    session := c.GetHeader(SessionHeader)
+    if session == "" {
+        session, _ = c.Cookie(SessionCookie)
+    }
+    if !validSessionID(session) {
+        return ""                             // Abaikan nilai yang tidak dibuat oleh server
+    }
+    return session
+}
+
+func validSessionID(id string) bool {         // Fungsi untuk memeriksa format ID sesi (32 karakter hex)
+    if len(id) != 32 {
+        return false
+    }
+    _, err := hex.DecodeString(id)
+    return err == nil
+}
+
+func newSessionID() string {                  // Fungsi untuk membuat ID sesi acak
+    b := make([]byte, 16)
+    _, _ = rand.Read(b)
+    return hex.EncodeToString(b)
+}
+
+
+// {{{ Penjelasan Fungsi Handler }}}
+
+/*
+## Penjelasan Detail
+File handler.go ini berisi implementasi handler HTTP untuk modul Cart. Berikut penjelasan detailnya:
+
+1. Operasi :
+
+    - Get : Mendapatkan cart dengan harga terkini
+    - AddItem : Menambah product ke cart
+    - UpdateItem : Mengganti quantity item, ?variant_id= untuk item varian
+    - RemoveItem : Menghapus item, ?variant_id= untuk item varian
+    - Clear : Mengosongkan cart
+    - Merge : Menggabungkan cart anonim ke cart user yang login
+2. Pemilik Cart :
+
+    - Dengan token bearer yang valid, cart milik user tersebut
+    - Tanpa login, cart diidentifikasi oleh header X-Cart-Session atau cookie cart_session
+    - Sesi baru dibuat saat item pertama ditambahkan dan dikirim kembali di header dan cookie
+    - Nilai sesi yang bukan 32 karakter hex diabaikan
+3. Merge :
+
+    - Client memanggil POST /api/cart/merge setelah login sambil tetap mengirim sesi anonimnya
+    - Cookie sesi anonim dihapus setelah digabung
+4. Penanganan Error :
+
+    - Error binding JSON atau validasi: Status 400, 413, atau 415
+    - Product atau item tidak ditemukan: Status 404 Not Found
+    - Merge tanpa login: Status 401 Unauthorized (dari RequireAuth)
+Struktur handler ini mengikuti modul lainnya.
+*/
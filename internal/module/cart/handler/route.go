@@ -0,0 +1,42 @@
+package handler                                // Mendefinisikan package handler untuk modul cart
+
+import (
+    "rest-api-go/pkg/middleware"               // Mengimpor middleware RequireAuth
+
+    "github.com/gin-gonic/gin"                 // Mengimpor framework web Gin
+)
+
+func RegisterRoutes(router *gin.RouterGroup, handler *CartHandler) {  // Fungsi untuk mendaftarkan route
+    cart := router.Group("/cart")              // Membuat grup route dengan prefix "/cart"
+    {
+        cart.GET("", handler.Get)              // Mendaftarkan endpoint GET untuk mendapatkan cart
+        cart.DELETE("", handler.Clear)         // Mendaftarkan endpoint DELETE untuk mengosongkan cart
+        cart.POST("/items", handler.AddItem)   // Mendaftarkan endpoint POST untuk menambah item
+        cart.PUT("/items/:productId", handler.UpdateItem)  // Mendaftarkan endpoint PUT untuk mengganti quantity item
+        cart.DELETE("/items/:productId", handler.RemoveItem)  // Mendaftarkan endpoint DELETE untuk menghapus item
+        cart.POST("/merge", middleware.RequireAuth(), handler.Merge)  // Mendaftarkan endpoint POST untuk menggabungkan cart anonim, wajib login
+    }
+}
+
+
+// {{{ Penjelasan Fungsi RegisterRoutes }}}
+
+/*
+## Penjelasan Detail
+File route.go ini berisi konfigurasi routing untuk modul Cart. Berikut penjelasan detailnya:
+
+1. Endpoint API :
+
+    - GET /cart : Mendapatkan cart
+    - DELETE /cart : Mengosongkan cart
+    - POST /cart/items : Menambah product ke cart
+    - PUT /cart/items/:productId : Mengganti quantity item
+    - DELETE /cart/items/:productId : Menghapus item
+    - POST /cart/merge : Menggabungkan cart anonim ke cart user (wajib login)
+2. Parameter URL :
+
+    - :productId : ID product; untuk product bervarian tambahkan ?variant_id=, karena item cart diidentifikasi dengan product dan varian
+3. Autentikasi :
+
+    - Semua endpoint menerima user yang login maupun anonim, kecuali merge yang memakai RequireAuth
+*/
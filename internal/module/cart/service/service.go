@@ -0,0 +1,506 @@
+package service                                // Mendefinisikan package service untuk modul cart
+
+import (
+    "context"                                 // Package untuk context request
+    "errors"                                  // Package untuk pengecekan error
+    "fmt"                                     // Package untuk menyusun judul item varian
+    "net/http"                                // Package untuk konstanta HTTP
+    "rest-api-go/internal/module/cart/entity" // Mengimpor entity cart
+    productEntity "rest-api-go/internal/module/product/entity"  // Mengimpor entity product
+    "rest-api-go/pkg/money"                   // Package money untuk menghitung subtotal
+    "rest-api-go/pkg/tracing"                 // Mengimpor package tracing untuk span service
+    "rest-api-go/pkg/utils"                   // Mengimpor utilitas aplikasi untuk HTTPError
+    "time"                                    // Package untuk masa berlaku cart
+
+    "gorm.io/gorm"                            // Mengimpor ORM GORM
+    "gorm.io/gorm/clause"                     // Klausa SELECT ... FOR UPDATE
+)
+
+const maxQuantity = 999                       // Batas quantity per item, sama dengan tag binding lte=999
+
+var (
+    ErrProductNotFound = utils.NewHTTPError(http.StatusNotFound, "Product not found")  // Product yang ditambahkan tidak ada
+    ErrItemNotFound    = utils.NewHTTPError(http.StatusNotFound, "Item not found in cart")  // Product tidak ada di cart
+    ErrVariantNotFound = utils.NewHTTPError(http.StatusNotFound, "Variant not found")  // Varian tidak ada atau milik product lain
+    ErrVariantRequired = utils.NewHTTPError(http.StatusBadRequest, "variant_id is required for products with variants")  // Product bervarian dibeli per varian
+    ErrTotalTooLarge   = utils.NewHTTPError(http.StatusUnprocessableEntity, "cart total is too large")  // Total item atau subtotal melebihi batas money.ErrOverflow
+)
+
+// Owner - pemilik cart: user yang login atau sesi anonim
+type Owner struct {
+    UserID    uint                            // ID user, 0 jika anonim
+    SessionID string                          // ID sesi anonim, kosong jika user login
+}
+
+func (o Owner) scope(db *gorm.DB) *gorm.DB {  // Method untuk memfilter cart milik owner
+    if o.UserID != 0 {
+        return db.Where("user_id = ?", o.UserID)
+    }
+    return db.Where("session_id = ?", o.SessionID)
+}
+
+func (o Owner) isZero() bool {                // Method untuk memeriksa owner kosong (anonim tanpa sesi)
+    return o.UserID == 0 && o.SessionID == ""
+}
+
+type CartService struct {                     // Mendefinisikan struct service
+    db  *gorm.DB                              // Dependency database
+    ttl time.Duration                         // Lama cart tanpa aktivitas sebelum dianggap terbengkalai
+}
+
+func NewCartService(db *gorm.DB, ttl time.Duration) *CartService {  // Constructor untuk service
+    return &CartService{db, ttl}              // Mengembalikan instance service dengan database dan TTL yang diinjeksi
+}
+
+func (s *CartService) Get(ctx context.Context, owner Owner) (*entity.CartView, error) {  // Method untuk mendapatkan cart dengan harga terkini
+    ctx, span := tracing.Start(ctx, "CartService.Get")  // Membuat child span untuk method service
+    defer span.End()                          // Menutup span saat method selesai
+
+    db := s.db.WithContext(ctx)
+    cart, err := s.find(db, owner, false)
+    if err != nil {
+        return nil, err
+    }
+    return s.view(db, cart, owner)
+}
+
+func (s *CartService) AddItem(ctx context.Context, owner Owner, req *entity.ItemRequest) (*entity.CartView, error) {  // Method untuk menambah product ke cart
+    ctx, span := tracing.Start(ctx, "CartService.AddItem")  // Membuat child span untuk method service
+    defer span.End()                          // Menutup span saat method selesai
+
+    if err := req.Validate(); err != nil {    // Validasi data request
+        return nil, err
+    }
+
+    var cart *entity.Cart
+    err := s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+        var variantID uint                    // 0 untuk product tanpa varian
+        if req.VariantID != nil {
+            variantID = *req.VariantID
+        }
+        price, err := currentPrice(tx, req.ProductID, variantID)  // Product (dan varian) harus ada saat ditambahkan
+        if err != nil {
+            return err
+        }
+
+        if cart, err = s.findOrCreate(tx, owner); err != nil {
+            return err
+        }
+
+        var item entity.CartItem
+        err = tx.Where("cart_id = ? AND product_id = ? AND variant_id = ?", cart.ID, req.ProductID, variantID).First(&item).Error
+        switch {
+        case errors.Is(err, gorm.ErrRecordNotFound):  // Product belum ada di cart
+            item = entity.CartItem{CartID: cart.ID, ProductID: req.ProductID, VariantID: variantID, Quantity: req.Quantity}
+        case err != nil:
+            return err
+        default:                              // Product sudah ada, tambahkan quantity
+            item.Quantity = min(item.Quantity+req.Quantity, maxQuantity)
+        }
+        item.AddedPrice = price               // Harga terbaru yang dilihat user
+        if err := tx.Save(&item).Error; err != nil {
+            return err
+        }
+        if err := s.checkTotals(tx, owner); err != nil {  // Cart yang totalnya tidak bisa dihitung tidak di-commit
+            return err
+        }
+        return s.touch(tx, cart)
+    })
+    if err != nil {
+        return nil, err
+    }
+    return s.reload(ctx, owner)
+}
+
+func (s *CartService) UpdateItem(ctx context.Context, owner Owner, productID, variantID uint, req *entity.QuantityRequest) (*entity.CartView, error) {  // Method untuk mengganti quantity item
+    ctx, span := tracing.Start(ctx, "CartService.UpdateItem")  // Membuat child span untuk method service
+    defer span.End()                          // Menutup span saat method selesai
+
+    if err := req.Validate(); err != nil {    // Validasi data request
+        return nil, err
+    }
+
+    err := s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+        cart, item, err := s.findItem(tx, owner, productID, variantID)
+        if err != nil {
+            return err
+        }
+        updates := map[string]interface{}{"quantity": req.Quantity}
+        if price, err := currentPrice(tx, productID, variantID); err == nil {  // Product masih ada, perbarui harga yang sudah dilihat user
+            item.AddedPrice = price
+            updates["added_price"] = price.Decimal(money.StorageScale)
+            updates["currency"] = price.Currency
+        }
+        if err := tx.Model(item).UpdateColumns(updates).Error; err != nil {
+            return err
+        }
+        if err := s.checkTotals(tx, owner); err != nil {  // Cart yang totalnya tidak bisa dihitung tidak di-commit
+            return err
+        }
+        return s.touch(tx, cart)
+    })
+    if err != nil {
+        return nil, err
+    }
+    return s.reload(ctx, owner)
+}
+
+func (s *CartService) RemoveItem(ctx context.Context, owner Owner, productID, variantID uint) (*entity.CartView, error) {  // Method untuk menghapus item dari cart
+    ctx, span := tracing.Start(ctx, "CartService.RemoveItem")  // Membuat child span untuk method service
+    defer span.End()                          // Menutup span saat method selesai
+
+    err := s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+        cart, item, err := s.findItem(tx, owner, productID, variantID)
+        if err != nil {
+            return err
+        }
+        if err := tx.Delete(item).Error; err != nil {
+            return err
+        }
+        return s.touch(tx, cart)
+    })
+    if err != nil {
+        return nil, err
+    }
+    return s.reload(ctx, owner)
+}
+
+func (s *CartService) Clear(ctx context.Context, owner Owner) error {  // Method untuk mengosongkan cart
+    ctx, span := tracing.Start(ctx, "CartService.Clear")  // Membuat child span untuk method service
+    defer span.End()                          // Menutup span saat method selesai
+
+    return s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+        cart, err := s.find(tx, owner, true)
+        if err != nil || cart == nil {
+            return err                        // Cart tidak ada, tidak ada yang dihapus
+        }
+        return deleteCarts(tx, []uint{cart.ID})
+    })
+}
+
+func (s *CartService) Merge(ctx context.Context, userID uint, sessionID string) (*entity.CartView, error) {  // Method untuk menggabungkan cart anonim ke cart user
+    ctx, span := tracing.Start(ctx, "CartService.Merge")  // Membuat child span untuk method service
+    defer span.End()                          // Menutup span saat method selesai
+
+    owner := Owner{UserID: userID}
+    err := s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+        if sessionID == "" {
+            return nil                        // Tidak ada cart anonim
+        }
+        anonymous, err := s.find(tx, Owner{SessionID: sessionID}, true)
+        if err != nil || anonymous == nil {
+            return err
+        }
+        if len(anonymous.Items) == 0 {
+            return deleteCarts(tx, []uint{anonymous.ID})
+        }
+
+        cart, err := s.findOrCreate(tx, owner)
+        if err != nil {
+            return err
+        }
+        existing := make(map[itemKey]*entity.CartItem, len(cart.Items))
+        for i := range cart.Items {
+            existing[keyOf(&cart.Items[i])] = &cart.Items[i]
+        }
+
+        for _, item := range anonymous.Items {  // Pindahkan atau gabungkan setiap item
+            if current, ok := existing[keyOf(&item)]; ok {  // Product dan varian sudah ada di cart user, jumlahkan quantity
+                quantity := min(current.Quantity+item.Quantity, maxQuantity)
+                if err := tx.Model(current).UpdateColumn("quantity", quantity).Error; err != nil {
+                    return err
+                }
+                continue
+            }
+            if err := tx.Model(&item).UpdateColumn("cart_id", cart.ID).Error; err != nil {  // Pindahkan item ke cart user
+                return err
+            }
+        }
+        if err := deleteCarts(tx, []uint{anonymous.ID}); err != nil {  // Item yang tersisa adalah duplikat yang sudah dijumlahkan
+            return err
+        }
+        if err := s.checkTotals(tx, owner); err != nil {  // Cart yang totalnya tidak bisa dihitung tidak di-commit
+            return err
+        }
+        return s.touch(tx, cart)
+    })
+    if err != nil {
+        return nil, err
+    }
+    return s.reload(ctx, owner)
+}
+
+func (s *CartService) PurgeExpired(ctx context.Context) (int64, error) {  // Method untuk menghapus cart terbengkalai
+    ctx, span := tracing.Start(ctx, "CartService.PurgeExpired")  // Membuat child span untuk method service
+    defer span.End()                          // Menutup span saat method selesai
+
+    if s.ttl <= 0 {
+        return 0, nil                         // CART_TTL=0 mematikan expiry, sama seperti expired()
+    }
+    var ids []uint
+    err := s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+        if err := tx.Model(&entity.Cart{}).Where("updated_at < ?", time.Now().Add(-s.ttl)).Pluck("id", &ids).Error; err != nil {
+            return err
+        }
+        if len(ids) == 0 {
+            return nil
+        }
+        return deleteCarts(tx, ids)
+    })
+    return int64(len(ids)), err
+}
+
+func (s *CartService) find(tx *gorm.DB, owner Owner, lock bool) (*entity.Cart, error) {  // Fungsi untuk mencari cart milik owner, nil jika tidak ada atau sudah kedaluwarsa
+    if owner.isZero() {
+        return nil, nil                       // Anonim tanpa sesi belum memiliki cart
+    }
+    query := owner.scope(tx).Preload("Items", func(db *gorm.DB) *gorm.DB { return db.Order("id") })
+    if lock {
+        query = query.Clauses(clause.Locking{Strength: "UPDATE"})  // Kunci cart selama transaksi
+    }
+    var cart entity.Cart
+    err := query.First(&cart).Error
+    if errors.Is(err, gorm.ErrRecordNotFound) {
+        return nil, nil
+    }
+    if err != nil {
+        return nil, err
+    }
+    if s.expired(&cart) {                     // Cart terbengkalai dihapus saat diakses, tanpa menunggu PurgeExpired
+        return nil, deleteCarts(tx, []uint{cart.ID})
+    }
+    return &cart, nil
+}
+
+func (s *CartService) findOrCreate(tx *gorm.DB, owner Owner) (*entity.Cart, error) {  // Fungsi untuk mencari atau membuat cart milik owner
+    cart, err := s.find(tx, owner, true)
+    if err != nil || cart != nil {
+        return cart, err
+    }
+    cart = &entity.Cart{}
+    if owner.UserID != 0 {
+        cart.UserID = &owner.UserID
+    } else {
+        cart.SessionID = &owner.SessionID
+    }
+    return cart, tx.Create(cart).Error
+}
+
+func (s *CartService) findItem(tx *gorm.DB, owner Owner, productID, variantID uint) (*entity.Cart, *entity.CartItem, error) {  // Fungsi untuk mencari item dalam cart milik owner
+    cart, err := s.find(tx, owner, true)
+    if err != nil {
+        return nil, nil, err
+    }
+    if cart == nil {
+        return nil, nil, ErrItemNotFound
+    }
+    for i := range cart.Items {
+        if cart.Items[i].ProductID == productID && cart.Items[i].VariantID == variantID {
+            return cart, &cart.Items[i], nil
+        }
+    }
+    return nil, nil, ErrItemNotFound
+}
+
+func (s *CartService) touch(tx *gorm.DB, cart *entity.Cart) error {  // Fungsi untuk memperbarui waktu aktivitas terakhir cart
+    return tx.Model(cart).UpdateColumn("updated_at", time.Now()).Error
+}
+
+func (s *CartService) expired(cart *entity.Cart) bool {  // Fungsi untuk memeriksa cart terbengkalai
+    return s.ttl > 0 && cart.UpdatedAt.Before(time.Now().Add(-s.ttl))
+}
+
+func (s *CartService) reload(ctx context.Context, owner Owner) (*entity.CartView, error) {  // Fungsi untuk membaca ulang cart setelah perubahan
+    db := s.db.WithContext(ctx)
+    cart, err := s.find(db, owner, false)
+    if err != nil {
+        return nil, err
+    }
+    return s.view(db, cart, owner)
+}
+
+func (s *CartService) checkTotals(tx *gorm.DB, owner Owner) error {  // Fungsi untuk menghitung ulang cart di dalam transaksi, gagal dengan ErrTotalTooLarge jika total tidak muat
+    cart, err := s.find(tx, owner, false)
+    if err != nil || cart == nil {
+        return err
+    }
+    _, err = s.view(tx, cart, owner)
+    return err
+}
+
+func (s *CartService) view(db *gorm.DB, cart *entity.Cart, owner Owner) (*entity.CartView, error) {  // Fungsi untuk menghitung ulang cart dari harga product saat ini
+    view := &entity.CartView{Items: []entity.ItemView{}}
+    if owner.UserID != 0 {
+        view.UserID = &owner.UserID
+    }
+    if cart == nil {
+        return view, nil                      // Cart kosong
+    }
+    view.ID = cart.ID
+    expiresAt := cart.UpdatedAt.Add(s.ttl)
+    view.ExpiresAt = &expiresAt
+
+    ids, variantIDs := make([]uint, 0, len(cart.Items)), []uint{}
+    for _, item := range cart.Items {
+        ids = append(ids, item.ProductID)
+        if item.VariantID != 0 {
+            variantIDs = append(variantIDs, item.VariantID)
+        }
+    }
+    products := make(map[uint]productEntity.Product, len(ids))
+    if len(ids) > 0 {
+        var found []productEntity.Product
+        if err := db.Where("id IN ?", ids).Find(&found).Error; err != nil {
+            return nil, err
+        }
+        for _, product := range found {
+            products[product.ID] = product
+        }
+    }
+    variants := make(map[uint]productEntity.ProductVariant, len(variantIDs))
+    if len(variantIDs) > 0 {
+        var found []productEntity.ProductVariant
+        err := db.Preload("Values", func(db *gorm.DB) *gorm.DB { return db.Order("id") }).Where("id IN ?", variantIDs).Find(&found).Error
+        if err != nil {
+            return nil, err
+        }
+        for _, variant := range found {
+            variants[variant.ID] = variant
+        }
+    }
+
+    var subtotal *money.Money
+    for _, item := range cart.Items {
+        line := entity.ItemView{ProductID: item.ProductID, Quantity: item.Quantity, AddedPrice: item.AddedPrice, Status: entity.ItemOK}
+        product, ok := products[item.ProductID]
+        title, price, stock := product.Title, product.Price, product.Stock
+        if item.VariantID != 0 {
+            variantID := item.VariantID
+            line.VariantID = &variantID
+            variant, found := variants[item.VariantID]
+            ok = ok && found && variant.ProductID == item.ProductID
+            title, price, stock = fmt.Sprintf("%s (%s)", product.Title, variant.Label()), variant.PriceOr(product.Price), variant.Stock
+        }
+        switch {
+        case !ok:                             // Product atau varian sudah dihapus
+            line.Status = entity.ItemUnavailable
+        default:
+            total, err := price.Mul(item.Quantity)
+            if err != nil {
+                return nil, ErrTotalTooLarge
+            }
+            line.Title, line.Stock, line.UnitPrice, line.LineTotal = title, stock, &price, &total
+            if stock < item.Quantity {
+                line.Status = entity.ItemInsufficientStock
+            } else if price != item.AddedPrice {
+                line.Status = entity.ItemPriceChanged
+            }
+
+            if subtotal == nil {
+                subtotal = &total
+            } else if sum, err := subtotal.Add(total); err == nil {
+                subtotal = &sum
+            } else if errors.Is(err, money.ErrOverflow) {
+                return nil, ErrTotalTooLarge
+            } else {
+                view.HasIssues = true         // Mata uang berbeda tidak dapat dijumlahkan
+            }
+        }
+        if line.Status != entity.ItemOK {
+            view.HasIssues = true
+        }
+        view.Items = append(view.Items, line)
+    }
+    view.Subtotal = subtotal
+    return view, nil
+}
+
+type itemKey struct {                         // Kunci item cart: product dan varian
+    ProductID uint
+    VariantID uint
+}
+
+func keyOf(item *entity.CartItem) itemKey {   // Fungsi untuk membuat kunci item cart
+    return itemKey{item.ProductID, item.VariantID}
+}
+
+func currentPrice(tx *gorm.DB, productID, variantID uint) (money.Money, error) {  // Fungsi untuk membaca harga product atau varian saat ini
+    var product productEntity.Product
+    err := tx.First(&product, productID).Error
+    if errors.Is(err, gorm.ErrRecordNotFound) {
+        return money.Money{}, ErrProductNotFound
+    }
+    if err != nil {
+        return money.Money{}, err
+    }
+
+    if variantID == 0 {
+        var count int64                       // Product bervarian tidak dapat dibeli tanpa varian
+        if err := tx.Model(&productEntity.ProductVariant{}).Where("product_id = ?", productID).Count(&count).Error; err != nil {
+            return money.Money{}, err
+        }
+        if count > 0 {
+            return money.Money{}, ErrVariantRequired
+        }
+        return product.Price, nil
+    }
+
+    var variant productEntity.ProductVariant
+    err = tx.Where("product_id = ?", productID).First(&variant, variantID).Error
+    if errors.Is(err, gorm.ErrRecordNotFound) {
+        return money.Money{}, ErrVariantNotFound  // Varian tidak ada atau milik product lain
+    }
+    if err != nil {
+        return money.Money{}, err
+    }
+    return variant.PriceOr(product.Price), nil  // Harga khusus varian jika ada
+}
+
+func deleteCarts(tx *gorm.DB, ids []uint) error {  // Fungsi untuk menghapus cart beserta itemnya
+    if err := tx.Where("cart_id IN ?", ids).Delete(&entity.CartItem{}).Error; err != nil {
+        return err
+    }
+    return tx.Delete(&entity.Cart{}, ids).Error
+}
+
+
+
+// {{{ Penjelasan Fungsi Service }}}
+
+/*
+## Penjelasan Detail
+File service.go ini berisi logika bisnis untuk modul Cart. Berikut penjelasan detailnya:
+
+1. Owner :
+
+    - Cart dimiliki oleh user yang login (UserID) atau sesi anonim (SessionID)
+    - Handler menentukan owner dari token bearer atau header X-Cart-Session / cookie cart_session
+2. Operasi :
+
+    - Get : Cart dengan harga terkini, cart kosong jika belum ada
+    - AddItem : Menambah product (quantity dijumlahkan jika product dan varian yang sama sudah ada), product harus ada
+    - UpdateItem : Mengganti quantity item, item diidentifikasi dengan product dan varian (0 untuk tanpa varian)
+    - RemoveItem : Menghapus satu item
+    - Clear : Menghapus cart beserta itemnya
+    - Merge : Memindahkan item cart anonim ke cart user setelah login, quantity product dan varian yang sama dijumlahkan
+3. Harga Terkini :
+
+    - view() selalu membaca product saat ini, bukan harga yang tersimpan
+    - AddedPrice dibandingkan dengan harga saat ini untuk status price_changed
+    - Product atau varian yang dihapus berstatus unavailable dan tidak dihitung di subtotal
+    - Item varian memakai harga khusus varian (PriceOr) dan stok varian
+    - Product bervarian wajib ditambahkan dengan variant_id (400), varian milik product lain ditolak (404)
+    - Stok kurang dari quantity berstatus insufficient_stock
+    - AddItem dan UpdateItem memperbarui AddedPrice karena user sudah melihat harga terbaru
+    - Total item dan subtotal dihitung dengan Mul dan Add yang diperiksa; jika tidak muat di DECIMAL(19,4) view() gagal dengan ErrTotalTooLarge (422)
+    - AddItem, UpdateItem, dan Merge menghitung ulang cart sebelum commit (checkTotals) sehingga perubahan yang membuat total overflow di-rollback
+4. Cart Terbengkalai :
+
+    - Cart tanpa aktivitas lebih lama dari TTL (CART_TTL) dianggap terbengkalai
+    - find() langsung menghapus cart kedaluwarsa saat diakses
+    - PurgeExpired menghapus semua cart kedaluwarsa, dipanggil setiap jam oleh job cart.purge_expired yang didaftarkan di bootstrap.go; CART_TTL=0 mematikan expiry sehingga tidak ada cart yang dihapus
+5. Konkurensi :
+
+    - Perubahan cart berjalan di dalam transaksi dan cart dikunci dengan SELECT ... FOR UPDATE
+Cart tidak mengurangi stok; stok baru berkurang saat checkout di modul order.
+*/
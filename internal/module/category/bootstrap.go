@@ -3,21 +3,22 @@ package category // Mendefinisikan package category
 import (
 	"rest-api-go/internal/module/category/handler" // Mengimpor package handler dari modul category
 	"rest-api-go/internal/module/category/service" // Mengimpor package service dari modul category
+	"rest-api-go/pkg/cache"                        // Mengimpor cache hasil baca
 
 	"github.com/gin-gonic/gin" // Mengimpor framework web Gin
 	"gorm.io/gorm"             // Mengimpor ORM GORM
 )
 
 // Initialize - Fungsi untuk menginisialisasi modul category
-func Initialize(db *gorm.DB, router *gin.RouterGroup) {  // Fungsi untuk inisialisasi modul dengan parameter database dan router
+func Initialize(db *gorm.DB, router *gin.RouterGroup, responseCache *cache.Cache, cacheControl gin.HandlerFunc) {  // Fungsi untuk inisialisasi modul dengan parameter database, router, cache, dan middleware Cache-Control
 	// Initialize service
-	categoryService := service.NewCategoryService(db)    // Membuat instance service category dengan menyuntikkan database
+	categoryService := service.NewCategoryService(db, responseCache)  // Membuat instance service category dengan menyuntikkan database dan cache
 
 	// Initialize handler
 	categoryHandler := handler.NewCategoryHandler(categoryService)  // Membuat instance handler dengan menyuntikkan service
 
 	// Register routes
-	handler.RegisterRoutes(router, categoryHandler)     // Mendaftarkan route untuk modul category
+	handler.RegisterRoutes(router, categoryHandler, cacheControl)  // Mendaftarkan route untuk modul category
 }
 
 
@@ -31,7 +32,8 @@ File bootstrap.go ini berfungsi sebagai titik masuk (entry point) untuk modul ca
 2. Alur Kerja :
 
 	- Menerima koneksi database ( db ) dan grup router ( router ) dari aplikasi utama
-	- Membuat instance service dengan menyuntikkan database
+	- Membuat instance service dengan menyuntikkan database dan cache untuk GetByID dan GetAll (nil jika CACHE_DRIVER=none)
+	- Middleware Cache-Control diteruskan ke route baca
 	- Membuat instance handler dengan menyuntikkan service
 	- Mendaftarkan route API untuk modul category
 3. Pola Desain :
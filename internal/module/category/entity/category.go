@@ -2,22 +2,37 @@ package entity                                  // Mendefinisikan package entity
 
 import (
     "rest-api-go/internal/module/product/entity"  // Mengimpor entity product untuk relasi
+    "rest-api-go/pkg/slug"                         // Package slug untuk URL kategori
+    "rest-api-go/pkg/validation"                   // Package validation dengan validator bersama
     "time"                                         // Package time untuk tipe data waktu
-    
-    "github.com/go-playground/validator/v10"      // Package validator untuk validasi data
+
+    "gorm.io/gorm"                                 // Mengimpor ORM GORM untuk hook
 )
 
 type Category struct {                           // Mendefinisikan struct Category
     ID          uint                `json:"id" gorm:"primaryKey"`  // ID kategori sebagai primary key
-    Name        string              `json:"name" binding:"max=255"`  // Nama kategori dengan validasi maksimal 255 karakter
+    Name        string              `json:"name" binding:"required,notblank,max=255"`  // Nama kategori wajib diisi, tidak boleh kosong, maksimal 255 karakter
+    Slug        string              `json:"slug" gorm:"size:191;uniqueIndex" binding:"omitempty,slug"`  // Slug URL unik, dibuat dari Name jika kosong
+    ParentID    *uint               `json:"parent_id" gorm:"index"`  // ID kategori induk, null untuk kategori paling atas
+    Children    []Category          `json:"children,omitempty" gorm:"foreignKey:ParentID"`  // Relasi one-to-many dengan sub-kategori
     Products    []entity.Product    `json:"products,omitempty" gorm:"foreignKey:CategoryID"`  // Relasi one-to-many dengan Product
     CreatedAt   time.Time           `json:"created_at"`  // Waktu pembuatan record
     UpdatedAt   time.Time           `json:"updated_at"`  // Waktu pembaruan record
 }
 
 func (p *Category) Validate() error {           // Method untuk validasi struct Category
-    validate := validator.New()                 // Membuat instance validator baru
-    return validate.Struct(p)                   // Memvalidasi struct berdasarkan tag binding
+    return validation.Struct(p)               // Memvalidasi struct berdasarkan tag binding dengan validator bersama
+}
+
+func (p *Category) BeforeCreate(tx *gorm.DB) (err error) {  // Hook GORM sebelum create
+    if p.Slug == "" {                           // Slug dibuat otomatis dari nama, termasuk saat seeding
+        p.Slug, err = slug.Generate(tx.Session(&gorm.Session{NewDB: true}), "categories", 0, p.Name, "category")
+    }
+    return err
+}
+
+type MoveRequest struct {                       // Mendefinisikan struct body request untuk memindahkan kategori
+    ParentID *uint `json:"parent_id"`           // ID kategori induk baru, null untuk menjadikan kategori paling atas
 }
 
 
@@ -36,6 +51,9 @@ File category.go ini mendefinisikan struktur data untuk entitas Category. Beriku
 
     - ID : Primary key untuk kategori
     - Name : Nama kategori dengan batasan panjang 255 karakter
+    - Slug : Slug URL unik; jika kosong, hook BeforeCreate membuatnya dari Name (misal "Pakaian Pria" menjadi "pakaian-pria")
+    - ParentID : ID kategori induk, null untuk kategori paling atas (root)
+    - Children : Sub-kategori, hanya diisi oleh endpoint subtree
     - Products : Relasi one-to-many dengan entitas Product
     - CreatedAt/UpdatedAt : Timestamp untuk audit trail
 3. Tag Struct :
@@ -47,10 +65,13 @@ File category.go ini mendefinisikan struktur data untuk entitas Category. Beriku
 
     - Category memiliki relasi one-to-many dengan Product
     - gorm:"foreignKey:CategoryID" menentukan bahwa field CategoryID di tabel Product adalah foreign key yang merujuk ke tabel Category
+    - Category juga berelasi dengan dirinya sendiri melalui ParentID sehingga kategori membentuk pohon (tree)
+    - MoveRequest adalah body untuk memindahkan kategori beserta seluruh sub-kategorinya ke induk lain
 5. Validasi :
 
-    - Method Validate() menggunakan package validator untuk memastikan data valid sebelum disimpan ke database
-    - Validasi berdasarkan tag binding pada struct
+    - Method Validate() menggunakan validator bersama dari package validation untuk memastikan data valid sebelum disimpan ke database
+    - Validasi berdasarkan tag binding pada struct, tag yang sama dipakai saat binding di handler
+    - Name wajib diisi dan tidak boleh hanya berisi spasi (aturan notblank)
 ## Konsep Penting
 1. ORM (Object-Relational Mapping) : GORM digunakan untuk memetakan struct Go ke tabel database tanpa perlu menulis SQL secara manual.
 2. Struct Tags : Tag seperti json , gorm , dan binding memberikan metadata tambahan pada field struct yang digunakan oleh berbagai library.
@@ -4,6 +4,8 @@ import (
     "net/http"                                 // Package untuk konstanta HTTP
     "rest-api-go/internal/module/category/entity"  // Mengimpor entity category
     "rest-api-go/internal/module/category/service" // Mengimpor service category
+    "rest-api-go/pkg/bulk"                     // Mengimpor package bulk untuk laporan per item
+    "rest-api-go/pkg/fieldset"                 // Mengimpor package fieldset untuk ?fields= dan ?include=
     "rest-api-go/pkg/utils"                    // Mengimpor utilitas aplikasi
     "strconv"                                  // Package untuk konversi string
 
@@ -20,13 +22,13 @@ func NewCategoryHandler(service *service.CategoryService) *CategoryHandler {  //
 
 func (h *CategoryHandler) Create(c *gin.Context) {  // Handler untuk membuat category baru
     var category entity.Category               // Variabel untuk menampung data category dari request
-    if err := c.ShouldBindJSON(&category); err != nil {  // Binding JSON request ke struct category
-        c.JSON(http.StatusBadRequest, utils.ErrorResponse(err.Error()))  // Respons error jika binding gagal
+    if err := utils.BindJSON(c, &category); err != nil {  // Binding JSON request ke struct category secara ketat
+        utils.HandleError(c, http.StatusBadRequest, err)  // Respons error jika binding gagal (400, 413, atau 415)
         return
     }
 
-    if err := h.service.Create(&category); err != nil {  // Memanggil service untuk membuat category
-        c.JSON(http.StatusInternalServerError, utils.ErrorResponse(err.Error()))  // Respons error jika gagal
+    if err := h.service.Create(c.Request.Context(), &category); err != nil {  // Memanggil service untuk membuat category
+        utils.HandleError(c, http.StatusInternalServerError, err)  // Respons error jika gagal
         return
     }
 
@@ -36,68 +38,200 @@ func (h *CategoryHandler) Create(c *gin.Context) {  // Handler untuk membuat cat
 func (h *CategoryHandler) GetByID(c *gin.Context) {  // Handler untuk mendapatkan category berdasarkan ID
     id, err := strconv.ParseUint(c.Param("id"), 10, 32)  // Mengambil dan mengkonversi parameter ID
     if err != nil {
-        c.JSON(http.StatusBadRequest, utils.ErrorResponse("Invalid ID"))  // Respons error jika ID tidak valid
+        utils.ErrorJSON(c, http.StatusBadRequest, "Invalid ID")  // Respons error jika ID tidak valid
         return
     }
 
-    category, err := h.service.GetByID(uint(id))  // Memanggil service untuk mendapatkan category
+    sel, ok := service.Fields.Bind(c)          // Field dan relasi opsional ?fields=id,name&include=products
+    if !ok {
+        return
+    }
+
+    category, err := h.service.GetByID(c.Request.Context(), uint(id), sel)  // Memanggil service untuk mendapatkan category
     if err != nil {
-        c.JSON(http.StatusNotFound, utils.ErrorResponse("Category not found"))  // Respons error jika tidak ditemukan
+        utils.ErrorJSON(c, http.StatusNotFound, "Category not found")  // Respons error jika tidak ditemukan
         return
     }
 
-    c.JSON(http.StatusOK, utils.SuccessResponse(category))  // Respons sukses dengan data category
+    fieldset.Respond(c, http.StatusOK, sel, category)  // Respons sukses dengan field category yang diminta
+}
+
+func (h *CategoryHandler) GetBySlug(c *gin.Context) {  // Handler untuk mendapatkan category berdasarkan slug
+    sel, ok := service.Fields.Bind(c)          // Field dan relasi opsional, ikut dibawa saat redirect
+    if !ok {
+        return
+    }
+
+    category, current, err := h.service.GetBySlug(c.Request.Context(), c.Param("slug"), sel)  // Memanggil service untuk mencari slug
+    if err != nil {
+        utils.HandleError(c, http.StatusInternalServerError, err)  // Respons error (404 atau 500)
+        return
+    }
+    if category == nil {                        // Slug lama, arahkan ke slug terbaru
+        utils.RedirectParam(c, http.StatusMovedPermanently, "slug", current)
+        return
+    }
+
+    fieldset.Respond(c, http.StatusOK, sel, category)  // Respons sukses dengan field category yang diminta
 }
 
 func (h *CategoryHandler) GetAll(c *gin.Context) {  // Handler untuk mendapatkan semua category
-    categories, err := h.service.GetAll()  // Memanggil service untuk mendapatkan semua category
+    sel, ok := service.Fields.Bind(c)          // Field dan relasi opsional ?fields=id,name&include=products
+    if !ok {
+        return
+    }
+
+    categories, err := h.service.GetAll(c.Request.Context(), sel)  // Memanggil service untuk mendapatkan semua category
     if err != nil {
-        c.JSON(http.StatusInternalServerError, utils.ErrorResponse(err.Error()))  // Respons error jika gagal
+        utils.HandleError(c, http.StatusInternalServerError, err)  // Respons error jika gagal
         return
     }
 
-    c.JSON(http.StatusOK, utils.SuccessResponse(categories))  // Respons sukses dengan data categories
+    fieldset.Respond(c, http.StatusOK, sel, categories)  // Respons sukses dengan field categories yang diminta
 }
 
 func (h *CategoryHandler) Update(c *gin.Context) {  // Handler untuk memperbarui category
     id, err := strconv.ParseUint(c.Param("id"), 10, 32)  // Mengambil dan mengkonversi parameter ID
     if err != nil {
-        c.JSON(http.StatusBadRequest, utils.ErrorResponse("Invalid ID"))  // Respons error jika ID tidak valid
+        utils.ErrorJSON(c, http.StatusBadRequest, "Invalid ID")  // Respons error jika ID tidak valid
         return
     }
 
     var category entity.Category  // Variabel untuk menampung data category dari request
-    if err := c.ShouldBindJSON(&category); err != nil {  // Binding JSON request ke struct category
-        c.JSON(http.StatusBadRequest, utils.ErrorResponse(err.Error()))  // Respons error jika binding gagal
+    if err := utils.BindJSON(c, &category); err != nil {  // Binding JSON request ke struct category secara ketat
+        utils.HandleError(c, http.StatusBadRequest, err)  // Respons error jika binding gagal (400, 413, atau 415)
         return
     }
 
     // Set ID dari parameter URL
     category.ID = uint(id)  // Mengatur ID category dari parameter URL
 
-    if err := h.service.Update(&category); err != nil {  // Memanggil service untuk memperbarui category
-        c.JSON(http.StatusInternalServerError, utils.ErrorResponse(err.Error()))  // Respons error jika gagal
+    if err := h.service.Update(c.Request.Context(), &category); err != nil {  // Memanggil service untuk memperbarui category
+        utils.HandleError(c, http.StatusInternalServerError, err)  // Respons error jika gagal
         return
     }
 
     c.JSON(http.StatusOK, utils.SuccessResponse(category))  // Respons sukses dengan data category yang diperbarui
 }
 
+func (h *CategoryHandler) Subtree(c *gin.Context) {  // Handler untuk mendapatkan kategori beserta seluruh sub-kategorinya
+    id, err := strconv.ParseUint(c.Param("id"), 10, 32)  // Mengambil dan mengkonversi parameter ID
+    if err != nil {
+        utils.ErrorJSON(c, http.StatusBadRequest, "Invalid ID")  // Respons error jika ID tidak valid
+        return
+    }
+
+    tree, err := h.service.Subtree(c.Request.Context(), uint(id))  // Memanggil service untuk mendapatkan pohon kategori
+    if err != nil {
+        utils.HandleError(c, http.StatusInternalServerError, err)  // Respons error (404 atau 500)
+        return
+    }
+
+    c.JSON(http.StatusOK, utils.SuccessResponse(tree))  // Respons sukses dengan pohon kategori
+}
+
+func (h *CategoryHandler) Ancestors(c *gin.Context) {  // Handler untuk mendapatkan breadcrumb kategori
+    id, err := strconv.ParseUint(c.Param("id"), 10, 32)  // Mengambil dan mengkonversi parameter ID
+    if err != nil {
+        utils.ErrorJSON(c, http.StatusBadRequest, "Invalid ID")  // Respons error jika ID tidak valid
+        return
+    }
+
+    ancestors, err := h.service.Ancestors(c.Request.Context(), uint(id))  // Memanggil service untuk mendapatkan induk-induk kategori
+    if err != nil {
+        utils.HandleError(c, http.StatusInternalServerError, err)  // Respons error (404 atau 500)
+        return
+    }
+
+    c.JSON(http.StatusOK, utils.SuccessResponse(ancestors))  // Respons sukses dengan daftar induk dari root
+}
+
+func (h *CategoryHandler) Move(c *gin.Context) {  // Handler untuk memindahkan kategori ke induk lain
+    id, err := strconv.ParseUint(c.Param("id"), 10, 32)  // Mengambil dan mengkonversi parameter ID
+    if err != nil {
+        utils.ErrorJSON(c, http.StatusBadRequest, "Invalid ID")  // Respons error jika ID tidak valid
+        return
+    }
+
+    var req entity.MoveRequest                 // Variabel untuk menampung induk baru dari request
+    if err := utils.BindJSON(c, &req); err != nil {  // Binding JSON request ke struct secara ketat
+        utils.HandleError(c, http.StatusBadRequest, err)  // Respons error jika binding gagal (400, 413, atau 415)
+        return
+    }
+
+    category, err := h.service.Move(c.Request.Context(), uint(id), &req)  // Memanggil service untuk memindahkan kategori
+    if err != nil {
+        utils.HandleError(c, http.StatusInternalServerError, err)  // Respons error (400, 404, 409, atau 500)
+        return
+    }
+
+    c.JSON(http.StatusOK, utils.SuccessResponse(category))  // Respons sukses dengan data kategori yang dipindahkan
+}
+
 func (h *CategoryHandler) Delete(c *gin.Context) {  // Handler untuk menghapus category
     id, err := strconv.ParseUint(c.Param("id"), 10, 32)  // Mengambil dan mengkonversi parameter ID
     if err != nil {
-        c.JSON(http.StatusBadRequest, utils.ErrorResponse("Invalid ID"))  // Respons error jika ID tidak valid
+        utils.ErrorJSON(c, http.StatusBadRequest, "Invalid ID")  // Respons error jika ID tidak valid
         return
     }
 
-    if err := h.service.Delete(uint(id)); err != nil {  // Memanggil service untuk menghapus category
-        c.JSON(http.StatusInternalServerError, utils.ErrorResponse(err.Error()))  // Respons error jika gagal
+    if err := h.service.Delete(c.Request.Context(), uint(id)); err != nil {  // Memanggil service untuk menghapus category
+        utils.HandleError(c, http.StatusInternalServerError, err)  // Respons error jika gagal
         return
     }
 
     c.JSON(http.StatusOK, utils.SuccessResponse("Category deleted successfully"))  // Respons sukses dengan pesan
 }
 
+func (h *CategoryHandler) BulkCreate(c *gin.Context) {  // Handler untuk membuat banyak category sekaligus
+    var req bulk.Request                       // Variabel untuk menampung mode dan item dari request
+    if err := utils.BindJSON(c, &req); err != nil {  // Binding JSON request secara ketat
+        utils.HandleError(c, http.StatusBadRequest, err)  // Respons error jika binding gagal (400, 413, atau 415)
+        return
+    }
+
+    report, err := h.service.BulkCreate(c.Request.Context(), &req)  // Memanggil service untuk membuat category
+    if err != nil {
+        utils.HandleError(c, http.StatusInternalServerError, err)  // Respons error jika request ditolak seluruhnya
+        return
+    }
+
+    bulk.Respond(c, http.StatusCreated, report)  // Laporan per item (201, 207, atau 422)
+}
+
+func (h *CategoryHandler) BulkUpdate(c *gin.Context) {  // Handler untuk memperbarui banyak category sekaligus
+    var req bulk.Request                       // Variabel untuk menampung mode dan item dari request
+    if err := utils.BindJSON(c, &req); err != nil {  // Binding JSON request secara ketat
+        utils.HandleError(c, http.StatusBadRequest, err)  // Respons error jika binding gagal (400, 413, atau 415)
+        return
+    }
+
+    report, err := h.service.BulkUpdate(c.Request.Context(), &req)  // Memanggil service untuk memperbarui category
+    if err != nil {
+        utils.HandleError(c, http.StatusInternalServerError, err)  // Respons error jika request ditolak seluruhnya
+        return
+    }
+
+    bulk.Respond(c, http.StatusOK, report)     // Laporan per item (200, 207, atau 422)
+}
+
+func (h *CategoryHandler) BulkDelete(c *gin.Context) {  // Handler untuk menghapus banyak category sekaligus
+    var req bulk.DeleteRequest                 // Variabel untuk menampung mode dan ID dari request
+    if err := utils.BindJSON(c, &req); err != nil {  // Binding JSON request secara ketat
+        utils.HandleError(c, http.StatusBadRequest, err)  // Respons error jika binding gagal (400, 413, atau 415)
+        return
+    }
+
+    report, err := h.service.BulkDelete(c.Request.Context(), &req)  // Memanggil service untuk menghapus category
+    if err != nil {
+        utils.HandleError(c, http.StatusInternalServerError, err)  // Respons error jika request ditolak seluruhnya
+        return
+    }
+
+    bulk.Respond(c, http.StatusOK, report)     // Laporan per item (200, 207, atau 422)
+}
+
+
 // {{{ Penjelasan Fungsi RegisterRoutes }}}
 
 /*
@@ -113,9 +247,14 @@ File handler.go ini berisi implementasi handler HTTP untuk modul Category. Berik
 
     - Create : Membuat category baru dari data JSON request
     - GetByID : Mendapatkan category berdasarkan ID dari parameter URL
+    - GetBySlug : Mendapatkan category berdasarkan slug; slug lama dijawab 301 Moved Permanently ke URL dengan slug terbaru
     - GetAll : Mendapatkan semua category
+    - GetByID, GetBySlug, dan GetAll menerima ?fields= dan ?include=products (whitelist service.Fields), nama yang tidak dikenal dijawab 400
     - Update : Memperbarui category berdasarkan ID dan data JSON request
     - Delete : Menghapus category berdasarkan ID
+    - Subtree : Mendapatkan category beserta seluruh sub-kategori sebagai pohon
+    - Ancestors : Mendapatkan breadcrumb dari root sampai induk langsung
+    - Move : Memindahkan category (beserta subtree) ke induk lain, siklus ditolak dengan 409
 4. Alur Request :
 
     - Menerima HTTP request dari router
@@ -124,8 +263,9 @@ File handler.go ini berisi implementasi handler HTTP untuk modul Category. Berik
     - Mengembalikan respons HTTP yang sesuai
 5. Penanganan Error :
 
-    - Error binding JSON: Status 400 Bad Request
+    - Error binding JSON: Status 400 Bad Request (field tidak dikenal, tipe salah, JSON rusak), 413 jika body terlalu besar, 415 jika Content-Type bukan JSON
     - Error validasi atau tidak ditemukan: Status 404 Not Found
+    - Pemindahan yang membuat siklus atau menghapus kategori yang masih memiliki sub-kategori: Status 409 Conflict
     - Error internal: Status 500 Internal Server Error
 6. Format Respons :
 
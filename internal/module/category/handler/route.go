@@ -1,17 +1,27 @@
 package handler                                // Mendefinisikan package handler untuk modul category
 
 import (
+    "rest-api-go/pkg/bulk"                     // Mengimpor package bulk untuk batas body request bulk
+    "rest-api-go/pkg/middleware"               // Mengimpor middleware untuk batas body request bulk
+
     "github.com/gin-gonic/gin"                 // Mengimpor framework web Gin
 )
 
-func RegisterRoutes(router *gin.RouterGroup, handler *CategoryHandler) {  // Fungsi untuk mendaftarkan route
+func RegisterRoutes(router *gin.RouterGroup, handler *CategoryHandler, cacheControl gin.HandlerFunc) {  // Fungsi untuk mendaftarkan route
     categories := router.Group("/categories")  // Membuat grup route dengan prefix "/categories"
     {
         categories.POST("", handler.Create)    // Mendaftarkan endpoint POST untuk membuat category baru
-        categories.GET("/:id", handler.GetByID)  // Mendaftarkan endpoint GET dengan parameter id untuk mendapatkan category berdasarkan ID
-        categories.GET("", handler.GetAll)     // Mendaftarkan endpoint GET untuk mendapatkan semua category
+        categories.GET("/by-slug/:slug", handler.GetBySlug)  // Mendaftarkan endpoint GET untuk mendapatkan category berdasarkan slug
+        categories.GET("/:id", cacheControl, handler.GetByID)  // Mendaftarkan endpoint GET dengan parameter id untuk mendapatkan category berdasarkan ID
+        categories.GET("", cacheControl, handler.GetAll)  // Mendaftarkan endpoint GET untuk mendapatkan semua category
         categories.PUT("/:id", handler.Update)   // Mendaftarkan endpoint PUT dengan parameter id untuk memperbarui category
         categories.DELETE("/:id", handler.Delete)  // Mendaftarkan endpoint DELETE dengan parameter id untuk menghapus category
+        categories.POST("/bulk", middleware.BodyLimit(bulk.MaxBodyBytes), handler.BulkCreate)  // Mendaftarkan endpoint POST untuk membuat banyak category
+        categories.PATCH("/bulk", middleware.BodyLimit(bulk.MaxBodyBytes), handler.BulkUpdate)  // Mendaftarkan endpoint PATCH untuk memperbarui banyak category
+        categories.DELETE("/bulk", middleware.BodyLimit(bulk.MaxBodyBytes), handler.BulkDelete)  // Mendaftarkan endpoint DELETE untuk menghapus banyak category
+        categories.GET("/:id/tree", handler.Subtree)  // Mendaftarkan endpoint GET untuk mendapatkan subtree category
+        categories.GET("/:id/ancestors", handler.Ancestors)  // Mendaftarkan endpoint GET untuk mendapatkan breadcrumb category
+        categories.PATCH("/:id/parent", handler.Move)  // Mendaftarkan endpoint PATCH untuk memindahkan category ke induk lain
     }
 }
 
@@ -30,10 +40,17 @@ File route.go ini berisi konfigurasi routing untuk modul Category. Berikut penje
 3. Endpoint API :
 
     - POST /categories : Membuat category baru
-    - GET /categories/:id : Mendapatkan category berdasarkan ID
-    - GET /categories : Mendapatkan semua category
+    - GET /categories/by-slug/:slug : Mendapatkan category berdasarkan slug, slug lama dijawab 301 ke slug terbaru
+    - GET /categories/:id : Mendapatkan category berdasarkan ID, dari cache dengan header Cache-Control
+    - GET /categories : Mendapatkan semua category, dari cache dengan header Cache-Control
     - PUT /categories/:id : Memperbarui category berdasarkan ID
     - DELETE /categories/:id : Menghapus category berdasarkan ID
+    - POST /categories/bulk : Membuat banyak category sekaligus, laporan per item
+    - PATCH /categories/bulk : Memperbarui banyak category sekaligus, hanya field yang dikirim
+    - DELETE /categories/bulk : Menghapus banyak category sekaligus berdasarkan daftar ID
+    - GET /categories/:id/tree : Mendapatkan category beserta seluruh sub-kategorinya
+    - GET /categories/:id/ancestors : Mendapatkan breadcrumb (root sampai induk langsung)
+    - PATCH /categories/:id/parent : Memindahkan category beserta subtree ke induk lain
 4. Parameter URL :
 
     - :id : Parameter dinamis untuk ID category
@@ -0,0 +1,153 @@
+package service                                // Mendefinisikan package service untuk modul category
+
+import (
+    "context"                                 // Package untuk context request
+    "encoding/json"                           // Package untuk item JSON mentah
+    "errors"                                  // Package untuk pengecekan error
+    "net/http"                                // Package untuk konstanta HTTP
+    "rest-api-go/internal/module/category/entity"  // Mengimpor entity category
+    "rest-api-go/pkg/bulk"                    // Package bulk untuk laporan per item
+    "rest-api-go/pkg/tracing"                 // Mengimpor package tracing untuk span service
+    "rest-api-go/pkg/utils"                   // Mengimpor utilitas aplikasi untuk decode JSON
+
+    "gorm.io/gorm"                            // Mengimpor ORM GORM
+)
+
+func (s *CategoryService) BulkCreate(ctx context.Context, req *bulk.Request) (*bulk.Report, error) {  // Method untuk membuat banyak category sekaligus
+    ctx, span := tracing.Start(ctx, "CategoryService.BulkCreate")  // Membuat child span untuk method service
+    defer span.End()                          // Menutup span saat method selesai
+
+    if err := req.Validate(); err != nil {    // Validasi mode dan jumlah item
+        return nil, err
+    }
+
+    report := bulk.NewReport(req.Mode, len(req.Items))
+    err := bulk.Run(s.db.WithContext(ctx), report, func(tx *gorm.DB) error {
+        batch := bulk.NewBatch(tx, report, func(c *entity.Category) uint { return c.ID }).AfterCreate(func(tx *gorm.DB, c *entity.Category) error {
+            return record(tx, EventCategoryCreated, c)
+        })
+        claims := bulk.Claims{}               // Slug item sebelumnya yang belum tersimpan
+        for i, raw := range req.Items {
+            category, err := s.newCategory(tx, raw, claims)
+            if err != nil {
+                report.Fail(i, err)           // Status item ditentukan dari error, seperti utils.HandleError
+                continue
+            }
+            batch.Add(i, category)
+        }
+        batch.Flush()
+        return nil
+    })
+    return report, err
+}
+
+func (s *CategoryService) BulkUpdate(ctx context.Context, req *bulk.Request) (*bulk.Report, error) {  // Method untuk memperbarui banyak category sekaligus, hanya field yang dikirim yang berubah
+    ctx, span := tracing.Start(ctx, "CategoryService.BulkUpdate")  // Membuat child span untuk method service
+    defer span.End()                          // Menutup span saat method selesai
+
+    if err := req.Validate(); err != nil {    // Validasi mode dan jumlah item
+        return nil, err
+    }
+
+    report := bulk.NewReport(req.Mode, len(req.Items))
+    err := bulk.Run(s.db.WithContext(ctx), report, func(tx *gorm.DB) error {
+        for i, raw := range req.Items {
+            bulk.Item(tx, report, i, http.StatusOK, func(tx *gorm.DB) (uint, error) {
+                id, err := bulk.ID(raw)
+                if err != nil {
+                    return 0, err
+                }
+                var category entity.Category
+                if err := tx.First(&category, id).Error; err != nil {
+                    if errors.Is(err, gorm.ErrRecordNotFound) {
+                        return 0, ErrCategoryNotFound
+                    }
+                    return 0, err
+                }
+                sent, err := bulk.Patch(raw, &category)  // Field yang tidak dikirim tetap bernilai lama
+                if err != nil {
+                    return 0, err
+                }
+                category.ID = id
+                category.Children, category.Products = nil, nil  // Relasi tidak diubah lewat body request
+                if !sent["slug"] {            // Slug dibuat ulang jika nama berubah, sama seperti update tanpa slug
+                    category.Slug = ""
+                }
+                if err := category.Validate(); err != nil {
+                    return 0, err
+                }
+                return id, s.update(tx, &category)
+            })
+        }
+        return nil
+    })
+    return report, err
+}
+
+func (s *CategoryService) BulkDelete(ctx context.Context, req *bulk.DeleteRequest) (*bulk.Report, error) {  // Method untuk menghapus banyak category sekaligus, sesuai urutan ID
+    ctx, span := tracing.Start(ctx, "CategoryService.BulkDelete")  // Membuat child span untuk method service
+    defer span.End()                          // Menutup span saat method selesai
+
+    if err := req.Validate(); err != nil {    // Validasi mode dan daftar ID
+        return nil, err
+    }
+
+    report := bulk.NewReport(req.Mode, len(req.IDs))
+    err := bulk.Run(s.db.WithContext(ctx), report, func(tx *gorm.DB) error {
+        for i, id := range req.IDs {
+            bulk.Item(tx, report, i, http.StatusOK, func(tx *gorm.DB) (uint, error) {
+                var count int64
+                if err := tx.Model(&entity.Category{}).Where("id = ?", id).Count(&count).Error; err != nil {
+                    return 0, err
+                }
+                if count == 0 {
+                    return 0, ErrCategoryNotFound
+                }
+                return id, s.remove(tx, id)
+            })
+        }
+        return nil
+    })
+    return report, err
+}
+
+func (s *CategoryService) newCategory(tx *gorm.DB, raw json.RawMessage, claims bulk.Claims) (*entity.Category, error) {  // Fungsi untuk membaca dan memeriksa satu item bulk create
+    var category entity.Category
+    if err := utils.DecodeJSON(raw, &category); err != nil {  // Field yang tidak dikenal ditolak seperti BindJSON
+        return nil, err
+    }
+    if err := category.Validate(); err != nil {
+        return nil, err
+    }
+    category.ID = 0                           // ID selalu dibuat database
+    category.Children, category.Products = nil, nil  // Relasi tidak dibuat lewat body request
+    if err := s.checkNew(tx, &category, claims); err != nil {
+        return nil, err
+    }
+    return &category, nil
+}
+
+
+// {{{ Penjelasan Bulk Category }}}
+
+/*
+## Penjelasan Detail
+File bulk.go ini berisi operasi bulk untuk category. Berikut penjelasan detailnya:
+
+1. BulkCreate :
+
+    - Setiap item didecode dan divalidasi sendiri, item yang rusak hanya menggagalkan item itu
+    - parent_id harus menunjuk kategori yang sudah ada sebelum request, bukan item lain dalam request yang sama
+    - Slug diperiksa terhadap database dan terhadap item sebelumnya dalam request yang sama (bulk.Claims)
+    - Item yang lolos disimpan dengan INSERT multi-baris per 500 category
+2. BulkUpdate :
+
+    - Setiap item wajib berisi id dan hanya field yang dikirim yang berubah
+    - parent_id yang berubah diperiksa seperti Move (siklus, kedalaman)
+    - Jika slug tidak dikirim dan nama berubah, slug dibuat ulang dan slug lama disimpan sebagai redirect
+3. BulkDelete :
+
+    - ID dihapus sesuai urutan, sehingga sub-kategori yang disebut lebih dulu membuat induknya dapat dihapus
+    - Kategori yang masih memiliki sub-kategori ditolak (409) seperti Delete
+4. Mode atomic dan best_effort ditangani oleh bulk.Run, lihat pkg/bulk.
+*/
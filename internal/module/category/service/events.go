@@ -0,0 +1,47 @@
+package service                                // Mendefinisikan package service untuk modul category
+
+import (
+    "rest-api-go/internal/module/category/entity"  // Mengimpor entity category
+    "rest-api-go/pkg/events"                  // Mengimpor outbox domain event
+
+    "gorm.io/gorm"                            // Mengimpor ORM GORM
+)
+
+const (
+    EventCategoryCreated = "category.created" // Category baru disimpan
+    EventCategoryUpdated = "category.updated" // Nama, slug, atau induk berubah lewat update, bulk update, atau move
+    EventCategoryDeleted = "category.deleted" // Category dihapus
+
+    eventEntity = "category"                  // Nama entitas di event
+)
+
+type deletedData struct {                     // Mendefinisikan struct data event category.deleted
+    ID uint `json:"id"`                       // ID category yang dihapus
+}
+
+func record(tx *gorm.DB, typ string, category *entity.Category) error {  // Fungsi untuk mencatat event category di transaksi tx
+    data := *category                         // Salinan tanpa relasi, yang tidak dimuat saat menyimpan
+    data.Children, data.Products = nil, nil
+    return events.Record(tx, typ, eventEntity, category.ID, data)
+}
+
+func recordDeleted(tx *gorm.DB, id uint) error {  // Fungsi untuk mencatat category.deleted di transaksi tx
+    return events.Record(tx, EventCategoryDeleted, eventEntity, id, deletedData{ID: id})
+}
+
+
+// {{{ Penjelasan Event Category }}}
+
+/*
+## Penjelasan Detail
+File events.go ini berisi domain event yang dicatat service category. Berikut penjelasan detailnya:
+
+1. Tipe Event :
+
+    - category.created : Create dan bulk create
+    - category.updated : Update, bulk update, dan Move; data berisi category setelah perubahan
+    - category.deleted : Delete dan bulk delete, data berisi ID category
+2. Transaksi :
+
+    - Event dicatat dengan events.Record di transaksi yang sama dengan perubahan, sehingga perubahan yang di-rollback tidak pernah menghasilkan event
+*/
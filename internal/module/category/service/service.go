@@ -1,53 +1,406 @@
 package service                                // Mendefinisikan package service untuk modul category
 
 import (
+    "context"                                 // Package untuk context request
+    "errors"                                  // Package untuk pengecekan error
+    "fmt"                                     // Package untuk key cache
+    "net/http"                                // Package untuk konstanta HTTP
+    "rest-api-go/pkg/bulk"                    // Package bulk untuk pemeriksaan keunikan dalam satu request
+    "rest-api-go/pkg/cache"                   // Package cache untuk hasil GetByID dan GetAll
+    "rest-api-go/pkg/fieldset"                // Package fieldset untuk ?fields= dan ?include=
+    "rest-api-go/pkg/slug"                    // Package slug untuk keunikan dan riwayat slug
+    "rest-api-go/pkg/tracing"                 // Mengimpor package tracing untuk span service
+    "rest-api-go/pkg/utils"                   // Mengimpor utilitas aplikasi untuk HTTPError
+    attributeEntity "rest-api-go/internal/module/attribute/entity"  // Mengimpor entity attribute untuk template kategori
     "rest-api-go/internal/module/category/entity"  // Mengimpor entity category
     "gorm.io/gorm"                            // Mengimpor ORM GORM
+    "gorm.io/gorm/clause"                     // Klausa SELECT ... FOR UPDATE
+)
+
+const MaxDepth = 32                           // Kedalaman maksimum pohon kategori, juga pengaman dari data yang rusak
+
+const slugScope = "categories"                // Nama tabel category untuk package slug
+
+const cacheNamespace = "categories"           // Namespace cache category, diinvalidasi oleh plugin cache dan event category.* serta product.*
+
+// Fields - field dan relasi category yang boleh dipilih client lewat ?fields= dan ?include=
+var Fields = &fieldset.Schema{
+    Fields: map[string][]string{
+        "id":         {"id"},
+        "name":       {"name"},
+        "slug":       {"slug"},
+        "parent_id":  {"parent_id"},
+        "created_at": {"created_at"},
+        "updated_at": {"updated_at"},
+    },
+    Always:   []string{"id"},                 // Dibutuhkan untuk preload Products
+    Includes: map[string][]string{"products": nil},
+}
+
+var (
+    ErrCategoryNotFound = utils.NewHTTPError(http.StatusNotFound, "Category not found")  // Kategori tidak ada
+    ErrParentNotFound   = utils.NewHTTPError(http.StatusBadRequest, "parent category not found")  // parent_id menunjuk kategori yang tidak ada
+    ErrCategoryCycle    = utils.NewHTTPError(http.StatusConflict, "a category cannot be moved under itself or one of its descendants")  // Pemindahan akan membuat siklus
+    ErrTooDeep          = utils.NewHTTPError(http.StatusConflict, "category tree is too deep")  // Pemindahan melebihi MaxDepth
+    ErrHasChildren      = utils.NewHTTPError(http.StatusConflict, "category has subcategories, move or delete them first")  // Kategori induk tidak dapat dihapus
+    ErrSlugTaken        = utils.NewHTTPError(http.StatusConflict, "slug is already in use")  // Slug manual sudah dipakai kategori lain
 )
 
 type CategoryService struct {                  // Mendefinisikan struct service
-    db *gorm.DB                               // Dependency database
+    db    *gorm.DB                            // Dependency database
+    cache *cache.Cache                        // Cache hasil baca, nil jika CACHE_DRIVER=none
 }
 
-func NewCategoryService(db *gorm.DB) *CategoryService {  // Constructor untuk service
-    return &CategoryService{db}               // Mengembalikan instance service dengan database yang diinjeksi
+func NewCategoryService(db *gorm.DB, responseCache *cache.Cache) *CategoryService {  // Constructor untuk service
+    return &CategoryService{db, responseCache}  // Mengembalikan instance service dengan database dan cache yang diinjeksi
 }
 
-func (s *CategoryService) Create(category *entity.Category) error {  // Method untuk membuat category baru
+func (s *CategoryService) Create(ctx context.Context, category *entity.Category) error {  // Method untuk membuat category baru
+    ctx, span := tracing.Start(ctx, "CategoryService.Create")  // Membuat child span untuk method service
+    defer span.End()                          // Menutup span saat method selesai
+
     if err := category.Validate(); err != nil {  // Validasi data category
         return err                            // Mengembalikan error jika validasi gagal
     }
-    return s.db.Create(category).Error        // Menyimpan category ke database dan mengembalikan error jika ada
+    category.Children = nil                   // Sub-kategori tidak dibuat lewat body request
+
+    return s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+        if err := s.checkNew(tx, category, bulk.Claims{}); err != nil {  // Kategori induk harus ada dan slug harus unik
+            return err
+        }
+        if err := tx.Create(category).Error; err != nil {  // Menyimpan category ke database dan mengembalikan error jika ada
+            return err
+        }
+        return record(tx, EventCategoryCreated, category)  // Event category.created hanya ada jika category ikut di-commit
+    })
 }
 
-func (s *CategoryService) GetByID(id uint) (*entity.Category, error) {  // Method untuk mendapatkan category berdasarkan ID
-    var category entity.Category              // Variabel untuk menampung hasil query
-    err := s.db.Preload("Products").First(&category, id).Error  // Query category dengan preload relasi Products
-    return &category, err                     // Mengembalikan category dan error jika ada
+func (s *CategoryService) GetByID(ctx context.Context, id uint, sel fieldset.Selection) (*entity.Category, error) {  // Method untuk mendapatkan category berdasarkan ID
+    ctx, span := tracing.Start(ctx, "CategoryService.GetByID")  // Membuat child span untuk method service
+    defer span.End()                          // Menutup span saat method selesai
+
+    return cache.Load(ctx, s.cache, cacheNamespace, cacheKey(fmt.Sprintf("id:%d", id), sel), func(ctx context.Context) (*entity.Category, error) {  // Query hanya dijalankan saat cache miss
+        var category entity.Category          // Variabel untuk menampung hasil query
+        if err := selected(s.db.WithContext(ctx), sel).First(&category, id).Error; err != nil {  // Query category, Products hanya untuk ?include=products
+            return nil, err
+        }
+        return &category, nil                 // Mengembalikan category
+    })
 }
 
-func (s *CategoryService) GetAll() ([]entity.Category, error) {  // Method untuk mendapatkan semua category
-    var categories []entity.Category          // Variabel untuk menampung hasil query
-    err := s.db.Preload("Products").Find(&categories).Error  // Query semua category dengan preload relasi Products
-    return categories, err                    // Mengembalikan categories dan error jika ada
+func (s *CategoryService) GetAll(ctx context.Context, sel fieldset.Selection) ([]entity.Category, error) {  // Method untuk mendapatkan semua category
+    ctx, span := tracing.Start(ctx, "CategoryService.GetAll")  // Membuat child span untuk method service
+    defer span.End()                          // Menutup span saat method selesai
+
+    return cache.Load(ctx, s.cache, cacheNamespace, cacheKey("all", sel), func(ctx context.Context) ([]entity.Category, error) {
+        var categories []entity.Category      // Variabel untuk menampung hasil query
+        err := selected(s.db.WithContext(ctx), sel).Find(&categories).Error  // Query semua category, Products dimuat dalam satu query untuk ?include=products
+        return categories, err                // Mengembalikan categories dan error jika ada
+    })
 }
 
-func (s *CategoryService) Update(category *entity.Category) error {  // Method untuk memperbarui category
+func (s *CategoryService) Update(ctx context.Context, category *entity.Category) error {  // Method untuk memperbarui category
+    ctx, span := tracing.Start(ctx, "CategoryService.Update")  // Membuat child span untuk method service
+    defer span.End()                          // Menutup span saat method selesai
+
     if err := category.Validate(); err != nil {  // Validasi data category
         return err                            // Mengembalikan error jika validasi gagal
     }
 
+    category.Children = nil                   // Sub-kategori tidak diubah lewat body request
+
+    return s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+        return s.update(tx, category)
+    })
+}
+
+func (s *CategoryService) Move(ctx context.Context, id uint, req *entity.MoveRequest) (*entity.Category, error) {  // Method untuk memindahkan kategori beserta sub-kategorinya
+    ctx, span := tracing.Start(ctx, "CategoryService.Move")  // Membuat child span untuk method service
+    defer span.End()                          // Menutup span saat method selesai
+
+    var category entity.Category
+    err := s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+        if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&category, id).Error; err != nil {  // Kunci kategori yang dipindahkan
+            if errors.Is(err, gorm.ErrRecordNotFound) {
+                return ErrCategoryNotFound
+            }
+            return err
+        }
+        if err := s.checkParent(tx, id, req.ParentID); err != nil {  // Tolak siklus, induk yang tidak ada, dan pohon yang terlalu dalam
+            return err
+        }
+
+        category.ParentID = req.ParentID
+        if err := tx.Model(&category).Update("parent_id", req.ParentID).Error; err != nil {  // Hanya parent_id yang berubah, sub-kategori tetap menempel
+            return err
+        }
+        return record(tx, EventCategoryUpdated, &category)
+    })
+    if err != nil {
+        return nil, err
+    }
+    return &category, nil
+}
+
+func (s *CategoryService) GetBySlug(ctx context.Context, value string, sel fieldset.Selection) (*entity.Category, string, error) {  // Method untuk mendapatkan category berdasarkan slug, mengembalikan slug terbaru jika value adalah slug lama
+    ctx, span := tracing.Start(ctx, "CategoryService.GetBySlug")  // Membuat child span untuk method service
+    defer span.End()                          // Menutup span saat method selesai
+
+    db := s.db.WithContext(ctx)
+    var category entity.Category
+    err := selected(db, sel).Where("slug = ?", value).First(&category).Error  // Slug yang sedang dipakai, sama seperti GetByID
+    if err == nil {
+        return &category, "", nil
+    }
+    if !errors.Is(err, gorm.ErrRecordNotFound) {
+        return nil, "", err
+    }
+
+    id, found, err := slug.Resolve(db, slugScope, value)  // Slug lama
+    if err != nil {
+        return nil, "", err
+    }
+    if !found {
+        return nil, "", ErrCategoryNotFound
+    }
+    if err := db.Select("id", "slug").First(&category, id).Error; err != nil {
+        if errors.Is(err, gorm.ErrRecordNotFound) {
+            return nil, "", ErrCategoryNotFound
+        }
+        return nil, "", err
+    }
+    return nil, category.Slug, nil
+}
+
+func (s *CategoryService) Subtree(ctx context.Context, id uint) (*entity.Category, error) {  // Method untuk mendapatkan kategori beserta seluruh sub-kategorinya
+    ctx, span := tracing.Start(ctx, "CategoryService.Subtree")  // Membuat child span untuk method service
+    defer span.End()                          // Menutup span saat method selesai
+
+    db := s.db.WithContext(ctx)
+    var root entity.Category
+    if err := db.First(&root, id).Error; err != nil {
+        if errors.Is(err, gorm.ErrRecordNotFound) {
+            return nil, ErrCategoryNotFound
+        }
+        return nil, err
+    }
+
+    children := make(map[uint][]entity.Category)  // Sub-kategori langsung per ID induk
+    frontier := []uint{root.ID}
+    for depth := 0; len(frontier) > 0 && depth < MaxDepth; depth++ {  // Satu query per tingkat
+        var level []entity.Category
+        if err := db.Where("parent_id IN ?", frontier).Order("name, id").Find(&level).Error; err != nil {
+            return nil, err
+        }
+        frontier = frontier[:0]
+        for _, child := range level {
+            children[*child.ParentID] = append(children[*child.ParentID], child)
+            frontier = append(frontier, child.ID)
+        }
+    }
+
+    attachChildren(&root, children)           // Susun hasil query menjadi pohon
+    return &root, nil
+}
+
+func (s *CategoryService) Ancestors(ctx context.Context, id uint) ([]entity.Category, error) {  // Method untuk mendapatkan breadcrumb dari root sampai induk langsung
+    ctx, span := tracing.Start(ctx, "CategoryService.Ancestors")  // Membuat child span untuk method service
+    defer span.End()                          // Menutup span saat method selesai
+
+    db := s.db.WithContext(ctx)
+    var category entity.Category
+    if err := db.First(&category, id).Error; err != nil {
+        if errors.Is(err, gorm.ErrRecordNotFound) {
+            return nil, ErrCategoryNotFound
+        }
+        return nil, err
+    }
+
+    ancestors := []entity.Category{}
+    for parentID := category.ParentID; parentID != nil && len(ancestors) < MaxDepth; {  // Naik satu tingkat per query
+        var parent entity.Category
+        if err := db.First(&parent, *parentID).Error; err != nil {
+            return nil, err
+        }
+        ancestors = append([]entity.Category{parent}, ancestors...)  // Root selalu di awal
+        parentID = parent.ParentID
+    }
+    return ancestors, nil
+}
+
+func (s *CategoryService) Delete(ctx context.Context, id uint) error {  // Method untuk menghapus category
+    ctx, span := tracing.Start(ctx, "CategoryService.Delete")  // Membuat child span untuk method service
+    defer span.End()                          // Menutup span saat method selesai
+
+    return s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+        return s.remove(tx, id)
+    })
+}
+
+func (s *CategoryService) checkNew(tx *gorm.DB, category *entity.Category, claims bulk.Claims) error {  // Fungsi untuk memeriksa induk dan slug category baru, termasuk terhadap item lain dalam request bulk
+    if err := s.checkParent(tx, 0, category.ParentID); err != nil {  // Kategori induk harus ada
+        return err
+    }
+    if category.Slug != "" {                  // Slug manual harus unik
+        ok, err := slug.Available(tx, slugScope, 0, category.Slug)
+        if err != nil {
+            return err
+        }
+        if !ok || claims.Has("slug", category.Slug) {
+            return ErrSlugTaken
+        }
+    } else {                                  // Slug dibuat dari nama, melewati slug item lain yang belum disimpan
+        generated, err := slug.GenerateExcept(tx, slugScope, 0, category.Name, "category", func(s string) bool { return claims.Has("slug", s) })
+        if err != nil {
+            return err
+        }
+        category.Slug = generated
+    }
+    claims.Claim("slug", category.Slug)
+    return nil
+}
+
+func (s *CategoryService) update(tx *gorm.DB, category *entity.Category) error {  // Fungsi untuk menyimpan perubahan category di dalam transaksi
     // Cek apakah category ada
     var existingCategory entity.Category      // Variabel untuk menampung hasil query
-    if err := s.db.First(&existingCategory, category.ID).Error; err != nil {  // Query category berdasarkan ID
+    if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&existingCategory, category.ID).Error; err != nil {  // Query category berdasarkan ID
         return err                            // Mengembalikan error jika category tidak ditemukan
     }
+    if err := s.checkParent(tx, category.ID, category.ParentID); err != nil {  // parent_id yang berubah diperiksa seperti Move
+        return err
+    }
+    if err := s.resolveSlug(tx, category, &existingCategory); err != nil {  // Slug manual, slug baru dari nama, atau slug lama
+        return err
+    }
+    if err := slug.Rename(tx, slugScope, category.ID, existingCategory.Slug, category.Slug); err != nil {  // Slug lama tetap bisa dibuka lewat redirect
+        return err
+    }
+    if err := tx.Save(category).Error; err != nil {  // Menyimpan perubahan category ke database dan mengembalikan error jika ada
+        return err
+    }
+    return record(tx, EventCategoryUpdated, category)
+}
+
+func (s *CategoryService) remove(tx *gorm.DB, id uint) error {  // Fungsi untuk menghapus category di dalam transaksi
+    var children int64                        // Jumlah sub-kategori langsung
+    if err := tx.Model(&entity.Category{}).Where("parent_id = ?", id).Count(&children).Error; err != nil {  // Sub-kategori tidak ikut terhapus
+        return err
+    }
+    if children > 0 {
+        return ErrHasChildren
+    }
+    if err := slug.Forget(tx, slugScope, id); err != nil {  // Slug lama kategori ini bisa dipakai kategori lain
+        return err
+    }
+    if err := tx.Where("category_id = ?", id).Delete(&attributeEntity.CategoryAttribute{}).Error; err != nil {  // Template atribut kategori ikut dihapus
+        return err
+    }
+    result := tx.Delete(&entity.Category{}, id)  // Menghapus category dari database dan mengembalikan error jika ada
+    if result.Error != nil {
+        return result.Error
+    }
+    if result.RowsAffected == 0 {             // Category yang tidak ada tidak menghasilkan event category.deleted
+        return ErrCategoryNotFound
+    }
+    return recordDeleted(tx, id)
+}
+
+func (s *CategoryService) resolveSlug(tx *gorm.DB, category, existing *entity.Category) error {  // Fungsi untuk menentukan slug category saat update
+    switch {
+    case category.Slug == "" && category.Name != existing.Name:  // Nama berubah, slug dibuat ulang
+        generated, err := slug.Generate(tx, slugScope, category.ID, category.Name, "category")
+        if err != nil {
+            return err
+        }
+        category.Slug = generated
+    case category.Slug == "":                 // Nama tetap, slug tetap
+        category.Slug = existing.Slug
+    case category.Slug != existing.Slug:      // Slug diisi manual
+        ok, err := slug.Available(tx, slugScope, category.ID, category.Slug)
+        if err != nil {
+            return err
+        }
+        if !ok {
+            return ErrSlugTaken
+        }
+    }
+    return nil
+}
+
+func (s *CategoryService) checkParent(tx *gorm.DB, id uint, parentID *uint) error {  // Fungsi untuk memeriksa induk baru, id 0 untuk kategori baru
+    if parentID == nil {
+        return nil                            // Menjadi kategori paling atas
+    }
+
+    depth := 0                                // Kedalaman induk baru (root = 1)
+    for current := parentID; current != nil; depth++ {  // Telusuri induk baru sampai root
+        if id != 0 && *current == id {        // Kategori itu sendiri ada di jalur, berarti induk baru adalah turunannya
+            return ErrCategoryCycle
+        }
+        if depth >= MaxDepth {
+            return ErrTooDeep
+        }
+        var ancestor entity.Category
+        if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Select("id", "parent_id").First(&ancestor, *current).Error; err != nil {  // Kunci jalur agar pemindahan bersamaan tidak membuat siklus
+            if errors.Is(err, gorm.ErrRecordNotFound) && depth == 0 {
+                return ErrParentNotFound
+            }
+            return err
+        }
+        current = ancestor.ParentID
+    }
 
-    return s.db.Save(category).Error          // Menyimpan perubahan category ke database dan mengembalikan error jika ada
+    height := 1                               // Kategori baru tidak memiliki subtree
+    if id != 0 {
+        var err error
+        if height, err = s.height(tx, id); err != nil {  // Seluruh subtree ikut pindah sehingga tingginya ikut dihitung
+            return err
+        }
+    }
+    if depth+height > MaxDepth {
+        return ErrTooDeep
+    }
+    return nil
 }
 
-func (s *CategoryService) Delete(id uint) error {  // Method untuk menghapus category
-    return s.db.Delete(&entity.Category{}, id).Error  // Menghapus category dari database dan mengembalikan error jika ada
+func (s *CategoryService) height(tx *gorm.DB, id uint) (int, error) {  // Fungsi untuk menghitung jumlah tingkat subtree (kategori tanpa anak = 1)
+    height := 1
+    frontier := []uint{id}
+    for ; height <= MaxDepth; height++ {
+        var next []uint
+        if err := tx.Model(&entity.Category{}).Where("parent_id IN ?", frontier).Pluck("id", &next).Error; err != nil {
+            return 0, err
+        }
+        if len(next) == 0 {
+            return height, nil
+        }
+        frontier = next
+    }
+    return height, nil
+}
+
+func selected(db *gorm.DB, sel fieldset.Selection) *gorm.DB {  // Fungsi untuk memilih kolom sesuai ?fields= dan memuat Products untuk ?include=products
+    if columns := sel.Columns(); columns != nil {
+        db = db.Select(columns)
+    }
+    if sel.Include("products") {
+        db = db.Preload("Products")
+    }
+    return db
+}
+
+func cacheKey(key string, sel fieldset.Selection) string {  // Fungsi untuk membedakan entri cache per ?fields= dan ?include=
+    if selection := sel.Key(); selection != "" {
+        return key + "|" + selection
+    }
+    return key
+}
+
+func attachChildren(category *entity.Category, children map[uint][]entity.Category) {  // Fungsi untuk menyusun sub-kategori secara rekursif
+    category.Children = children[category.ID]
+    for i := range category.Children {
+        attachChildren(&category.Children[i], children)
+    }
 }
 
 
@@ -66,24 +419,43 @@ File service.go ini berisi implementasi service untuk modul Category. Berikut pe
 3. Operasi CRUD :
 
     - Create : Membuat category baru setelah validasi
-    - GetByID : Mendapatkan category berdasarkan ID dengan relasi Products
-    - GetAll : Mendapatkan semua category dengan relasi Products
+    - GetByID : Mendapatkan category berdasarkan ID, relasi Products hanya dengan ?include=products
+    - GetAll : Mendapatkan semua category, relasi Products hanya dengan ?include=products (satu query untuk semua category)
+    - Fields : Whitelist ?fields= dan ?include=; kolom yang tidak diminta tidak ikut di-SELECT
     - Update : Memperbarui category setelah validasi dan pengecekan keberadaan
-    - Delete : Menghapus category berdasarkan ID
-4. Fitur GORM :
+    - Delete : Menghapus category berdasarkan ID, ditolak (409) jika masih memiliki sub-kategori; template atribut kategori ikut dihapus; ID yang tidak ada dijawab 404 tanpa event category.deleted
+    - checkNew, update, remove : Isi transaksi Create, Update, dan Delete, dipakai juga oleh operasi bulk (bulk.go)
+    - Domain Event : Create, Update, Move, dan Delete mencatat category.created, category.updated, dan category.deleted di outbox dalam transaksi yang sama (events.go)
+4. Hierarki Kategori :
+
+    - Subtree : Kategori beserta seluruh turunannya sebagai pohon, dibaca satu query per tingkat
+    - Ancestors : Breadcrumb dari root sampai induk langsung (kategori itu sendiri tidak termasuk)
+    - Move : Mengganti parent_id, seluruh subtree ikut pindah karena hanya satu baris yang berubah
+    - checkParent : Menelusuri induk baru sampai root; jika kategori itu sendiri ditemukan di jalur, pemindahan akan membuat siklus dan ditolak (409)
+    - Jalur induk dikunci dengan SELECT ... FOR UPDATE sehingga dua pemindahan bersamaan tidak dapat membuat siklus
+    - Kedalaman pohon dibatasi MaxDepth; Create dan Update memakai pemeriksaan induk yang sama
+5. Slug :
+
+    - GetBySlug : Mendapatkan category berdasarkan slug; untuk slug lama mengembalikan slug terbaru agar handler mengirim 301
+    - Slug dibuat dari Name oleh hook BeforeCreate jika kosong; saat update dibuat ulang hanya jika Name berubah dan slug tidak diisi
+    - Slug manual yang sudah dipakai kategori lain (termasuk sebagai slug lama) ditolak dengan 409
+    - Slug lama disimpan di slug_redirects melalui slug.Rename dan dihapus saat category dihapus
+6. Fitur GORM :
 
-    - Preload : Mengambil relasi (Products) bersama dengan data utama
+    - Preload : Mengambil relasi (Products) dengan satu query IN untuk semua category, bukan satu query per category
     - First : Mengambil record pertama yang cocok dengan kondisi
     - Find : Mengambil semua record yang cocok dengan kondisi
     - Save : Menyimpan perubahan pada record yang ada
     - Delete : Menghapus record dari database
-5. Validasi :
+7. Validasi :
 
     - Memanggil method Validate() pada entity sebelum operasi Create dan Update
     - Memastikan data valid sebelum berinteraksi dengan database
-6. Penanganan Error :
+8. Penanganan Error :
 
     - Mengembalikan error dari validasi atau operasi database ke handler
     - Memeriksa keberadaan record sebelum update untuk mencegah error
+    - Setiap method menerima context.Context dari handler dan meneruskannya ke GORM dengan db.WithContext(ctx), sehingga log query membawa request ID yang sama dengan access log
+    - Setiap method membuat span OpenTelemetry bernama <Service>.<Method> sehingga waktu di service terlihat terpisah dari query database
 Service ini mengimplementasikan prinsip "fat model, thin controller" di mana logika bisnis berada di service, sementara handler hanya bertanggung jawab untuk menangani HTTP request/response.
 */
\ No newline at end of file
@@ -0,0 +1,35 @@
+package event                                  // Mendefinisikan package event
+
+import (
+	"rest-api-go/internal/module/event/handler"    // Mengimpor package handler dari modul event
+	"rest-api-go/pkg/events"                       // Mengimpor stream domain event
+	"time"                                         // Package untuk interval heartbeat
+
+	"github.com/gin-gonic/gin"                     // Mengimpor framework web Gin
+)
+
+// Initialize - Fungsi untuk menginisialisasi modul event
+func Initialize(router *gin.RouterGroup, stream *events.Stream, heartbeat time.Duration) {  // Fungsi untuk inisialisasi modul dengan parameter router, stream event, dan interval heartbeat
+	// Initialize handler
+	eventHandler := handler.NewEventHandler(stream, heartbeat)  // Membuat instance handler dengan menyuntikkan stream
+
+	// Register routes
+	handler.RegisterRoutes(router, eventHandler)       // Mendaftarkan route untuk modul event
+}
+
+
+// {{{ Penjelasan Fungsi Initialize }}}
+
+/*
+## Penjelasan Detail
+File bootstrap.go ini berfungsi sebagai titik masuk (entry point) untuk modul event. Berikut penjelasan detailnya:
+
+1. Tujuan : Menyediakan endpoint Server-Sent Events di bawah /events untuk dashboard admin.
+2. Hubungan dengan pkg/events :
+
+	- Modul ini tidak punya service sendiri; event dibaca dari outbox oleh events.Stream yang dibuat dan dijalankan di main.go
+	- Event dicatat oleh service product, category, user, inventory, dan order, lihat events.go di masing-masing modul
+3. Hubungan dengan Aplikasi Utama :
+
+	- Fungsi Initialize dipanggil dari main.go
+*/
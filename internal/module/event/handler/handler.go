@@ -0,0 +1,181 @@
+package handler                                // Mendefinisikan package handler untuk modul event
+
+import (
+    "fmt"                                      // Package untuk formatting pesan error
+    "net/http"                                 // Package untuk konstanta HTTP
+    "path"                                     // Package untuk path cookie token stream
+    "rest-api-go/pkg/auth"                     // Mengimpor package auth untuk token stream
+    "rest-api-go/pkg/events"                   // Mengimpor stream domain event
+    "rest-api-go/pkg/middleware"               // Mengimpor middleware untuk user dan peran yang login
+    "rest-api-go/pkg/utils"                    // Mengimpor utilitas aplikasi
+    "slices"                                   // Package untuk mencari entitas publik
+    "strconv"                                  // Package untuk konversi string
+    "strings"                                  // Package untuk memisahkan daftar tipe
+    "time"                                     // Package untuk interval heartbeat
+
+    "github.com/gin-contrib/sse"               // Encoder Server-Sent Events bawaan Gin
+    "github.com/gin-gonic/gin"                 // Framework web Gin
+)
+
+const (
+    retryMillis    = 3000                      // Jeda reconnect yang disarankan ke EventSource
+    streamTokenTTL = 5 * time.Minute           // Masa berlaku token stream, cukup untuk membuka koneksi dan beberapa reconnect
+)
+
+var publicEntities = []string{"product", "category", "user"}  // Entitas event yang boleh dibaca semua user yang login; order hanya untuk staff dan terminal
+
+type streamTokenResponse struct {              // Mendefinisikan struct respons token stream
+    Token     string    `json:"token"`         // Token untuk ?access_token= atau cookie access_token
+    ExpiresAt time.Time `json:"expires_at"`    // Waktu token kedaluwarsa
+}
+
+type EventHandler struct {                     // Mendefinisikan struct handler
+    stream    *events.Stream                   // Sumber event dari outbox
+    heartbeat time.Duration                    // Interval event ping
+}
+
+func NewEventHandler(stream *events.Stream, heartbeat time.Duration) *EventHandler {  // Constructor untuk handler
+    if heartbeat <= 0 {
+        heartbeat = 15 * time.Second           // Ticker membutuhkan interval positif
+    }
+    return &EventHandler{stream, heartbeat}    // Mengembalikan instance handler dengan stream yang diinjeksi
+}
+
+func (h *EventHandler) Stream(c *gin.Context) {  // Handler untuk mengirim domain event sebagai Server-Sent Events
+    patterns := []string{"*"}                  // Tanpa ?types semua event dikirim
+    if raw := c.Query("types"); raw != "" {
+        patterns = patterns[:0]
+        for _, pattern := range strings.Split(raw, ",") {
+            pattern = strings.TrimSpace(pattern)
+            if !events.ValidPattern(pattern) {
+                utils.ErrorJSON(c, http.StatusBadRequest, fmt.Sprintf("types: %q must be \"*\", an event type such as product.created, or a prefix such as product.*", pattern))
+                return
+            }
+            patterns = append(patterns, pattern)
+        }
+    }
+    if !middleware.CurrentRole(c).ReadsOrders() {
+        var ok bool
+        if patterns, ok = restrict(patterns); !ok {
+            utils.ErrorJSON(c, http.StatusForbidden, "types: order events require a staff or terminal account")
+            return
+        }
+    }
+    lastID := c.GetHeader("Last-Event-ID")     // Dikirim EventSource saat tersambung ulang
+    if lastID == "" {
+        lastID = c.Query("last_event_id")      // Untuk koneksi pertama yang ingin melanjutkan dari ID tertentu
+    }
+    var after uint
+    if lastID != "" {
+        id, err := strconv.ParseUint(lastID, 10, 32)
+        if err != nil {
+            utils.ErrorJSON(c, http.StatusBadRequest, "Last-Event-ID must be a positive integer")
+            return
+        }
+        after = uint(id)
+    }
+
+    client, replay, complete := h.stream.Subscribe(patterns, after)
+    defer h.stream.Unsubscribe(client)
+
+    c.Header("Content-Type", "text/event-stream")
+    c.Header("Cache-Control", "no-cache")
+    c.Header("X-Accel-Buffering", "no")        // Nginx tidak menahan event di buffer proxy
+    c.Status(http.StatusOK)
+    fmt.Fprintf(c.Writer, "retry: %d\n\n", retryMillis)  // Tanpa baris data, sehingga tidak muncul sebagai event di client
+    if !complete {                             // Sebagian event sudah keluar dari buffer, client harus memuat ulang data
+        c.Render(-1, sse.Event{Event: "reset", Data: gin.H{"last_event_id": after}})
+    }
+    for i := range replay {
+        h.send(c, &replay[i])
+    }
+    c.Writer.Flush()
+
+    ticker := time.NewTicker(h.heartbeat)
+    defer ticker.Stop()
+    for {
+        select {
+        case <-c.Request.Context().Done():     // Client menutup koneksi
+            return
+        case event, ok := <-client.Events():
+            if !ok {                           // Server berhenti atau client terlalu lambat; EventSource tersambung ulang dengan Last-Event-ID
+                return
+            }
+            h.send(c, &event)
+        case now := <-ticker.C:
+            c.Render(-1, sse.Event{Event: "ping", Data: gin.H{"time": now.UTC()}})  // Menjaga koneksi tetap hidup melewati proxy
+        }
+        c.Writer.Flush()
+    }
+}
+
+func (h *EventHandler) StreamToken(c *gin.Context) {  // Handler untuk membuat token stream berumur pendek bagi EventSource
+    userID, _ := middleware.CurrentUserID(c)   // Route ini memakai RequireAuth
+    token, expiresAt, err := auth.Default().IssueScoped(userID, middleware.CurrentRole(c), auth.ScopeEventStream, streamTokenTTL)
+    if err != nil {
+        utils.HandleError(c, http.StatusInternalServerError, err)  // Respons error jika gagal
+        return
+    }
+
+    c.SetSameSite(http.SameSiteStrictMode)
+    c.SetCookie(middleware.ScopedTokenParam, token, int(streamTokenTTL.Seconds()), path.Join(path.Dir(c.FullPath()), "stream"), "", c.Request.TLS != nil, true)  // Hanya dikirim browser ke /api/events/stream
+    c.JSON(http.StatusOK, utils.SuccessResponse(streamTokenResponse{Token: token, ExpiresAt: expiresAt}))  // Respons sukses dengan token
+}
+
+func restrict(patterns []string) ([]string, bool) {  // Fungsi untuk membatasi pola ke publicEntities; false jika ada pola di luar daftar
+    allowed := make([]string, 0, len(patterns))
+    for _, pattern := range patterns {
+        if pattern == "*" {                    // Semua event diganti semua entitas publik
+            for _, entity := range publicEntities {
+                allowed = append(allowed, entity+".*")
+            }
+            continue
+        }
+        if !slices.Contains(publicEntities, strings.SplitN(pattern, ".", 2)[0]) {
+            return nil, false
+        }
+        allowed = append(allowed, pattern)
+    }
+    return allowed, true
+}
+
+func (h *EventHandler) send(c *gin.Context, event *events.Event) {  // Fungsi untuk menulis satu domain event
+    c.Render(-1, sse.Event{
+        Id:    strconv.FormatUint(uint64(event.ID), 10),  // Menjadi Last-Event-ID saat tersambung ulang
+        Event: event.Type,
+        Data:  event,
+    })
+}
+
+
+// {{{ Penjelasan Fungsi Handler }}}
+
+/*
+## Penjelasan Detail
+File handler.go ini berisi endpoint Server-Sent Events untuk domain event. Berikut penjelasan detailnya:
+
+1. Stream :
+
+    - ?types=product.*,category.deleted membatasi tipe event, default semua event yang boleh dibaca caller
+    - Customer hanya menerima event product, category, dan user (tanpa email); * diganti product.*, category.*, dan user.*, pola lain dijawab 403
+    - Staff, admin, dan terminal juga menerima event order, yang berisi order lengkap
+    - Setiap event dikirim dengan id (ID outbox), event (tipe event), dan data (JSON event lengkap)
+    - Event ping dikirim setiap interval heartbeat agar proxy tidak menutup koneksi yang diam
+2. Resume :
+
+    - Header Last-Event-ID (dikirim otomatis oleh EventSource) atau ?last_event_id= melanjutkan dari event setelah ID tersebut
+    - Jika event setelah ID tersebut sudah keluar dari buffer, event reset dikirim lebih dulu agar client memuat ulang datanya
+3. Koneksi Berakhir :
+
+    - Client menutup koneksi, client terlalu lambat membaca, atau server berhenti; EventSource tersambung ulang setelah retry 3 detik
+4. StreamToken :
+
+    - EventSource bawaan browser tidak dapat mengirim header Authorization
+    - POST /events/stream-token (dengan token bearer) mengembalikan token ber-scope events.stream yang berlaku 5 menit, sekaligus cookie HttpOnly access_token dengan path /api/events/stream
+    - Token dipakai sebagai new EventSource("/api/events/stream?access_token=...") atau lewat cookie; token ini ditolak di endpoint lain
+    - Peran ikut di token, sehingga batasan event order sama dengan token login
+5. Penanganan Error :
+
+    - ?types atau Last-Event-ID tidak valid: Status 400 Bad Request
+    - Pola event order dari customer: Status 403 Forbidden
+*/
@@ -0,0 +1,34 @@
+package handler                                // Mendefinisikan package handler untuk modul event
+
+import (
+    "rest-api-go/pkg/auth"                     // Mengimpor package auth untuk scope token stream
+    "rest-api-go/pkg/middleware"               // Mengimpor middleware RequireAuth dan AuthenticateScoped
+
+    "github.com/gin-gonic/gin"                 // Mengimpor framework web Gin
+)
+
+func RegisterRoutes(router *gin.RouterGroup, handler *EventHandler) {  // Fungsi untuk mendaftarkan route
+    events := router.Group("/events")          // Membuat grup route dengan prefix "/events"
+    {
+        events.POST("/stream-token", middleware.RequireAuth(), handler.StreamToken)  // Mendaftarkan endpoint POST untuk token stream, wajib token bearer
+        events.GET("/stream", middleware.AuthenticateScoped(auth.Default(), auth.ScopeEventStream), middleware.RequireAuth(), handler.Stream)  // Mendaftarkan endpoint GET untuk Server-Sent Events, wajib login karena event berisi perubahan data user
+    }
+}
+
+
+// {{{ Penjelasan Fungsi RegisterRoutes }}}
+
+/*
+## Penjelasan Detail
+File route.go ini berisi konfigurasi routing untuk modul Event. Berikut penjelasan detailnya:
+
+1. Endpoint API :
+
+    - POST /events/stream-token : Membuat token stream berumur pendek untuk EventSource
+    - GET /events/stream : Stream Server-Sent Events berisi domain event product, category, dan user; event order hanya untuk staff dan terminal
+2. Autentikasi :
+
+    - Kedua endpoint memakai middleware.RequireAuth, request tanpa token yang valid dijawab 401
+    - GET /events/stream juga menerima token stream lewat ?access_token= atau cookie access_token (AuthenticateScoped), karena EventSource bawaan browser tidak dapat mengirim header Authorization
+    - auth.Default() sudah diganti main.go sebelum Initialize dipanggil
+*/
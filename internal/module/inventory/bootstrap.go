@@ -0,0 +1,40 @@
+package inventory                              // Mendefinisikan package inventory
+
+import (
+	"rest-api-go/internal/module/inventory/handler"  // Mengimpor package handler dari modul inventory
+	"rest-api-go/internal/module/inventory/service"  // Mengimpor package service dari modul inventory
+
+	"github.com/gin-gonic/gin"                     // Mengimpor framework web Gin
+	"gorm.io/gorm"                                 // Mengimpor ORM GORM
+)
+
+// Initialize - Fungsi untuk menginisialisasi modul inventory
+func Initialize(db *gorm.DB, router *gin.RouterGroup) {  // Fungsi untuk inisialisasi modul dengan parameter database dan router
+	// Initialize service
+	inventoryService := service.NewInventoryService(db)  // Membuat instance service inventory dengan menyuntikkan database
+
+	// Initialize handler
+	inventoryHandler := handler.NewInventoryHandler(inventoryService)  // Membuat instance handler dengan menyuntikkan service
+
+	// Register routes
+	handler.RegisterRoutes(router, inventoryHandler)   // Mendaftarkan route untuk modul inventory
+}
+
+
+// {{{ Penjelasan Fungsi Initialize }}}
+
+/*
+## Penjelasan Detail
+File bootstrap.go ini berfungsi sebagai titik masuk (entry point) untuk modul inventory. Berikut penjelasan detailnya:
+
+1. Tujuan : Menghubungkan service, handler, dan route modul inventory dengan pola Dependency Injection.
+2. Alur Kerja :
+
+	- Membuat instance service dengan menyuntikkan database
+	- Membuat instance handler dengan menyuntikkan service
+	- Mendaftarkan route stok di bawah /products/:id/stock dan /inventory
+3. Hubungan dengan Aplikasi Utama :
+
+	- Fungsi Initialize dipanggil dari main.go setelah modul product
+Modul ini mengikuti pola yang sama dengan modul product, category, dan user.
+*/
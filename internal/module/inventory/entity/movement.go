@@ -0,0 +1,101 @@
+package entity                                // Mendefinisikan package entity untuk modul inventory
+
+import (
+    "rest-api-go/pkg/validation"              // Package validation dengan validator bersama
+    "time"                                    // Package time untuk tipe data waktu
+)
+
+type MovementType string                      // Jenis perubahan stok
+
+const (
+    MovementReceipt    MovementType = "receipt"     // Barang masuk dari supplier
+    MovementSale       MovementType = "sale"        // Barang keluar karena terjual
+    MovementAdjustment MovementType = "adjustment"  // Koreksi hasil stock opname (boleh positif atau negatif)
+    MovementReturn     MovementType = "return"      // Barang kembali dari pelanggan
+)
+
+type StockMovement struct {                   // Mendefinisikan struct StockMovement (ledger append-only)
+    ID           uint         `json:"id" gorm:"primaryKey"`  // ID movement sebagai primary key
+    ProductID    uint         `json:"product_id" gorm:"index;not null"`  // ID product yang stoknya berubah
+    VariantID    *uint        `json:"variant_id" gorm:"index"`  // ID varian yang stoknya berubah, null untuk product tanpa varian
+    Type         MovementType `json:"type" gorm:"type:varchar(20);not null"`  // Jenis movement
+    Quantity     int64        `json:"quantity" gorm:"not null"`  // Perubahan stok bertanda: positif menambah, negatif mengurangi
+    BalanceAfter int64        `json:"balance_after" gorm:"not null"`  // Stok product (atau varian jika VariantID diisi) setelah movement ini
+    Reason       string       `json:"reason" gorm:"size:255"`  // Alasan perubahan stok
+    Actor        string       `json:"actor" gorm:"size:255"`  // Siapa yang melakukan perubahan
+    Reference    string       `json:"reference" gorm:"size:255;index"`  // Referensi dokumen lain, misal nomor order
+    CreatedAt    time.Time    `json:"created_at"`  // Waktu movement dicatat (tidak ada UpdatedAt karena tidak pernah diubah)
+}
+
+type MovementRequest struct {                 // Mendefinisikan struct body request untuk mencatat movement
+    Type      MovementType `json:"type" binding:"required,oneof=receipt sale adjustment return"`  // Jenis movement wajib diisi
+    VariantID *uint        `json:"variant_id"`  // Wajib untuk product yang memiliki varian
+    Quantity  int64        `json:"quantity" binding:"required,min=-1000000000,max=1000000000"`  // Jumlah barang, tidak boleh 0, paling banyak satu miliar per movement
+    Reason    string       `json:"reason" binding:"required_if=Type adjustment,max=255"`  // Alasan wajib untuk adjustment
+    Actor     string       `json:"actor" binding:"max=255"`  // Diisi otomatis dari user yang login jika tersedia
+    Reference string       `json:"reference" binding:"max=255"`  // Referensi opsional
+}
+
+func (r *MovementRequest) Validate() error {  // Method untuk validasi struct MovementRequest
+    return validation.Struct(r)               // Memvalidasi struct berdasarkan tag binding dengan validator bersama
+}
+
+// Delta - perubahan stok bertanda untuk request ini
+func (r *MovementRequest) Delta() int64 {     // Receipt dan return menambah stok, sale mengurangi, adjustment apa adanya
+    switch r.Type {
+    case MovementSale:
+        return -r.Quantity
+    default:
+        return r.Quantity
+    }
+}
+
+type StockLevel struct {                      // Mendefinisikan struct respons stok saat ini
+    ProductID         uint    `json:"product_id"`  // ID product
+    SKU               *string `json:"sku"`         // Kode stok product
+    Stock             int64   `json:"stock"`       // Jumlah stok saat ini
+    LowStockThreshold int64   `json:"low_stock_threshold"`  // Batas stok rendah
+    LowStock          bool    `json:"low_stock"`   // True jika stok berada di bawah atau sama dengan batas
+    Variants          []VariantLevel `json:"variants,omitempty"`  // Stok per varian, kosong untuk product tanpa varian
+}
+
+type VariantLevel struct {                    // Mendefinisikan struct stok satu varian
+    VariantID uint    `json:"variant_id"`     // ID varian
+    SKU       *string `json:"sku"`            // Kode stok varian
+    Label     string  `json:"label"`          // Nilai atribut varian, misal "Red / M"
+    Stock     int64   `json:"stock"`          // Jumlah stok varian saat ini
+}
+
+
+//  {{{ Penjelasan Struktur StockMovement }}}
+
+/*
+## Penjelasan Detail
+File movement.go ini mendefinisikan struktur data untuk ledger stok. Berikut penjelasan detailnya:
+
+1. Tujuan : Menggantikan spreadsheet stok dengan catatan perubahan stok di database.
+2. StockMovement :
+
+    - Ledger append-only: baris tidak pernah diubah atau dihapus, koreksi dilakukan dengan movement adjustment baru
+    - Quantity bertanda sehingga SUM(quantity) per product sama dengan stok product; quantity per movement dibatasi satu miliar
+    - BalanceAfter menyimpan stok setelah movement untuk memudahkan audit
+    - VariantID diisi untuk product yang memiliki varian; BalanceAfter lalu berisi stok varian tersebut
+    - Actor dan Reason menjelaskan siapa dan mengapa stok berubah
+3. Jenis Movement :
+
+    - receipt : Barang masuk, quantity positif
+    - sale : Barang terjual, quantity dikirim positif lalu disimpan negatif
+    - return : Barang kembali dari pelanggan, quantity positif
+    - adjustment : Koreksi stok, quantity boleh positif atau negatif dan reason wajib diisi
+4. MovementRequest :
+
+    - Body request untuk POST /api/products/:id/stock/movements
+    - Delta() mengubah quantity menjadi perubahan stok bertanda
+    - variant_id wajib untuk product yang memiliki varian, karena stok product adalah jumlah stok variannya
+5. StockLevel :
+
+    - Respons untuk GET /api/products/:id/stock
+    - LowStock bernilai true jika product memiliki batas stok rendah dan stok sudah mencapai batas tersebut
+    - Variants berisi stok setiap varian (VariantLevel) untuk product yang memiliki varian
+Entitas ini hanya memiliki CreatedAt karena movement tidak pernah diperbarui.
+*/
@@ -0,0 +1,114 @@
+package handler                                // Mendefinisikan package handler untuk modul inventory
+
+import (
+    "net/http"                                 // Package untuk konstanta HTTP
+    "rest-api-go/internal/module/inventory/entity"   // Mengimpor entity inventory
+    "rest-api-go/internal/module/inventory/service"  // Mengimpor service inventory
+    "rest-api-go/pkg/middleware"               // Mengimpor key user ID dari middleware
+    "rest-api-go/pkg/utils"                    // Mengimpor utilitas aplikasi
+    "strconv"                                  // Package untuk konversi string
+
+    "github.com/gin-gonic/gin"                 // Framework web Gin
+)
+
+type InventoryHandler struct {                 // Mendefinisikan struct handler
+    service *service.InventoryService          // Dependency service
+}
+
+func NewInventoryHandler(service *service.InventoryService) *InventoryHandler {  // Constructor untuk handler
+    return &InventoryHandler{service}          // Mengembalikan instance handler dengan service yang diinjeksi
+}
+
+func (h *InventoryHandler) CreateMovement(c *gin.Context) {  // Handler untuk mencatat stock movement
+    id, err := strconv.ParseUint(c.Param("id"), 10, 32)  // Mengambil dan mengkonversi parameter ID
+    if err != nil {
+        utils.ErrorJSON(c, http.StatusBadRequest, "Invalid ID")  // Respons error jika ID tidak valid
+        return
+    }
+
+    var req entity.MovementRequest             // Variabel untuk menampung data movement dari request
+    if err := utils.BindJSON(c, &req); err != nil {  // Binding JSON request ke struct secara ketat
+        utils.HandleError(c, http.StatusBadRequest, err)  // Respons error jika binding gagal (400, 413, atau 415)
+        return
+    }
+    if userID, ok := middleware.CurrentUserID(c); ok {  // Jika user sudah login, actor selalu user tersebut
+        req.Actor = strconv.FormatUint(uint64(userID), 10)
+    }
+
+    movement, err := h.service.Record(c.Request.Context(), uint(id), &req)  // Memanggil service untuk mencatat movement
+    if err != nil {
+        utils.HandleError(c, http.StatusInternalServerError, err)  // Respons error (404, 409, atau 500)
+        return
+    }
+
+    c.JSON(http.StatusCreated, utils.SuccessResponse(movement))  // Respons sukses dengan data movement
+}
+
+func (h *InventoryHandler) ListMovements(c *gin.Context) {  // Handler untuk mendapatkan riwayat movement
+    id, err := strconv.ParseUint(c.Param("id"), 10, 32)  // Mengambil dan mengkonversi parameter ID
+    if err != nil {
+        utils.ErrorJSON(c, http.StatusBadRequest, "Invalid ID")  // Respons error jika ID tidak valid
+        return
+    }
+
+    movements, err := h.service.ListMovements(c.Request.Context(), uint(id))  // Memanggil service untuk mendapatkan movement
+    if err != nil {
+        utils.HandleError(c, http.StatusInternalServerError, err)  // Respons error jika gagal
+        return
+    }
+
+    c.JSON(http.StatusOK, utils.SuccessResponse(movements))  // Respons sukses dengan data movements
+}
+
+func (h *InventoryHandler) GetStock(c *gin.Context) {  // Handler untuk mendapatkan stok saat ini
+    id, err := strconv.ParseUint(c.Param("id"), 10, 32)  // Mengambil dan mengkonversi parameter ID
+    if err != nil {
+        utils.ErrorJSON(c, http.StatusBadRequest, "Invalid ID")  // Respons error jika ID tidak valid
+        return
+    }
+
+    level, err := h.service.GetStock(c.Request.Context(), uint(id))  // Memanggil service untuk mendapatkan stok
+    if err != nil {
+        utils.HandleError(c, http.StatusInternalServerError, err)  // Respons error jika gagal
+        return
+    }
+
+    c.JSON(http.StatusOK, utils.SuccessResponse(level))  // Respons sukses dengan data stok
+}
+
+func (h *InventoryHandler) LowStock(c *gin.Context) {  // Handler untuk mendapatkan product dengan stok rendah
+    levels, err := h.service.LowStock(c.Request.Context())  // Memanggil service untuk mendapatkan product stok rendah
+    if err != nil {
+        utils.HandleError(c, http.StatusInternalServerError, err)  // Respons error jika gagal
+        return
+    }
+
+    c.JSON(http.StatusOK, utils.SuccessResponse(levels))  // Respons sukses dengan data stok
+}
+
+
+// {{{ Penjelasan Fungsi Handler }}}
+
+/*
+## Penjelasan Detail
+File handler.go ini berisi implementasi handler HTTP untuk modul Inventory. Berikut penjelasan detailnya:
+
+1. Tujuan : File ini menangani HTTP request/response untuk stok product dan ledger movement.
+2. Operasi :
+
+    - CreateMovement : Mencatat receipt, sale, adjustment, atau return untuk product
+    - ListMovements : Mendapatkan riwayat movement product
+    - GetStock : Mendapatkan stok saat ini dan status stok rendah
+    - LowStock : Mendapatkan semua product yang stoknya mencapai batas stok rendah
+3. Actor :
+
+    - Jika user sudah login (middleware mengisi user_id di gin.Context), actor diisi dengan user tersebut
+    - Jika belum ada autentikasi, actor diambil dari body request
+4. Penanganan Error :
+
+    - Error binding JSON: Status 400, 413, atau 415
+    - Product tidak ditemukan: Status 404 Not Found
+    - Stok tidak mencukupi: Status 409 Conflict
+    - Error internal: Status 500 Internal Server Error
+Struktur handler ini mengikuti modul product dan category.
+*/
@@ -0,0 +1,38 @@
+package handler                                // Mendefinisikan package handler untuk modul inventory
+
+import (
+    "github.com/gin-gonic/gin"                 // Mengimpor framework web Gin
+)
+
+func RegisterRoutes(router *gin.RouterGroup, handler *InventoryHandler) {  // Fungsi untuk mendaftarkan route
+    stock := router.Group("/products/:id/stock")  // Stok berada di bawah resource product
+    {
+        stock.GET("", handler.GetStock)        // Mendaftarkan endpoint GET untuk mendapatkan stok saat ini
+        stock.GET("/movements", handler.ListMovements)  // Mendaftarkan endpoint GET untuk riwayat movement
+        stock.POST("/movements", handler.CreateMovement)  // Mendaftarkan endpoint POST untuk mencatat movement
+    }
+
+    inventory := router.Group("/inventory")    // Membuat grup route dengan prefix "/inventory"
+    {
+        inventory.GET("/low-stock", handler.LowStock)  // Mendaftarkan endpoint GET untuk product dengan stok rendah
+    }
+}
+
+
+// {{{ Penjelasan Fungsi RegisterRoutes }}}
+
+/*
+## Penjelasan Detail
+File route.go ini berisi konfigurasi routing untuk modul Inventory. Berikut penjelasan detailnya:
+
+1. Endpoint API :
+
+    - GET /products/:id/stock : Mendapatkan stok saat ini
+    - GET /products/:id/stock/movements : Mendapatkan riwayat movement
+    - POST /products/:id/stock/movements : Mencatat movement baru
+    - GET /inventory/low-stock : Mendapatkan product dengan stok rendah
+2. Parameter URL :
+
+    - :id : ID product, nama parameter sama dengan route product agar tidak bentrok di router Gin
+Route stok diletakkan di bawah /products karena stok adalah bagian dari product, sedangkan logikanya dipisah ke modul inventory.
+*/
@@ -0,0 +1,65 @@
+package service                                // Mendefinisikan package service untuk modul inventory
+
+import (
+    "rest-api-go/internal/module/inventory/entity"  // Mengimpor entity inventory
+    productEntity "rest-api-go/internal/module/product/entity"  // Mengimpor entity product
+    "rest-api-go/pkg/events"                  // Mengimpor outbox domain event
+
+    "gorm.io/gorm"                            // Mengimpor ORM GORM
+)
+
+const (
+    EventStockChanged = "product.stock_changed"  // Stok product atau varian berubah lewat stock movement
+
+    eventEntity = "product"                   // Event stok milik entitas product agar ikut pola product.*
+)
+
+type stockChangedData struct {                // Mendefinisikan struct data event product.stock_changed
+    ProductID    uint                `json:"product_id"`    // ID product
+    CategoryID   uint                `json:"category_id"`   // Kategori product, untuk subscriber per kategori
+    VariantID    *uint               `json:"variant_id"`    // ID varian, null untuk product tanpa varian
+    Stock        int64               `json:"stock"`         // Stok product setelah movement
+    VariantStock *int64              `json:"variant_stock"` // Stok varian setelah movement, null untuk product tanpa varian
+    LowStock     bool                `json:"low_stock"`     // True jika stok product berada di bawah atau sama dengan batas
+    MovementID   uint                `json:"movement_id"`   // ID baris ledger
+    Type         entity.MovementType `json:"type"`          // Jenis movement
+    Quantity     int64               `json:"quantity"`      // Perubahan stok bertanda
+    Reference    string              `json:"reference"`     // Referensi movement, misal order:12
+}
+
+func recordStockChanged(tx *gorm.DB, product *productEntity.Product, variant *productEntity.ProductVariant, movement *entity.StockMovement) error {  // Fungsi untuk mencatat product.stock_changed di transaksi tx
+    data := stockChangedData{
+        ProductID:  product.ID,
+        CategoryID: product.CategoryID,
+        VariantID:  movement.VariantID,
+        Stock:      product.Stock,
+        LowStock:   product.IsLowStock(),
+        MovementID: movement.ID,
+        Type:       movement.Type,
+        Quantity:   movement.Quantity,
+        Reference:  movement.Reference,
+    }
+    if variant != nil {
+        data.VariantStock = &variant.Stock
+    }
+    return events.Record(tx, EventStockChanged, eventEntity, product.ID, data)
+}
+
+
+// {{{ Penjelasan Event Inventory }}}
+
+/*
+## Penjelasan Detail
+File events.go ini berisi domain event yang dicatat service inventory. Berikut penjelasan detailnya:
+
+1. Tipe Event :
+
+    - product.stock_changed : Setiap stock movement, baik dari endpoint inventory, checkout, maupun pengembalian stok saat order dibatalkan atau di-refund
+2. Data :
+
+    - Stok product (dan varian) setelah movement beserta movement yang menyebabkannya
+    - category_id disertakan agar client WebSocket yang berlangganan kategori ikut menerima perubahan stok
+3. Transaksi :
+
+    - Event dicatat di Apply, di transaksi yang sama dengan movement, sehingga checkout yang di-rollback tidak pernah menghasilkan event
+*/
@@ -0,0 +1,250 @@
+package service                                // Mendefinisikan package service untuk modul inventory
+
+import (
+    "context"                                 // Package untuk context request
+    "errors"                                  // Package untuk pengecekan error
+    "net/http"                                // Package untuk konstanta HTTP
+    "rest-api-go/internal/module/inventory/entity"  // Mengimpor entity inventory
+    productEntity "rest-api-go/internal/module/product/entity"  // Mengimpor entity product
+    "rest-api-go/pkg/tracing"                 // Mengimpor package tracing untuk span service
+    "rest-api-go/pkg/utils"                   // Mengimpor utilitas aplikasi untuk HTTPError
+
+    "gorm.io/gorm"                            // Mengimpor ORM GORM
+    "gorm.io/gorm/clause"                     // Klausa SELECT ... FOR UPDATE
+)
+
+const MaxStock = 1_000_000_000_000            // Batas stok per product atau varian, tetap aman sebagai angka JSON di client JavaScript
+
+var (
+    ErrProductNotFound   = utils.NewHTTPError(http.StatusNotFound, "Product not found")  // Product tidak ada
+    ErrInsufficientStock = utils.NewHTTPError(http.StatusConflict, "insufficient stock")  // Movement akan membuat stok negatif
+    ErrVariantNotFound   = utils.NewHTTPError(http.StatusNotFound, "Variant not found")  // Varian tidak ada atau milik product lain
+    ErrVariantRequired   = utils.NewHTTPError(http.StatusBadRequest, "variant_id is required for products with variants")  // Stok product bervarian dikelola per varian
+    ErrStockTooLarge     = utils.NewHTTPError(http.StatusUnprocessableEntity, "stock would exceed the maximum of 1000000000000")  // Movement membuat stok melebihi MaxStock
+    ErrInvalidQuantity   = utils.NewHTTPError(http.StatusBadRequest, "quantity must be positive for receipt, sale and return; use adjustment for negative corrections")  // Tanda quantity tidak sesuai jenis movement
+)
+
+type InventoryService struct {                // Mendefinisikan struct service
+    db *gorm.DB                               // Dependency database
+}
+
+func NewInventoryService(db *gorm.DB) *InventoryService {  // Constructor untuk service
+    return &InventoryService{db}              // Mengembalikan instance service dengan database yang diinjeksi
+}
+
+// Apply - mencatat movement di dalam transaksi yang sudah berjalan
+func (s *InventoryService) Apply(tx *gorm.DB, movement *entity.StockMovement) error {  // Dipakai juga oleh modul lain (misal checkout order) agar stok berubah di transaksi yang sama
+    var product productEntity.Product         // Variabel untuk menampung product yang dikunci
+    err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&product, movement.ProductID).Error  // SELECT ... FOR UPDATE agar movement lain untuk product ini menunggu
+    if errors.Is(err, gorm.ErrRecordNotFound) {
+        return ErrProductNotFound             // Product tidak ditemukan
+    }
+    if err != nil {
+        return err
+    }
+
+    variant, err := s.lockVariant(tx, &product, movement.VariantID)  // Kunci varian jika product memiliki varian
+    if err != nil {
+        return err
+    }
+
+    balance, err := addStock(product.Stock, movement.Quantity)  // Stok setelah movement
+    if err != nil {
+        return err
+    }
+    movement.BalanceAfter = balance           // Simpan saldo setelah movement untuk audit
+
+    if variant != nil {
+        variantBalance, err := addStock(variant.Stock, movement.Quantity)  // Stok varian setelah movement
+        if err != nil {
+            return err
+        }
+        if err := tx.Model(variant).Update("stock", variantBalance).Error; err != nil {  // Perbarui stok varian
+            return err
+        }
+        variant.Stock = variantBalance        // Dipakai data event
+        movement.BalanceAfter = variantBalance  // Saldo ledger varian
+    }
+
+    if err := tx.Model(&product).Update("stock", balance).Error; err != nil {  // Perbarui stok product, selalu sama dengan jumlah stok varian
+        return err
+    }
+    product.Stock = balance                   // Dipakai data event
+    if err := tx.Create(movement).Error; err != nil {  // Tambahkan baris baru ke ledger
+        return err
+    }
+    return recordStockChanged(tx, &product, variant, movement)  // product.stock_changed untuk sink, SSE, dan WebSocket
+}
+
+func (s *InventoryService) lockVariant(tx *gorm.DB, product *productEntity.Product, variantID *uint) (*productEntity.ProductVariant, error) {  // Fungsi untuk mengunci varian movement, nil untuk product tanpa varian
+    if variantID == nil {
+        var count int64                       // Jumlah varian product
+        if err := tx.Model(&productEntity.ProductVariant{}).Where("product_id = ?", product.ID).Count(&count).Error; err != nil {
+            return nil, err
+        }
+        if count > 0 {
+            return nil, ErrVariantRequired    // Stok product bervarian tidak boleh diubah tanpa varian
+        }
+        return nil, nil
+    }
+
+    var variant productEntity.ProductVariant  // Variabel untuk menampung varian yang dikunci
+    err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("product_id = ?", product.ID).First(&variant, *variantID).Error
+    if errors.Is(err, gorm.ErrRecordNotFound) {
+        return nil, ErrVariantNotFound        // Varian tidak ada atau milik product lain
+    }
+    if err != nil {
+        return nil, err
+    }
+    return &variant, nil
+}
+
+func addStock(stock, quantity int64) (int64, error) {  // Fungsi untuk menjumlahkan stok dengan movement tanpa overflow
+    if quantity > 0 && stock > MaxStock-quantity {  // Stok selalu 0..MaxStock sehingga pengurangan ini tidak overflow
+        return 0, ErrStockTooLarge
+    }
+    balance := stock + quantity
+    if balance < 0 {
+        return 0, ErrInsufficientStock        // Stok tidak boleh negatif
+    }
+    return balance, nil
+}
+
+func (s *InventoryService) Record(ctx context.Context, productID uint, req *entity.MovementRequest) (*entity.StockMovement, error) {  // Method untuk mencatat movement dari request API
+    ctx, span := tracing.Start(ctx, "InventoryService.Record")  // Membuat child span untuk method service
+    defer span.End()                          // Menutup span saat method selesai
+
+    if err := req.Validate(); err != nil {    // Validasi data request
+        return nil, err                       // Mengembalikan error jika validasi gagal
+    }
+    if req.Type != entity.MovementAdjustment && req.Quantity < 0 {  // Hanya adjustment yang boleh negatif
+        return nil, ErrInvalidQuantity
+    }
+
+    movement := &entity.StockMovement{        // Movement yang akan dicatat
+        ProductID: productID,
+        VariantID: req.VariantID,
+        Type:      req.Type,
+        Quantity:  req.Delta(),               // Quantity bertanda
+        Reason:    req.Reason,
+        Actor:     req.Actor,
+        Reference: req.Reference,
+    }
+    err := s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {  // Kunci product, cek stok, dan simpan movement secara atomik
+        return s.Apply(tx, movement)
+    })
+    if err != nil {
+        return nil, err                       // Transaksi di-rollback otomatis
+    }
+    return movement, nil
+}
+
+func (s *InventoryService) GetStock(ctx context.Context, productID uint) (*entity.StockLevel, error) {  // Method untuk mendapatkan stok saat ini
+    ctx, span := tracing.Start(ctx, "InventoryService.GetStock")  // Membuat child span untuk method service
+    defer span.End()                          // Menutup span saat method selesai
+
+    var product productEntity.Product         // Variabel untuk menampung hasil query
+    err := s.db.WithContext(ctx).
+        Preload("Variants", func(db *gorm.DB) *gorm.DB { return db.Order("id") }).
+        Preload("Variants.Values", func(db *gorm.DB) *gorm.DB { return db.Order("id") }).
+        First(&product, productID).Error      // Query product berdasarkan ID beserta varian
+    if errors.Is(err, gorm.ErrRecordNotFound) {
+        return nil, ErrProductNotFound
+    }
+    if err != nil {
+        return nil, err
+    }
+
+    level := stockLevel(&product)
+    for i := range product.Variants {         // Stok per varian
+        variant := &product.Variants[i]
+        level.Variants = append(level.Variants, entity.VariantLevel{
+            VariantID: variant.ID,
+            SKU:       variant.SKU,
+            Label:     variant.Label(),
+            Stock:     variant.Stock,
+        })
+    }
+    return level, nil
+}
+
+func (s *InventoryService) ListMovements(ctx context.Context, productID uint) ([]entity.StockMovement, error) {  // Method untuk mendapatkan riwayat movement product
+    ctx, span := tracing.Start(ctx, "InventoryService.ListMovements")  // Membuat child span untuk method service
+    defer span.End()                          // Menutup span saat method selesai
+
+    var count int64                           // Variabel untuk menampung jumlah product
+    if err := s.db.WithContext(ctx).Model(&productEntity.Product{}).Where("id = ?", productID).Count(&count).Error; err != nil {  // Memeriksa apakah product ada
+        return nil, err
+    }
+    if count == 0 {
+        return nil, ErrProductNotFound
+    }
+
+    var movements []entity.StockMovement      // Variabel untuk menampung hasil query
+    err := s.db.WithContext(ctx).Where("product_id = ?", productID).Order("id DESC").Find(&movements).Error  // Movement terbaru lebih dulu
+    return movements, err
+}
+
+func (s *InventoryService) LowStock(ctx context.Context) ([]entity.StockLevel, error) {  // Method untuk mendapatkan product dengan stok rendah
+    ctx, span := tracing.Start(ctx, "InventoryService.LowStock")  // Membuat child span untuk method service
+    defer span.End()                          // Menutup span saat method selesai
+
+    var products []productEntity.Product      // Variabel untuk menampung hasil query
+    err := s.db.WithContext(ctx).
+        Where("low_stock_threshold > 0 AND stock <= low_stock_threshold").  // Hanya product yang dipantau dan sudah mencapai batas
+        Order("stock ASC").
+        Find(&products).Error
+    if err != nil {
+        return nil, err
+    }
+
+    levels := make([]entity.StockLevel, 0, len(products))
+    for i := range products {
+        levels = append(levels, *stockLevel(&products[i]))
+    }
+    return levels, nil
+}
+
+func stockLevel(product *productEntity.Product) *entity.StockLevel {  // Fungsi untuk mengubah product menjadi StockLevel
+    return &entity.StockLevel{
+        ProductID:         product.ID,
+        SKU:               product.SKU,
+        Stock:             product.Stock,
+        LowStockThreshold: product.LowStockThreshold,
+        LowStock:          product.IsLowStock(),
+    }
+}
+
+
+
+// {{{ Penjelasan Fungsi Service }}}
+
+/*
+## Penjelasan Detail
+File service.go ini berisi logika bisnis untuk modul Inventory. Berikut penjelasan detailnya:
+
+1. Tujuan : Mengelola stok product melalui ledger StockMovement.
+2. Apply :
+
+    - Menerima transaksi (tx) dari pemanggil sehingga dapat digabung dengan operasi lain, misal checkout order
+    - Mengunci baris product dengan SELECT ... FOR UPDATE (clause.Locking)
+    - Product dengan varian: VariantID wajib (400), varian ikut dikunci dan stok varian serta stok product berubah bersama
+    - Menolak movement yang membuat stok negatif dengan ErrInsufficientStock (409 Conflict)
+    - Penjumlahan stok diperiksa dengan addStock: stok di atas MaxStock ditolak dengan ErrStockTooLarge (422) sebelum int64 bisa overflow; quantity dari API juga dibatasi tag binding (maksimal satu miliar)
+    - Memperbarui kolom stock lalu menambahkan baris ke ledger dengan BalanceAfter
+    - Mencatat event product.stock_changed di transaksi yang sama (lihat events.go)
+3. Operasi :
+
+    - Record : Validasi request lalu menjalankan Apply di dalam transaksi baru
+    - GetStock : Stok saat ini beserta status stok rendah dan stok per varian
+    - ListMovements : Riwayat movement product, terbaru lebih dulu
+    - LowStock : Product dengan low_stock_threshold > 0 dan stock <= low_stock_threshold
+4. Konkurensi :
+
+    - Dua request sale bersamaan untuk product yang sama dijalankan berurutan karena lock baris
+    - Request kedua membaca stok yang sudah dikurangi request pertama sehingga stok tidak pernah negatif
+5. Penanganan Error :
+
+    - Error dikembalikan sebagai utils.HTTPError (404 product/varian, 409, 400) sehingga handler cukup memanggil utils.HandleError
+Stok hanya boleh berubah melalui service ini agar ledger dan kolom stock selalu konsisten.
+*/
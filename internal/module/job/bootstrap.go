@@ -0,0 +1,38 @@
+package job                                    // Mendefinisikan package job
+
+import (
+	"rest-api-go/internal/module/job/handler"      // Mengimpor package handler dari modul job
+	"rest-api-go/internal/module/job/service"      // Mengimpor package service dari modul job
+
+	"github.com/gin-gonic/gin"                     // Mengimpor framework web Gin
+	"gorm.io/gorm"                                 // Mengimpor ORM GORM
+)
+
+// Initialize - Fungsi untuk menginisialisasi modul job
+func Initialize(db *gorm.DB, router *gin.RouterGroup) {  // Fungsi untuk inisialisasi modul dengan parameter database dan router
+	// Initialize service
+	jobService := service.NewJobService(db)        // Membuat instance service job dengan menyuntikkan database
+
+	// Initialize handler
+	jobHandler := handler.NewJobHandler(jobService)  // Membuat instance handler dengan menyuntikkan service
+
+	// Register routes
+	handler.RegisterRoutes(router, jobHandler)         // Mendaftarkan route untuk modul job
+}
+
+
+// {{{ Penjelasan Fungsi Initialize }}}
+
+/*
+## Penjelasan Detail
+File bootstrap.go ini berfungsi sebagai titik masuk (entry point) untuk modul job. Berikut penjelasan detailnya:
+
+1. Tujuan : Menghubungkan service, handler, dan route modul job dengan pola Dependency Injection.
+2. Hubungan dengan pkg/jobs :
+
+	- Modul ini hanya menyediakan endpoint status di bawah /jobs
+	- Runner yang menjalankan job dibuat di main.go, dan modul lain mendaftarkan handler job-nya sendiri
+3. Hubungan dengan Aplikasi Utama :
+
+	- Fungsi Initialize dipanggil dari main.go
+*/
@@ -0,0 +1,35 @@
+package entity                                // Mendefinisikan package entity untuk modul job
+
+import (
+    "rest-api-go/pkg/jobs"                    // Mengimpor package jobs untuk status job
+)
+
+const (
+    DefaultListLimit = 50                     // Jumlah job per halaman jika ?limit tidak diisi
+    MaxListLimit     = 500                    // Jumlah job maksimum per halaman
+)
+
+type ListFilter struct {                      // Mendefinisikan struct filter daftar job
+    Status   jobs.Status                      // ?status=dead, kosong berarti semua status
+    Type     string                           // ?type=cart.purge_expired
+    Queue    string                           // ?queue=default
+    BeforeID uint                             // ?before_id=, halaman berikutnya dimulai dari ID terkecil halaman sebelumnya
+    Limit    int                              // ?limit=, 1 sampai MaxListLimit
+}
+
+
+//  {{{ Penjelasan Struktur ListFilter }}}
+
+/*
+## Penjelasan Detail
+File filter.go ini mendefinisikan filter daftar job. Berikut penjelasan detailnya:
+
+1. Model Job :
+
+    - Model Job dan Schedule didefinisikan di pkg/jobs karena dipakai langsung oleh runner
+    - Modul job hanya membaca dan mengubah status job lewat API
+2. ListFilter :
+
+    - Semua field opsional dan digabung dengan AND
+    - Job diurutkan dari ID terbesar (terbaru); BeforeID dipakai untuk halaman berikutnya tanpa OFFSET
+*/
@@ -0,0 +1,140 @@
+package handler                                // Mendefinisikan package handler untuk modul job
+
+import (
+    "fmt"                                      // Package untuk formatting pesan error
+    "net/http"                                 // Package untuk konstanta HTTP
+    "rest-api-go/internal/module/job/entity"   // Mengimpor entity job
+    "rest-api-go/internal/module/job/service"  // Mengimpor service job
+    "rest-api-go/pkg/jobs"                     // Mengimpor status job
+    "rest-api-go/pkg/utils"                    // Mengimpor utilitas aplikasi
+    "slices"                                   // Package untuk memeriksa status
+    "strconv"                                  // Package untuk konversi string
+
+    "github.com/gin-gonic/gin"                 // Framework web Gin
+)
+
+type JobHandler struct {                       // Mendefinisikan struct handler
+    service *service.JobService                // Dependency service
+}
+
+func NewJobHandler(service *service.JobService) *JobHandler {  // Constructor untuk handler
+    return &JobHandler{service}                // Mengembalikan instance handler dengan service yang diinjeksi
+}
+
+func (h *JobHandler) List(c *gin.Context) {    // Handler untuk mendapatkan daftar job
+    filter := entity.ListFilter{
+        Status: jobs.Status(c.Query("status")),
+        Type:   c.Query("type"),
+        Queue:  c.Query("queue"),
+        Limit:  entity.DefaultListLimit,
+    }
+    if filter.Status != "" && !slices.Contains(jobs.Statuses, filter.Status) {
+        utils.ErrorJSON(c, http.StatusBadRequest, fmt.Sprintf("status must be one of %v", jobs.Statuses))
+        return
+    }
+    if raw := c.Query("limit"); raw != "" {
+        limit, err := strconv.Atoi(raw)
+        if err != nil || limit < 1 || limit > entity.MaxListLimit {
+            utils.ErrorJSON(c, http.StatusBadRequest, fmt.Sprintf("limit must be between 1 and %d", entity.MaxListLimit))
+            return
+        }
+        filter.Limit = limit
+    }
+    if raw := c.Query("before_id"); raw != "" {
+        id, err := strconv.ParseUint(raw, 10, 32)
+        if err != nil {
+            utils.ErrorJSON(c, http.StatusBadRequest, "before_id must be a positive integer")
+            return
+        }
+        filter.BeforeID = uint(id)
+    }
+
+    list, err := h.service.List(c.Request.Context(), filter)  // Memanggil service untuk mendapatkan job
+    if err != nil {
+        utils.HandleError(c, http.StatusInternalServerError, err)  // Respons error jika gagal
+        return
+    }
+
+    c.JSON(http.StatusOK, utils.SuccessResponse(list))  // Respons sukses dengan daftar job
+}
+
+func (h *JobHandler) GetByID(c *gin.Context) { // Handler untuk mendapatkan job berdasarkan ID
+    id, err := strconv.ParseUint(c.Param("id"), 10, 32)  // Mengambil dan mengkonversi parameter ID
+    if err != nil {
+        utils.ErrorJSON(c, http.StatusBadRequest, "Invalid ID")  // Respons error jika ID tidak valid
+        return
+    }
+
+    job, err := h.service.GetByID(c.Request.Context(), uint(id))  // Memanggil service untuk mendapatkan job
+    if err != nil {
+        utils.HandleError(c, http.StatusInternalServerError, err)  // Respons error (404 atau 500)
+        return
+    }
+
+    c.JSON(http.StatusOK, utils.SuccessResponse(job))  // Respons sukses dengan data job
+}
+
+func (h *JobHandler) Retry(c *gin.Context) {   // Handler untuk menjalankan ulang job dead atau cancelled
+    id, err := strconv.ParseUint(c.Param("id"), 10, 32)  // Mengambil dan mengkonversi parameter ID
+    if err != nil {
+        utils.ErrorJSON(c, http.StatusBadRequest, "Invalid ID")  // Respons error jika ID tidak valid
+        return
+    }
+
+    job, err := h.service.Retry(c.Request.Context(), uint(id))  // Memanggil service untuk menjalankan ulang job
+    if err != nil {
+        utils.HandleError(c, http.StatusInternalServerError, err)  // Respons error (404, 409, atau 500)
+        return
+    }
+
+    c.JSON(http.StatusOK, utils.SuccessResponse(job))  // Respons sukses dengan job yang kembali pending
+}
+
+func (h *JobHandler) Cancel(c *gin.Context) {  // Handler untuk membatalkan job pending
+    id, err := strconv.ParseUint(c.Param("id"), 10, 32)  // Mengambil dan mengkonversi parameter ID
+    if err != nil {
+        utils.ErrorJSON(c, http.StatusBadRequest, "Invalid ID")  // Respons error jika ID tidak valid
+        return
+    }
+
+    job, err := h.service.Cancel(c.Request.Context(), uint(id))  // Memanggil service untuk membatalkan job
+    if err != nil {
+        utils.HandleError(c, http.StatusInternalServerError, err)  // Respons error (404, 409, atau 500)
+        return
+    }
+
+    c.JSON(http.StatusOK, utils.SuccessResponse(job))  // Respons sukses dengan job yang dibatalkan
+}
+
+func (h *JobHandler) Schedules(c *gin.Context) {  // Handler untuk mendapatkan jadwal cron
+    schedules, err := h.service.Schedules(c.Request.Context())  // Memanggil service untuk mendapatkan jadwal
+    if err != nil {
+        utils.HandleError(c, http.StatusInternalServerError, err)  // Respons error jika gagal
+        return
+    }
+
+    c.JSON(http.StatusOK, utils.SuccessResponse(schedules))  // Respons sukses dengan daftar jadwal
+}
+
+
+// {{{ Penjelasan Fungsi Handler }}}
+
+/*
+## Penjelasan Detail
+File handler.go ini berisi implementasi handler HTTP untuk modul Job. Berikut penjelasan detailnya:
+
+1. Endpoint :
+
+    - List : Daftar job terbaru dengan ?status, ?type, ?queue, ?limit (1-500, default 50), dan ?before_id
+    - GetByID : Detail satu job
+    - Retry : Menjalankan ulang job dead atau cancelled
+    - Cancel : Membatalkan job yang belum berjalan
+    - Schedules : Daftar jadwal cron
+2. Penanganan Error :
+
+    - Query string tidak valid atau ID tidak valid: Status 400 Bad Request
+    - Job tidak ditemukan: Status 404 Not Found
+    - Status job tidak sesuai untuk retry atau cancel: Status 409 Conflict
+    - Error internal: Status 500 Internal Server Error
+Handler ini hanya membaca dan mengubah status job; job dijalankan oleh jobs.Runner yang dibuat di main.go.
+*/
@@ -0,0 +1,40 @@
+package handler                                // Mendefinisikan package handler untuk modul job
+
+import (
+    "rest-api-go/pkg/middleware"               // Mengimpor middleware RequireAuth
+
+    "github.com/gin-gonic/gin"                 // Mengimpor framework web Gin
+)
+
+func RegisterRoutes(router *gin.RouterGroup, handler *JobHandler) {  // Fungsi untuk mendaftarkan route
+    jobs := router.Group("/jobs", middleware.RequireAuth())  // Membuat grup route dengan prefix "/jobs", wajib login karena payload dapat berisi data internal
+    {
+        jobs.GET("", handler.List)             // Mendaftarkan endpoint GET untuk mendapatkan daftar job
+        jobs.GET("/schedules", handler.Schedules)  // Mendaftarkan endpoint GET untuk mendapatkan jadwal cron
+        jobs.GET("/:id", handler.GetByID)      // Mendaftarkan endpoint GET untuk mendapatkan job berdasarkan ID
+        jobs.POST("/:id/retry", handler.Retry) // Mendaftarkan endpoint POST untuk menjalankan ulang job
+        jobs.POST("/:id/cancel", handler.Cancel)  // Mendaftarkan endpoint POST untuk membatalkan job
+    }
+}
+
+
+// {{{ Penjelasan Fungsi RegisterRoutes }}}
+
+/*
+## Penjelasan Detail
+File route.go ini berisi konfigurasi routing untuk modul Job. Berikut penjelasan detailnya:
+
+1. Endpoint API :
+
+    - GET /jobs : Mendapatkan daftar job terbaru dengan filter
+    - GET /jobs/schedules : Mendapatkan jadwal cron dan waktu eksekusi berikutnya
+    - GET /jobs/:id : Mendapatkan status satu job
+    - POST /jobs/:id/retry : Menjalankan ulang job dead atau cancelled
+    - POST /jobs/:id/cancel : Membatalkan job pending
+2. Autentikasi :
+
+    - Semua endpoint memakai middleware.RequireAuth, request tanpa token bearer yang valid dijawab 401
+3. Parameter URL :
+
+    - :id : Parameter dinamis untuk ID job
+*/
@@ -0,0 +1,129 @@
+package service                                // Mendefinisikan package service untuk modul job
+
+import (
+    "context"                                 // Package untuk context request
+    "errors"                                  // Package untuk pengecekan error
+    "net/http"                                // Package untuk konstanta HTTP
+    "rest-api-go/internal/module/job/entity"  // Mengimpor entity job
+    "rest-api-go/pkg/jobs"                    // Mengimpor model job
+    "rest-api-go/pkg/tracing"                 // Mengimpor package tracing untuk span service
+    "rest-api-go/pkg/utils"                   // Mengimpor utilitas aplikasi untuk HTTPError
+    "time"                                    // Package untuk waktu pembatalan dan retry
+
+    "gorm.io/gorm"                            // Mengimpor ORM GORM
+)
+
+var (
+    ErrJobNotFound       = utils.NewHTTPError(http.StatusNotFound, "Job not found")  // Job tidak ada atau sudah dihapus oleh retensi
+    ErrJobNotRetryable   = utils.NewHTTPError(http.StatusConflict, "only dead or cancelled jobs can be retried")  // Job masih berjalan, menunggu, atau sudah berhasil
+    ErrJobNotCancellable = utils.NewHTTPError(http.StatusConflict, "only pending jobs can be cancelled")  // Job sudah berjalan atau selesai
+)
+
+type JobService struct {                      // Mendefinisikan struct service
+    db *gorm.DB                               // Dependency database
+}
+
+func NewJobService(db *gorm.DB) *JobService { // Constructor untuk service
+    return &JobService{db}                    // Mengembalikan instance service dengan database yang diinjeksi
+}
+
+func (s *JobService) List(ctx context.Context, filter entity.ListFilter) ([]jobs.Job, error) {  // Method untuk mendapatkan job terbaru sesuai filter
+    ctx, span := tracing.Start(ctx, "JobService.List")  // Membuat child span untuk method service
+    defer span.End()                          // Menutup span saat method selesai
+
+    query := s.db.WithContext(ctx).Order("id DESC").Limit(filter.Limit)
+    if filter.Status != "" {
+        query = query.Where("status = ?", filter.Status)
+    }
+    if filter.Type != "" {
+        query = query.Where("type = ?", filter.Type)
+    }
+    if filter.Queue != "" {
+        query = query.Where("queue = ?", filter.Queue)
+    }
+    if filter.BeforeID > 0 {
+        query = query.Where("id < ?", filter.BeforeID)
+    }
+    list := []jobs.Job{}                      // Slice kosong agar JSON berisi [] bukan null
+    return list, query.Find(&list).Error
+}
+
+func (s *JobService) GetByID(ctx context.Context, id uint) (*jobs.Job, error) {  // Method untuk mendapatkan job berdasarkan ID
+    ctx, span := tracing.Start(ctx, "JobService.GetByID")  // Membuat child span untuk method service
+    defer span.End()                          // Menutup span saat method selesai
+
+    return s.find(s.db.WithContext(ctx), id)
+}
+
+func (s *JobService) Retry(ctx context.Context, id uint) (*jobs.Job, error) {  // Method untuk menjalankan ulang job dead atau cancelled dengan percobaan dari awal
+    ctx, span := tracing.Start(ctx, "JobService.Retry")  // Membuat child span untuk method service
+    defer span.End()                          // Menutup span saat method selesai
+
+    return s.transition(s.db.WithContext(ctx), id, []jobs.Status{jobs.StatusDead, jobs.StatusCancelled}, ErrJobNotRetryable, map[string]any{
+        "status": jobs.StatusPending, "attempts": 0, "run_at": time.Now(), "finished_at": nil,  // last_error disimpan sampai percobaan berikutnya selesai
+    })
+}
+
+func (s *JobService) Cancel(ctx context.Context, id uint) (*jobs.Job, error) {  // Method untuk membatalkan job yang belum berjalan
+    ctx, span := tracing.Start(ctx, "JobService.Cancel")  // Membuat child span untuk method service
+    defer span.End()                          // Menutup span saat method selesai
+
+    return s.transition(s.db.WithContext(ctx), id, []jobs.Status{jobs.StatusPending}, ErrJobNotCancellable, map[string]any{
+        "status": jobs.StatusCancelled, "finished_at": time.Now(),
+    })
+}
+
+func (s *JobService) Schedules(ctx context.Context) ([]jobs.Schedule, error) {  // Method untuk mendapatkan semua jadwal cron
+    ctx, span := tracing.Start(ctx, "JobService.Schedules")  // Membuat child span untuk method service
+    defer span.End()                          // Menutup span saat method selesai
+
+    schedules := []jobs.Schedule{}
+    return schedules, s.db.WithContext(ctx).Order("name").Find(&schedules).Error
+}
+
+func (s *JobService) transition(db *gorm.DB, id uint, from []jobs.Status, conflict error, updates map[string]any) (*jobs.Job, error) {  // Fungsi untuk mengubah status job hanya jika status saat ini sesuai
+    result := db.Model(&jobs.Job{}).Where("id = ? AND status IN ?", id, from).Updates(updates)  // Satu UPDATE bersyarat, aman terhadap worker yang mengambil job bersamaan
+    if result.Error != nil {
+        return nil, result.Error
+    }
+    job, err := s.find(db, id)
+    if err != nil {
+        return nil, err
+    }
+    if result.RowsAffected == 0 {
+        return nil, conflict
+    }
+    return job, nil
+}
+
+func (s *JobService) find(db *gorm.DB, id uint) (*jobs.Job, error) {  // Fungsi untuk mengambil job atau ErrJobNotFound
+    var job jobs.Job
+    if err := db.First(&job, id).Error; err != nil {
+        if errors.Is(err, gorm.ErrRecordNotFound) {
+            return nil, ErrJobNotFound
+        }
+        return nil, err
+    }
+    return &job, nil
+}
+
+
+// {{{ Penjelasan Fungsi Service }}}
+
+/*
+## Penjelasan Detail
+File service.go ini berisi implementasi service untuk modul Job. Berikut penjelasan detailnya:
+
+1. Tujuan : Memberikan status job latar belakang (pkg/jobs) lewat API tanpa menjalankan job.
+2. Operasi :
+
+    - List : Job terbaru dengan filter status, type, queue, dan halaman berbasis before_id
+    - GetByID : Detail satu job termasuk error terakhir
+    - Retry : Job dead atau cancelled kembali ke pending dengan attempts 0 dan langsung siap dijalankan
+    - Cancel : Job pending (termasuk yang menunggu retry) menjadi cancelled dan tidak akan dijalankan
+    - Schedules : Semua jadwal cron beserta waktu eksekusi berikutnya
+3. Konkurensi :
+
+    - Retry dan Cancel memakai UPDATE dengan syarat status, sehingga job yang baru saja diambil worker tidak ikut dibatalkan
+    - Jika status tidak sesuai, service mengembalikan 409; jika job tidak ada, 404
+*/
@@ -0,0 +1,43 @@
+package order                                  // Mendefinisikan package order
+
+import (
+	inventoryService "rest-api-go/internal/module/inventory/service"  // Mengimpor service inventory untuk stok
+	"rest-api-go/internal/module/order/handler"    // Mengimpor package handler dari modul order
+	"rest-api-go/internal/module/order/service"    // Mengimpor package service dari modul order
+
+	"github.com/gin-gonic/gin"                     // Mengimpor framework web Gin
+	"gorm.io/gorm"                                 // Mengimpor ORM GORM
+)
+
+// Initialize - Fungsi untuk menginisialisasi modul order
+func Initialize(db *gorm.DB, router *gin.RouterGroup) {  // Fungsi untuk inisialisasi modul dengan parameter database dan router
+	// Initialize service
+	inventory := inventoryService.NewInventoryService(db)  // Service inventory untuk mengurangi dan mengembalikan stok
+	orderService := service.NewOrderService(db, inventory)  // Membuat instance service order dengan menyuntikkan database dan inventory
+
+	// Initialize handler
+	orderHandler := handler.NewOrderHandler(orderService)  // Membuat instance handler dengan menyuntikkan service
+
+	// Register routes
+	handler.RegisterRoutes(router, orderHandler)       // Mendaftarkan route untuk modul order
+}
+
+
+// {{{ Penjelasan Fungsi Initialize }}}
+
+/*
+## Penjelasan Detail
+File bootstrap.go ini berfungsi sebagai titik masuk (entry point) untuk modul order. Berikut penjelasan detailnya:
+
+1. Tujuan : Menghubungkan service, handler, dan route modul order dengan pola Dependency Injection.
+2. Alur Kerja :
+
+	- Membuat InventoryService karena checkout dan pembatalan order mengubah stok
+	- Membuat OrderService dengan menyuntikkan database dan InventoryService
+	- Membuat instance handler dengan menyuntikkan service
+	- Mendaftarkan route di bawah /orders
+3. Hubungan dengan Aplikasi Utama :
+
+	- Fungsi Initialize dipanggil dari main.go
+Modul ini mengikuti pola yang sama dengan modul lainnya.
+*/
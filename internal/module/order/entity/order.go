@@ -0,0 +1,153 @@
+package entity                                // Mendefinisikan package entity untuk modul order
+
+import (
+    "rest-api-go/pkg/money"                   // Package money untuk harga dengan mata uang
+    "rest-api-go/pkg/validation"              // Package validation dengan validator bersama
+    "time"                                    // Package time untuk tipe data waktu
+
+    "gorm.io/gorm"                            // Mengimpor ORM GORM untuk hook
+)
+
+type OrderStatus string                       // Status order
+
+const (
+    StatusPending   OrderStatus = "pending"   // Order dibuat, menunggu pembayaran
+    StatusPaid      OrderStatus = "paid"      // Order sudah dibayar
+    StatusShipped   OrderStatus = "shipped"   // Barang sudah dikirim
+    StatusCancelled OrderStatus = "cancelled" // Order dibatalkan sebelum dikirim
+    StatusRefunded  OrderStatus = "refunded"  // Pembayaran dikembalikan
+)
+
+var transitions = map[OrderStatus][]OrderStatus{  // Perpindahan status yang diizinkan
+    StatusPending: {StatusPaid, StatusCancelled},
+    StatusPaid:    {StatusShipped, StatusRefunded},
+    StatusShipped: {StatusRefunded},
+}
+
+// CanTransitionTo - memeriksa apakah status boleh berpindah ke status berikutnya
+func (s OrderStatus) CanTransitionTo(next OrderStatus) bool {
+    for _, allowed := range transitions[s] {
+        if allowed == next {
+            return true
+        }
+    }
+    return false                              // Cancelled dan refunded adalah status akhir
+}
+
+type Order struct {                           // Mendefinisikan struct Order
+    ID          uint        `json:"id" gorm:"primaryKey"`  // ID order sebagai primary key
+    UserID      uint        `json:"user_id" gorm:"index;not null"`  // ID user yang membuat order
+    Status      OrderStatus `json:"status" gorm:"type:varchar(20);not null;default:'pending';index"`  // Status order saat ini
+    Total       money.Money `json:"total" gorm:"-"`  // Total seluruh baris order
+    TotalAmount string      `json:"-" gorm:"column:total;type:decimal(19,4);not null;default:0"`  // Kolom bayangan: total sebagai DECIMAL
+    Currency    string      `json:"-" gorm:"column:currency;type:char(3);not null"`  // Kolom bayangan: kode mata uang ISO 4217
+    Lines       []OrderLine `json:"lines,omitempty" gorm:"foreignKey:OrderID"`  // Relasi one-to-many dengan OrderLine
+    PaidAt      *time.Time  `json:"paid_at"`  // Waktu order dibayar
+    ShippedAt   *time.Time  `json:"shipped_at"`  // Waktu order dikirim
+    CancelledAt *time.Time  `json:"cancelled_at"`  // Waktu order dibatalkan
+    RefundedAt  *time.Time  `json:"refunded_at"`  // Waktu pembayaran dikembalikan
+    CreatedAt   time.Time   `json:"created_at"`  // Waktu pembuatan record
+    UpdatedAt   time.Time   `json:"updated_at"`  // Waktu pembaruan record
+}
+
+type OrderLine struct {                       // Mendefinisikan struct OrderLine
+    ID              uint        `json:"id" gorm:"primaryKey"`  // ID baris order sebagai primary key
+    OrderID         uint        `json:"order_id" gorm:"index;not null"`  // ID order pemilik baris
+    ProductID       uint        `json:"product_id" gorm:"index;not null"`  // ID product (tanpa foreign key agar order tetap utuh saat product dihapus)
+    VariantID       *uint       `json:"variant_id" gorm:"index"`  // ID varian yang dibeli, null untuk product tanpa varian
+    Title           string      `json:"title" gorm:"size:255;not null"`  // Snapshot judul product (beserta label varian) saat order dibuat
+    SKU             *string     `json:"sku" gorm:"size:64"`  // Snapshot SKU product saat order dibuat
+    UnitPrice       money.Money `json:"unit_price" gorm:"-"`  // Snapshot harga satuan saat order dibuat
+    UnitPriceAmount string      `json:"-" gorm:"column:unit_price;type:decimal(19,4);not null"`  // Kolom bayangan: harga satuan sebagai DECIMAL
+    Quantity        int64       `json:"quantity" gorm:"not null"`  // Jumlah barang
+    LineTotal       money.Money `json:"line_total" gorm:"-"`  // Harga satuan x quantity
+    LineTotalAmount string      `json:"-" gorm:"column:line_total;type:decimal(19,4);not null"`  // Kolom bayangan: total baris sebagai DECIMAL
+    Currency        string      `json:"-" gorm:"column:currency;type:char(3);not null"`  // Kolom bayangan: kode mata uang ISO 4217
+    CreatedAt       time.Time   `json:"created_at"`  // Waktu pembuatan record
+}
+
+type CheckoutRequest struct {                 // Mendefinisikan struct body request checkout
+    UserID uint           `json:"-"`          // Diisi handler dari user yang login, tidak pernah dari body request
+    Lines  []CheckoutLine `json:"lines" binding:"required,min=1,max=100,dive"`  // Product yang dibeli, minimal satu
+}
+
+type CheckoutLine struct {                    // Mendefinisikan struct satu baris checkout
+    ProductID uint  `json:"product_id" binding:"required"`  // ID product
+    VariantID *uint `json:"variant_id"`       // ID varian, wajib untuk product yang memiliki varian
+    Quantity  int64 `json:"quantity" binding:"required,gt=0,lte=1000000"`  // Jumlah barang, harus lebih dari 0; dibatasi agar jumlah baris yang digabung tidak overflow
+}
+
+type StatusRequest struct {                   // Mendefinisikan struct body request perubahan status
+    Status OrderStatus `json:"status" binding:"required,oneof=paid shipped cancelled refunded"`  // Status tujuan
+}
+
+func (r *CheckoutRequest) Validate() error {  // Method untuk validasi struct CheckoutRequest
+    return validation.Struct(r)               // Memvalidasi struct berdasarkan tag binding dengan validator bersama
+}
+
+func (o *Order) BeforeSave(tx *gorm.DB) error {  // Hook GORM sebelum create/update
+    o.TotalAmount = o.Total.Decimal(money.StorageScale)  // Salin total ke kolom DECIMAL(19,4)
+    o.Currency = o.Total.Currency             // Salin mata uang ke kolom currency
+    return nil
+}
+
+func (o *Order) AfterFind(tx *gorm.DB) error {  // Hook GORM setelah data dibaca dari database
+    total, err := money.Parse(o.TotalAmount, o.Currency)  // Ubah DECIMAL menjadi minor unit tanpa float
+    if err != nil {
+        return err
+    }
+    o.Total = total
+    return nil
+}
+
+func (l *OrderLine) BeforeSave(tx *gorm.DB) error {  // Hook GORM sebelum create/update
+    l.UnitPriceAmount = l.UnitPrice.Decimal(money.StorageScale)  // Salin harga satuan ke kolom DECIMAL(19,4)
+    l.LineTotalAmount = l.LineTotal.Decimal(money.StorageScale)  // Salin total baris ke kolom DECIMAL(19,4)
+    l.Currency = l.UnitPrice.Currency         // Salin mata uang ke kolom currency
+    return nil
+}
+
+func (l *OrderLine) AfterFind(tx *gorm.DB) error {  // Hook GORM setelah data dibaca dari database
+    unitPrice, err := money.Parse(l.UnitPriceAmount, l.Currency)
+    if err != nil {
+        return err
+    }
+    lineTotal, err := money.Parse(l.LineTotalAmount, l.Currency)
+    if err != nil {
+        return err
+    }
+    l.UnitPrice, l.LineTotal = unitPrice, lineTotal
+    return nil
+}
+
+
+//  {{{ Penjelasan Struktur Order }}}
+
+/*
+## Penjelasan Detail
+File order.go ini mendefinisikan struktur data untuk modul Order. Berikut penjelasan detailnya:
+
+1. Order :
+
+    - UserID merujuk ke user yang membuat order
+    - Total dihitung dari seluruh baris dan disimpan sebagai DECIMAL(19,4) melalui kolom bayangan, sama seperti harga Product
+    - PaidAt, ShippedAt, CancelledAt, RefundedAt diisi saat status berpindah
+2. OrderLine :
+
+    - Title, SKU, dan UnitPrice adalah snapshot dari product saat checkout
+    - Perubahan harga atau penghapusan product setelahnya tidak mengubah order yang sudah ada
+    - ProductID sengaja tanpa foreign key agar product tetap dapat dihapus
+    - VariantID diisi untuk product bervarian; Title berisi judul product dan label varian, misal "Kaos (Red / M)"
+3. State Machine Status :
+
+    - pending -> paid, cancelled
+    - paid -> shipped, refunded
+    - shipped -> refunded
+    - cancelled dan refunded adalah status akhir
+    - CanTransitionTo dipakai service sebelum mengubah status
+4. Request :
+
+    - CheckoutRequest : user_id dan daftar baris (product_id, variant_id, quantity), divalidasi sampai ke setiap baris dengan dive
+    - StatusRequest : Status tujuan untuk PATCH /api/orders/:id/status
+Semua nilai uang memakai money.Money sehingga tidak ada error pembulatan pada total order.
+*/
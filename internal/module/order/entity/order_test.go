@@ -0,0 +1,37 @@
+package entity                                // Mendefinisikan package entity untuk modul order
+
+import "testing"                              // Package testing bawaan Go
+
+func TestCanTransitionTo(t *testing.T) {
+    statuses := []OrderStatus{StatusPending, StatusPaid, StatusShipped, StatusCancelled, StatusRefunded}
+    allowed := map[[2]OrderStatus]bool{       // Semua pasangan lain harus ditolak
+        {StatusPending, StatusPaid}:      true,
+        {StatusPending, StatusCancelled}: true,
+        {StatusPaid, StatusShipped}:      true,
+        {StatusPaid, StatusRefunded}:     true,
+        {StatusShipped, StatusRefunded}:  true,
+    }
+    for _, from := range statuses {
+        for _, to := range statuses {
+            if got, want := from.CanTransitionTo(to), allowed[[2]OrderStatus{from, to}]; got != want {
+                t.Errorf("%s.CanTransitionTo(%s) = %v, want %v", from, to, got, want)
+            }
+        }
+    }
+    for _, from := range statuses {
+        if from.CanTransitionTo("unknown") || OrderStatus("unknown").CanTransitionTo(from) {
+            t.Errorf("transition between %s and an unknown status allowed", from)
+        }
+    }
+}
+
+
+// {{{ Penjelasan Test Status Order }}}
+
+/*
+## Penjelasan Detail
+File order_test.go ini menguji perpindahan status order. Berikut penjelasan detailnya:
+
+1. TestCanTransitionTo : Setiap pasangan status diuji, hanya lima perpindahan di transitions yang diterima
+2. Status Akhir : cancelled dan refunded tidak bisa berpindah ke status mana pun, dan status yang tidak dikenal selalu ditolak
+*/
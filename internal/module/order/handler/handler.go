@@ -0,0 +1,135 @@
+package handler                                // Mendefinisikan package handler untuk modul order
+
+import (
+    "net/http"                                 // Package untuk konstanta HTTP
+    "rest-api-go/internal/module/order/entity"   // Mengimpor entity order
+    "rest-api-go/internal/module/order/service"  // Mengimpor service order
+    "rest-api-go/pkg/middleware"               // Mengimpor key user ID dari middleware
+    "rest-api-go/pkg/utils"                    // Mengimpor utilitas aplikasi
+    "strconv"                                  // Package untuk konversi string
+
+    "github.com/gin-gonic/gin"                 // Framework web Gin
+)
+
+type OrderHandler struct {                     // Mendefinisikan struct handler
+    service *service.OrderService              // Dependency service
+}
+
+func NewOrderHandler(service *service.OrderService) *OrderHandler {  // Constructor untuk handler
+    return &OrderHandler{service}              // Mengembalikan instance handler dengan service yang diinjeksi
+}
+
+func (h *OrderHandler) Checkout(c *gin.Context) {  // Handler untuk membuat order baru
+    var req entity.CheckoutRequest             // Variabel untuk menampung data checkout dari request
+    if err := utils.BindJSON(c, &req); err != nil {  // Binding JSON request ke struct secara ketat
+        utils.HandleError(c, http.StatusBadRequest, err)  // Respons error jika binding gagal (400, 413, atau 415)
+        return
+    }
+    req.UserID, _ = middleware.CurrentUserID(c)  // Order selalu milik user yang login; route memakai RequireAuth
+
+    order, err := h.service.Checkout(c.Request.Context(), &req)  // Memanggil service untuk checkout
+    if err != nil {
+        utils.HandleError(c, http.StatusInternalServerError, err)  // Respons error (404, 409, atau 500)
+        return
+    }
+
+    c.JSON(http.StatusCreated, utils.SuccessResponse(order))  // Respons sukses dengan data order
+}
+
+func (h *OrderHandler) GetByID(c *gin.Context) {  // Handler untuk mendapatkan order berdasarkan ID
+    id, err := strconv.ParseUint(c.Param("id"), 10, 32)  // Mengambil dan mengkonversi parameter ID
+    if err != nil {
+        utils.ErrorJSON(c, http.StatusBadRequest, "Invalid ID")  // Respons error jika ID tidak valid
+        return
+    }
+
+    order, err := h.service.GetByID(c.Request.Context(), uint(id), scope(c))  // Memanggil service untuk mendapatkan order, customer hanya melihat order miliknya
+    if err != nil {
+        utils.HandleError(c, http.StatusInternalServerError, err)  // Respons error (404 atau 500)
+        return
+    }
+
+    c.JSON(http.StatusOK, utils.SuccessResponse(order))  // Respons sukses dengan data order
+}
+
+func (h *OrderHandler) GetAll(c *gin.Context) {  // Handler untuk mendapatkan semua order
+    userID := scope(c)                         // Customer selalu dibatasi ke order miliknya, 0 untuk staff
+    if value := c.Query("user_id"); value != "" {  // Filter opsional ?user_id=, hanya untuk staff
+        parsed, err := strconv.ParseUint(value, 10, 32)
+        if err != nil {
+            utils.ErrorJSON(c, http.StatusBadRequest, "Invalid User ID")  // Respons error jika user_id tidak valid
+            return
+        }
+        if userID != 0 && uint(parsed) != userID {
+            utils.ErrorJSON(c, http.StatusForbidden, "only staff can list orders of other users")
+            return
+        }
+        userID = uint(parsed)
+    }
+
+    orders, err := h.service.GetAll(c.Request.Context(), userID)  // Memanggil service untuk mendapatkan order
+    if err != nil {
+        utils.HandleError(c, http.StatusInternalServerError, err)  // Respons error jika gagal
+        return
+    }
+
+    c.JSON(http.StatusOK, utils.SuccessResponse(orders))  // Respons sukses dengan data orders
+}
+
+func (h *OrderHandler) UpdateStatus(c *gin.Context) {  // Handler untuk mengubah status order
+    id, err := strconv.ParseUint(c.Param("id"), 10, 32)  // Mengambil dan mengkonversi parameter ID
+    if err != nil {
+        utils.ErrorJSON(c, http.StatusBadRequest, "Invalid ID")  // Respons error jika ID tidak valid
+        return
+    }
+
+    var req entity.StatusRequest               // Variabel untuk menampung status tujuan
+    if err := utils.BindJSON(c, &req); err != nil {  // Binding JSON request ke struct secara ketat
+        utils.HandleError(c, http.StatusBadRequest, err)  // Respons error jika binding gagal
+        return
+    }
+
+    order, err := h.service.UpdateStatus(c.Request.Context(), uint(id), req.Status, scope(c))  // Memanggil service untuk mengubah status, customer hanya boleh membatalkan order pending miliknya
+    if err != nil {
+        utils.HandleError(c, http.StatusInternalServerError, err)  // Respons error (404, 409, atau 500)
+        return
+    }
+
+    c.JSON(http.StatusOK, utils.SuccessResponse(order))  // Respons sukses dengan data order terbaru
+}
+
+func scope(c *gin.Context) uint {               // Fungsi untuk menentukan pemilik order yang boleh diakses: 0 (semua) untuk staff, selain itu user yang login
+    if middleware.CurrentRole(c).IsStaff() {
+        return 0
+    }
+    userID, _ := middleware.CurrentUserID(c)   // Semua route order memakai RequireAuth
+    return userID
+}
+
+
+// {{{ Penjelasan Fungsi Handler }}}
+
+/*
+## Penjelasan Detail
+File handler.go ini berisi implementasi handler HTTP untuk modul Order. Berikut penjelasan detailnya:
+
+1. Operasi :
+
+    - Checkout : Membuat order dari daftar product dan quantity
+    - GetByID : Mendapatkan order beserta barisnya, customer hanya order miliknya
+    - GetAll : Customer mendapatkan order miliknya, staff mendapatkan semua order dan dapat memfilter dengan ?user_id=
+    - UpdateStatus : Memindahkan status order sesuai state machine; customer hanya membatalkan order pending miliknya
+    - scope : 0 untuk staff (peran dari token), selain itu ID user yang login
+2. User :
+
+    - Checkout wajib login (RequireAuth di route.go), request tanpa token dijawab 401
+    - Order selalu dibuat untuk user yang login; user_id di body request ditolak sebagai field yang tidak dikenal
+3. Penanganan Error :
+
+    - Error binding JSON atau validasi: Status 400, 413, atau 415
+    - Order, user, atau product tidak ditemukan: Status 404 Not Found (order milik user lain juga 404)
+    - Customer memfilter order user lain atau mengubah status selain membatalkan: Status 403 Forbidden
+    - Stok tidak mencukupi atau perpindahan status tidak valid: Status 409 Conflict
+    - Error internal: Status 500 Internal Server Error
+Struktur handler ini mengikuti modul product dan category.
+*/
@@ -0,0 +1,37 @@
+package handler                                // Mendefinisikan package handler untuk modul order
+
+import (
+    "rest-api-go/pkg/middleware"               // Mengimpor middleware untuk mewajibkan login
+
+    "github.com/gin-gonic/gin"                 // Mengimpor framework web Gin
+)
+
+func RegisterRoutes(router *gin.RouterGroup, handler *OrderHandler) {  // Fungsi untuk mendaftarkan route
+    orders := router.Group("/orders", middleware.RequireAuth())  // Membuat grup route dengan prefix "/orders", semua endpoint hanya untuk user yang login
+    {
+        orders.POST("", handler.Checkout)      // Mendaftarkan endpoint POST untuk checkout
+        orders.GET("", handler.GetAll)         // Mendaftarkan endpoint GET untuk mendapatkan semua order
+        orders.GET("/:id", handler.GetByID)    // Mendaftarkan endpoint GET dengan parameter id untuk mendapatkan order berdasarkan ID
+        orders.PATCH("/:id/status", handler.UpdateStatus)  // Mendaftarkan endpoint PATCH untuk mengubah status order
+    }
+}
+
+
+// {{{ Penjelasan Fungsi RegisterRoutes }}}
+
+/*
+## Penjelasan Detail
+File route.go ini berisi konfigurasi routing untuk modul Order. Berikut penjelasan detailnya:
+
+1. Endpoint API :
+
+    - Semua endpoint memakai RequireAuth (401 tanpa token)
+    - POST /orders : Checkout, membuat order baru untuk user yang login dan mengurangi stok
+    - GET /orders : Order milik user yang login; staff melihat semua order (filter opsional ?user_id=), customer yang mengirim user_id lain mendapat 403
+    - GET /orders/:id : Mendapatkan order berdasarkan ID, order milik user lain 404 kecuali untuk staff
+    - PATCH /orders/:id/status : Mengubah status order; staff bebas mengikuti state machine, pemilik hanya membatalkan order pending
+2. Tidak Ada PUT dan DELETE :
+
+    - Isi order tidak dapat diubah setelah checkout karena harga dan stok sudah dibekukan
+    - Order tidak dihapus, gunakan status cancelled atau refunded
+*/
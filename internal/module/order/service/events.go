@@ -0,0 +1,47 @@
+package service                                // Mendefinisikan package service untuk modul order
+
+import (
+    "rest-api-go/internal/module/order/entity"  // Mengimpor entity order
+    "rest-api-go/pkg/events"                  // Mengimpor outbox domain event
+
+    "gorm.io/gorm"                            // Mengimpor ORM GORM
+)
+
+const (
+    EventOrderCreated       = "order.created"         // Checkout berhasil
+    EventOrderStatusChanged = "order.status_changed"  // Status order berpindah
+
+    eventEntity = "order"                     // Nama entitas di event
+)
+
+type statusChangedData struct {               // Mendefinisikan struct data event order.status_changed
+    *entity.Order
+    PreviousStatus entity.OrderStatus `json:"previous_status"`  // Status sebelum perpindahan
+}
+
+func recordCreated(tx *gorm.DB, order *entity.Order) error {  // Fungsi untuk mencatat order.created di transaksi tx
+    return events.Record(tx, EventOrderCreated, eventEntity, order.ID, order)
+}
+
+func recordStatusChanged(tx *gorm.DB, order *entity.Order, previous entity.OrderStatus) error {  // Fungsi untuk mencatat order.status_changed di transaksi tx
+    return events.Record(tx, EventOrderStatusChanged, eventEntity, order.ID, statusChangedData{Order: order, PreviousStatus: previous})
+}
+
+
+// {{{ Penjelasan Event Order }}}
+
+/*
+## Penjelasan Detail
+File events.go ini berisi domain event yang dicatat service order. Berikut penjelasan detailnya:
+
+1. Tipe Event :
+
+    - order.created : Checkout berhasil; data berisi order beserta barisnya
+    - order.status_changed : UpdateStatus berhasil; data berisi order setelah perpindahan dan previous_status
+2. Stok :
+
+    - Pengurangan dan pengembalian stok menghasilkan product.stock_changed dari service inventory, bukan dari service ini
+3. Transaksi :
+
+    - Event dicatat di transaksi yang sama dengan order, sehingga checkout yang gagal karena stok tidak cukup tidak menghasilkan event
+*/
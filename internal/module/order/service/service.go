@@ -0,0 +1,386 @@
+package service                                // Mendefinisikan package service untuk modul order
+
+import (
+    "context"                                 // Package untuk context request
+    "errors"                                  // Package untuk pengecekan error
+    "fmt"                                     // Package untuk formatting pesan error
+    "net/http"                                // Package untuk konstanta HTTP
+    inventoryEntity "rest-api-go/internal/module/inventory/entity"   // Mengimpor entity inventory
+    inventoryService "rest-api-go/internal/module/inventory/service" // Mengimpor service inventory untuk mengurangi stok
+    "rest-api-go/internal/module/order/entity"  // Mengimpor entity order
+    productEntity "rest-api-go/internal/module/product/entity"  // Mengimpor entity product
+    userEntity "rest-api-go/internal/module/user/entity"        // Mengimpor entity user
+    "rest-api-go/pkg/money"                   // Package money untuk menghitung total
+    "rest-api-go/pkg/tracing"                 // Mengimpor package tracing untuk span service
+    "rest-api-go/pkg/utils"                   // Mengimpor utilitas aplikasi untuk HTTPError
+    "sort"                                    // Package untuk mengurutkan ID product
+    "time"                                    // Package untuk waktu perubahan status
+
+    "gorm.io/gorm"                            // Mengimpor ORM GORM
+    "gorm.io/gorm/clause"                     // Klausa SELECT ... FOR UPDATE
+)
+
+var (
+    ErrOrderNotFound = utils.NewHTTPError(http.StatusNotFound, "Order not found")  // Order tidak ada
+    ErrUserNotFound  = utils.NewHTTPError(http.StatusNotFound, "User not found")   // User pada checkout tidak ada
+    ErrMixedCurrency = utils.NewHTTPError(http.StatusConflict, "all products in an order must use the same currency")  // Product dengan mata uang berbeda
+    ErrCancelOnly    = utils.NewHTTPError(http.StatusForbidden, "customers can only cancel their own pending orders")  // Perubahan status oleh pemilik order selain membatalkan order pending
+    ErrTotalTooLarge = utils.NewHTTPError(http.StatusUnprocessableEntity, "order total is too large")  // Total baris atau order melebihi batas money.ErrOverflow
+)
+
+type OrderService struct {                    // Mendefinisikan struct service
+    db        *gorm.DB                        // Dependency database
+    inventory *inventoryService.InventoryService  // Dependency inventory untuk stock movement
+}
+
+func NewOrderService(db *gorm.DB, inventory *inventoryService.InventoryService) *OrderService {  // Constructor untuk service
+    return &OrderService{db, inventory}       // Mengembalikan instance service dengan dependency yang diinjeksi
+}
+
+func (s *OrderService) Checkout(ctx context.Context, req *entity.CheckoutRequest) (*entity.Order, error) {  // Method untuk membuat order dari daftar product
+    ctx, span := tracing.Start(ctx, "OrderService.Checkout")  // Membuat child span untuk method service
+    defer span.End()                          // Menutup span saat method selesai
+
+    if err := req.Validate(); err != nil {    // Validasi data request
+        return nil, err                       // Mengembalikan error jika validasi gagal
+    }
+
+    quantities := make(map[lineKey]int64)     // Gabungkan baris dengan product dan varian yang sama
+    keys := []lineKey{}
+    for _, line := range req.Lines {
+        key := lineKey{ProductID: line.ProductID}
+        if line.VariantID != nil {
+            key.VariantID = *line.VariantID
+        }
+        if _, ok := quantities[key]; !ok {
+            keys = append(keys, key)
+        }
+        quantities[key] += line.Quantity
+    }
+    sort.Slice(keys, func(i, j int) bool {    // Urutan kunci yang sama untuk setiap checkout mencegah deadlock
+        if keys[i].ProductID != keys[j].ProductID {
+            return keys[i].ProductID < keys[j].ProductID
+        }
+        return keys[i].VariantID < keys[j].VariantID
+    })
+    ids, variantIDs := []uint{}, []uint{}
+    for _, key := range keys {
+        if len(ids) == 0 || ids[len(ids)-1] != key.ProductID {
+            ids = append(ids, key.ProductID)
+        }
+        if key.VariantID != 0 {
+            variantIDs = append(variantIDs, key.VariantID)
+        }
+    }
+
+    order := &entity.Order{UserID: req.UserID, Status: entity.StatusPending}  // Order baru selalu pending
+    err := s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {  // Cek stok, simpan order, dan kurangi stok secara atomik
+        var count int64
+        if err := tx.Model(&userEntity.User{}).Where("id = ?", req.UserID).Count(&count).Error; err != nil {  // Memeriksa apakah user ada
+            return err
+        }
+        if count == 0 {
+            return ErrUserNotFound
+        }
+
+        var products []productEntity.Product  // Product dikunci sampai transaksi selesai
+        if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("id IN ?", ids).Order("id").Find(&products).Error; err != nil {
+            return err
+        }
+        if len(products) != len(ids) {        // Ada product yang tidak ditemukan
+            return missingProduct(ids, products)
+        }
+        byID := make(map[uint]*productEntity.Product, len(products))
+        for i := range products {
+            byID[products[i].ID] = &products[i]
+        }
+
+        variants, err := lockVariants(tx, ids, variantIDs)  // Varian dikunci setelah product, dengan urutan yang sama
+        if err != nil {
+            return err
+        }
+
+        var total money.Money
+        for _, key := range keys {            // Buat baris order dengan snapshot product dan varian
+            product, quantity := byID[key.ProductID], quantities[key]
+            line := entity.OrderLine{
+                ProductID: product.ID,
+                Title:     product.Title,
+                SKU:       product.SKU,
+                UnitPrice: product.Price,     // Harga dibekukan saat checkout
+                Quantity:  quantity,
+            }
+            stock := product.Stock
+
+            if key.VariantID == 0 {
+                if variants.products[product.ID] {
+                    return utils.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("variant_id is required for product %d", product.ID))
+                }
+            } else {
+                variant, ok := variants.byID[key.VariantID]
+                if !ok || variant.ProductID != product.ID {
+                    return utils.NewHTTPError(http.StatusNotFound, fmt.Sprintf("Variant %d of product %d not found", key.VariantID, product.ID))
+                }
+                variantID := variant.ID
+                line.VariantID = &variantID
+                line.Title = fmt.Sprintf("%s (%s)", product.Title, variant.Label())
+                if variant.SKU != nil {
+                    line.SKU = variant.SKU
+                }
+                line.UnitPrice = variant.PriceOr(product.Price)  // Harga khusus varian jika ada
+                stock = variant.Stock
+            }
+
+            if stock < quantity {             // Pesan lebih jelas daripada ErrInsufficientStock dari inventory
+                subject := fmt.Sprintf("product %d", product.ID)
+                if key.VariantID != 0 {
+                    subject += fmt.Sprintf(" variant %d", key.VariantID)
+                }
+                return utils.NewHTTPError(http.StatusConflict, fmt.Sprintf("insufficient stock for %s: requested %d, available %d", subject, quantity, stock))
+            }
+            if line.LineTotal, err = line.UnitPrice.Mul(quantity); err != nil {
+                return ErrTotalTooLarge
+            }
+            if len(order.Lines) == 0 {
+                total = money.New(0, line.UnitPrice.Currency)  // Mata uang order mengikuti baris pertama
+            }
+            if total, err = total.Add(line.LineTotal); errors.Is(err, money.ErrOverflow) {
+                return ErrTotalTooLarge
+            } else if err != nil {
+                return ErrMixedCurrency
+            }
+            order.Lines = append(order.Lines, line)
+        }
+        order.Total = total
+
+        if err := tx.Create(order).Error; err != nil {  // Simpan order beserta barisnya
+            return err
+        }
+
+        for _, line := range order.Lines {    // Kurangi stok melalui ledger inventory di transaksi yang sama
+            movement := &inventoryEntity.StockMovement{
+                ProductID: line.ProductID,
+                VariantID: line.VariantID,
+                Type:      inventoryEntity.MovementSale,
+                Quantity:  -line.Quantity,
+                Reason:    "checkout",
+                Actor:     fmt.Sprint(order.UserID),
+                Reference: reference(order),
+            }
+            if err := s.inventory.Apply(tx, movement); err != nil {
+                return err
+            }
+        }
+        return recordCreated(tx, order)       // order.created untuk sink, SSE, dan WebSocket
+    })
+    if err != nil {
+        return nil, err                       // Transaksi di-rollback otomatis, stok tidak berubah
+    }
+    return order, nil
+}
+
+func (s *OrderService) GetByID(ctx context.Context, id, userID uint) (*entity.Order, error) {  // Method untuk mendapatkan order beserta barisnya, userID 0 berarti order user mana pun
+    ctx, span := tracing.Start(ctx, "OrderService.GetByID")  // Membuat child span untuk method service
+    defer span.End()                          // Menutup span saat method selesai
+
+    query := s.db.WithContext(ctx).Preload("Lines")
+    if userID != 0 {
+        query = query.Where("user_id = ?", userID)  // Order milik user lain dianggap tidak ada
+    }
+    var order entity.Order                    // Variabel untuk menampung hasil query
+    err := query.First(&order, id).Error      // Query order berdasarkan ID beserta barisnya
+    if errors.Is(err, gorm.ErrRecordNotFound) {
+        return nil, ErrOrderNotFound
+    }
+    if err != nil {
+        return nil, err
+    }
+    return &order, nil
+}
+
+func (s *OrderService) GetAll(ctx context.Context, userID uint) ([]entity.Order, error) {  // Method untuk mendapatkan order, userID 0 berarti semua user
+    ctx, span := tracing.Start(ctx, "OrderService.GetAll")  // Membuat child span untuk method service
+    defer span.End()                          // Menutup span saat method selesai
+
+    query := s.db.WithContext(ctx).Preload("Lines").Order("id DESC")  // Order terbaru lebih dulu
+    if userID != 0 {
+        query = query.Where("user_id = ?", userID)  // Filter berdasarkan user
+    }
+    var orders []entity.Order                 // Variabel untuk menampung hasil query
+    err := query.Find(&orders).Error
+    return orders, err
+}
+
+func (s *OrderService) UpdateStatus(ctx context.Context, id uint, next entity.OrderStatus, userID uint) (*entity.Order, error) {  // Method untuk memindahkan status order, userID 0 untuk staff, selain itu hanya pemilik yang membatalkan order pending
+    ctx, span := tracing.Start(ctx, "OrderService.UpdateStatus")  // Membuat child span untuk method service
+    defer span.End()                          // Menutup span saat method selesai
+
+    var order entity.Order                    // Variabel untuk menampung order yang dikunci
+    err := s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+        err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Preload("Lines").First(&order, id).Error  // Kunci order agar perubahan status tidak balapan
+        if errors.Is(err, gorm.ErrRecordNotFound) {
+            return ErrOrderNotFound
+        }
+        if err != nil {
+            return err
+        }
+
+        current := order.Status
+        if userID != 0 {                      // Bukan staff
+            if order.UserID != userID {
+                return ErrOrderNotFound       // Sama dengan GetByID, keberadaan order user lain tidak dibocorkan
+            }
+            if current != entity.StatusPending || next != entity.StatusCancelled {
+                return ErrCancelOnly
+            }
+        }
+        if !current.CanTransitionTo(next) {   // Tolak perpindahan yang tidak ada di state machine
+            return utils.NewHTTPError(http.StatusConflict, fmt.Sprintf("cannot change order status from %s to %s", current, next))
+        }
+
+        now := time.Now()
+        switch next {                         // Catat waktu perpindahan status
+        case entity.StatusPaid:
+            order.PaidAt = &now
+        case entity.StatusShipped:
+            order.ShippedAt = &now
+        case entity.StatusCancelled:
+            order.CancelledAt = &now
+        case entity.StatusRefunded:
+            order.RefundedAt = &now
+        }
+        order.Status = next
+        if err := tx.Omit(clause.Associations).Save(&order).Error; err != nil {  // Simpan order tanpa menyentuh barisnya
+            return err
+        }
+
+        if err := recordStatusChanged(tx, &order, current); err != nil {  // order.status_changed untuk sink, SSE, dan WebSocket
+            return err
+        }
+
+        restock := next == entity.StatusCancelled || (next == entity.StatusRefunded && current == entity.StatusPaid)  // Barang belum dikirim, kembalikan ke stok
+        if !restock {
+            return nil
+        }
+        for _, line := range order.Lines {
+            movement := &inventoryEntity.StockMovement{
+                ProductID: line.ProductID,
+                VariantID: line.VariantID,
+                Type:      inventoryEntity.MovementReturn,
+                Quantity:  line.Quantity,
+                Reason:    "order " + string(next),
+                Actor:     fmt.Sprint(order.UserID),
+                Reference: reference(&order),
+            }
+            err := s.inventory.Apply(tx, movement)
+            if errors.Is(err, inventoryService.ErrProductNotFound) || errors.Is(err, inventoryService.ErrVariantNotFound) {
+                continue                      // Product atau varian sudah dihapus, tidak ada stok yang dikembalikan
+            }
+            if errors.Is(err, inventoryService.ErrVariantRequired) {
+                continue                      // Product mendapat varian setelah order dibuat, stok tidak dapat dikembalikan ke varian tertentu
+            }
+            if err != nil {
+                return err
+            }
+        }
+        return nil
+    })
+    if err != nil {
+        return nil, err
+    }
+    return &order, nil
+}
+
+type lineKey struct {                         // Kunci baris checkout: product dan varian (0 untuk tanpa varian)
+    ProductID uint
+    VariantID uint
+}
+
+type lockedVariants struct {                  // Varian yang dikunci selama checkout
+    byID     map[uint]*productEntity.ProductVariant  // Varian yang diminta berdasarkan ID
+    products map[uint]bool                    // Product yang memiliki varian
+}
+
+func lockVariants(tx *gorm.DB, productIDs, variantIDs []uint) (*lockedVariants, error) {  // Fungsi untuk mengunci varian yang dibeli dan mencari product bervarian
+    locked := &lockedVariants{byID: map[uint]*productEntity.ProductVariant{}, products: map[uint]bool{}}
+
+    var owners []uint                         // Product yang memiliki minimal satu varian
+    if err := tx.Model(&productEntity.ProductVariant{}).Where("product_id IN ?", productIDs).Distinct().Pluck("product_id", &owners).Error; err != nil {
+        return nil, err
+    }
+    for _, id := range owners {
+        locked.products[id] = true
+    }
+    if len(variantIDs) == 0 {
+        return locked, nil
+    }
+
+    var variants []productEntity.ProductVariant
+    err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+        Preload("Values", func(db *gorm.DB) *gorm.DB { return db.Order("id") }).  // Nilai atribut untuk label varian
+        Where("id IN ?", variantIDs).Order("id").Find(&variants).Error
+    if err != nil {
+        return nil, err
+    }
+    for i := range variants {
+        locked.byID[variants[i].ID] = &variants[i]
+    }
+    return locked, nil
+}
+
+func missingProduct(ids []uint, products []productEntity.Product) error {  // Fungsi untuk membuat error product yang tidak ditemukan
+    found := make(map[uint]bool, len(products))
+    for _, product := range products {
+        found[product.ID] = true
+    }
+    for _, id := range ids {
+        if !found[id] {
+            return utils.NewHTTPError(http.StatusNotFound, fmt.Sprintf("Product %d not found", id))
+        }
+    }
+    return nil
+}
+
+func reference(order *entity.Order) string {  // Fungsi untuk membuat referensi stock movement
+    return fmt.Sprintf("order:%d", order.ID)
+}
+
+
+
+// {{{ Penjelasan Fungsi Service }}}
+
+/*
+## Penjelasan Detail
+File service.go ini berisi logika bisnis untuk modul Order. Berikut penjelasan detailnya:
+
+1. Checkout :
+
+    - Baris dengan product dan varian yang sama digabung, lalu diurutkan agar urutan lock selalu sama (mencegah deadlock)
+    - Dalam satu transaksi: cek user, kunci product lalu varian (SELECT ... FOR UPDATE), cek stok, simpan order dan barisnya, lalu kurangi stok
+    - Product yang memiliki varian wajib menyertakan variant_id (400), varian milik product lain dianggap tidak ada (404)
+    - Varian memakai harga khusus (PriceOr), SKU varian, dan judul "Product (Red / M)"; stok diperiksa per varian
+    - Pengurangan stok memakai InventoryService.Apply dengan tx yang sama sehingga ledger stok mencatat movement sale dengan referensi order:<id>
+    - Jika satu langkah gagal (stok kurang, product tidak ada, mata uang berbeda), seluruh transaksi di-rollback
+    - Event order.created dicatat di transaksi yang sama (lihat events.go)
+2. Snapshot Harga :
+
+    - Judul, SKU, dan harga satuan product disalin ke OrderLine saat checkout
+    - Total dihitung dengan money.Money (Mul dan Add) tanpa float
+    - Total baris atau order yang tidak muat di kolom DECIMAL(19,4) ditolak dengan ErrTotalTooLarge (422)
+3. UpdateStatus :
+
+    - Order dikunci lalu perpindahan status diperiksa dengan CanTransitionTo
+    - Dengan userID selain 0 (bukan staff) hanya pemilik order yang boleh membatalkan order pending; order user lain 404, perpindahan lain ErrCancelOnly (403)
+    - Perpindahan yang tidak valid menghasilkan 409 Conflict
+    - Perpindahan yang valid mencatat event order.status_changed beserta previous_status
+    - Cancel, serta refund dari status paid, mengembalikan stok dengan movement return karena barang belum dikirim
+    - Baris yang product atau variannya sudah dihapus dilewati saat stok dikembalikan
+    - Refund dari status shipped tidak mengubah stok; barang yang benar-benar kembali dicatat lewat modul inventory
+4. GetByID dan GetAll :
+
+    - userID selain 0 membatasi hasil ke order milik user tersebut; handler mengisi 0 hanya untuk staff
+5. Penanganan Error :
+
+    - Error dikembalikan sebagai utils.HTTPError (403, 404, 409, 422) sehingga handler cukup memanggil utils.HandleError
+Service ini bergantung pada InventoryService yang diinjeksi melalui constructor.
+*/
@@ -1,23 +1,36 @@
 package product                                // Mendefinisikan package product
 
 import (
+	attributeService "rest-api-go/internal/module/attribute/service"  // Mengimpor service attribute untuk template kategori
 	"rest-api-go/internal/module/product/handler"  // Mengimpor package handler dari modul product
 	"rest-api-go/internal/module/product/service"  // Mengimpor package service dari modul product
+	"rest-api-go/pkg/cache"                        // Mengimpor cache hasil baca
+	"rest-api-go/pkg/imaging"                      // Mengimpor aturan unggah gambar
+	"rest-api-go/pkg/jobs"                         // Mengimpor runner job latar belakang
+	"rest-api-go/pkg/storage"                      // Mengimpor storage file gambar
 
 	"github.com/gin-gonic/gin"                     // Mengimpor framework web Gin
 	"gorm.io/gorm"                                 // Mengimpor ORM GORM
 )
 
 // Initialize - Fungsi untuk menginisialisasi modul product
-func Initialize(db *gorm.DB, router *gin.RouterGroup) {  // Fungsi untuk inisialisasi modul dengan parameter database dan router
+func Initialize(db *gorm.DB, router *gin.RouterGroup, store storage.Storage, opts imaging.Options, runner *jobs.Runner, responseCache *cache.Cache, cacheControl gin.HandlerFunc) {  // Fungsi untuk inisialisasi modul dengan parameter database, router, storage gambar, runner job, cache, dan middleware Cache-Control
 	// Initialize service
-	productService := service.NewProductService(db)    // Membuat instance service product dengan menyuntikkan database
+	attributes := attributeService.NewAttributeService(db)  // Service attribute untuk filter dan pemeriksaan varian
+	productService := service.NewProductService(db, store, attributes, runner, responseCache)  // Membuat instance service product dengan menyuntikkan database, storage, attribute, runner job, dan cache
+	imageService := service.NewImageService(db, store, opts, runner)  // Membuat instance service gambar product
+	variantService := service.NewVariantService(db, attributes)  // Membuat instance service varian product
 
 	// Initialize handler
 	productHandler := handler.NewProductHandler(productService)  // Membuat instance handler dengan menyuntikkan service
+	imageHandler := handler.NewImageHandler(imageService)  // Membuat instance handler gambar
+	variantHandler := handler.NewVariantHandler(variantService)  // Membuat instance handler varian
 
 	// Register routes
-	handler.RegisterRoutes(router, productHandler)     // Mendaftarkan route untuk modul product
+	handler.RegisterRoutes(router, productHandler, imageHandler, variantHandler, cacheControl)  // Mendaftarkan route untuk modul product
+
+	// Register jobs
+	runner.Register(service.DeleteFilesJob, service.DeleteFiles(store), jobs.TypeOptions{})  // File gambar dihapus di latar belakang dengan retry
 }
 
 
@@ -31,9 +44,11 @@ File bootstrap.go ini berfungsi sebagai titik masuk (entry point) untuk modul pr
 2. Alur Kerja :
 
 	- Menerima koneksi database ( db ) dan grup router ( router ) dari aplikasi utama
-	- Membuat instance service dengan menyuntikkan database
+	- Membuat instance service dengan menyuntikkan database, storage gambar, dan aturan unggah (ukuran maksimum, daftar thumbnail)
+	- Menyuntikkan cache ke service product untuk GetByID dan GetAll (nil jika CACHE_DRIVER=none), dan middleware Cache-Control ke route baca
 	- Membuat instance handler dengan menyuntikkan service
 	- Mendaftarkan route API untuk modul product
+	- Mendaftarkan handler job product.delete_files ke runner job, sehingga file gambar dihapus di latar belakang dengan retry
 3. Pola Desain :
 
 	- Dependency Injection : Komponen-komponen (service, handler) menerima dependensi mereka dari luar
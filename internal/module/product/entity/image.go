@@ -0,0 +1,60 @@
+package entity                                // Mendefinisikan package entity untuk modul product
+
+import (
+    "rest-api-go/pkg/storage"                 // Package storage untuk membangun URL gambar
+    "rest-api-go/pkg/validation"              // Package validation dengan validator bersama
+    "time"                                    // Package time untuk tipe data waktu
+)
+
+type ProductImage struct {                    // Mendefinisikan struct ProductImage
+    ID            uint              `json:"id" gorm:"primaryKey"`  // ID gambar sebagai primary key
+    ProductID     uint              `json:"product_id" gorm:"index;not null"`  // ID product pemilik gambar
+    Key           string            `json:"-" gorm:"size:255;not null"`  // Key file asli di storage
+    ThumbnailKeys map[string]string `json:"-" gorm:"column:thumbnails;serializer:json;type:text"`  // Key thumbnail per ukuran, disimpan sebagai JSON
+    ContentType   string            `json:"content_type" gorm:"size:32;not null"`  // Content type hasil sniffing
+    Size          int64             `json:"size"`  // Ukuran file asli dalam byte
+    Width         int               `json:"width"`  // Lebar gambar asli dalam piksel
+    Height        int               `json:"height"`  // Tinggi gambar asli dalam piksel
+    Position      int               `json:"position" gorm:"not null;default:0"`  // Urutan tampil, dimulai dari 0
+    IsPrimary     bool              `json:"is_primary" gorm:"not null;default:false"`  // Gambar utama product
+    URL           string            `json:"url" gorm:"-"`  // URL file asli, diisi dari storage
+    Thumbnails    map[string]string `json:"thumbnails" gorm:"-"`  // URL thumbnail per ukuran, diisi dari storage
+    CreatedAt     time.Time         `json:"created_at"`  // Waktu unggah
+}
+
+type ImageOrderRequest struct {               // Mendefinisikan struct body request untuk mengurutkan gambar
+    ImageIDs []uint `json:"image_ids" binding:"required,min=1,dive,required"`  // Semua ID gambar product dalam urutan baru
+}
+
+func (r *ImageOrderRequest) Validate() error {  // Method untuk validasi struct ImageOrderRequest
+    return validation.Struct(r)               // Memvalidasi struct berdasarkan tag binding dengan validator bersama
+}
+
+func (i *ProductImage) ResolveURLs(store storage.Storage) {  // Method untuk mengisi URL dari key storage
+    i.URL = store.URL(i.Key)
+    i.Thumbnails = make(map[string]string, len(i.ThumbnailKeys))
+    for name, key := range i.ThumbnailKeys {
+        i.Thumbnails[name] = store.URL(key)
+    }
+}
+
+
+//  {{{ Penjelasan Struktur ProductImage }}}
+
+/*
+## Penjelasan Detail
+File image.go ini mendefinisikan struktur data untuk gambar product. Berikut penjelasan detailnya:
+
+1. ProductImage :
+
+    - Key dan ThumbnailKeys menyimpan lokasi file di storage, bukan URL, sehingga storage atau CDN dapat diganti tanpa mengubah data
+    - ThumbnailKeys disimpan sebagai JSON dengan serializer bawaan GORM, misal {"small": "products/1/ab12/small.jpg"}
+    - Position menentukan urutan tampil, IsPrimary menandai satu gambar utama per product
+2. URL dan Thumbnails :
+
+    - Tidak disimpan di database (gorm:"-")
+    - Diisi oleh service melalui ResolveURLs sebelum dikirim ke client
+3. ImageOrderRequest :
+
+    - Body untuk mengurutkan ulang gambar, harus berisi semua ID gambar product tepat satu kali
+*/
@@ -0,0 +1,51 @@
+package entity                                // Mendefinisikan package entity untuk modul product
+
+import (
+    "time"                                    // Package time untuk tipe data waktu
+)
+
+type ProductImport struct {                   // Mendefinisikan struct ProductImport (laporan satu impor)
+    ID              uint      `json:"id" gorm:"primaryKey"`  // ID impor sebagai primary key
+    Format          string    `json:"format" gorm:"size:8;not null"`  // Format file: csv atau jsonl
+    Key             string    `json:"key" gorm:"size:8;not null"`  // Kolom pencocokan upsert: sku atau id
+    Mode            string    `json:"mode" gorm:"size:16;not null"`  // atomic atau best_effort
+    DryRun          bool      `json:"dry_run" gorm:"not null;default:false"`  // Hanya validasi, tidak ada perubahan yang disimpan
+    Committed       bool      `json:"committed" gorm:"not null;default:false"`  // Perubahan tersimpan di database
+    Total           int       `json:"total" gorm:"not null;default:0"`  // Jumlah baris data
+    Created         int       `json:"created" gorm:"not null;default:0"`  // Baris yang membuat product baru
+    Updated         int       `json:"updated" gorm:"not null;default:0"`  // Baris yang memperbarui product yang ada
+    Failed          int       `json:"failed" gorm:"not null;default:0"`  // Baris yang ditolak
+    IgnoredColumns  []string  `json:"ignored_columns" gorm:"serializer:json;type:text"`  // Kolom file yang tidak dikenal dan tidak dipetakan
+    ErrorsTruncated bool      `json:"errors_truncated" gorm:"not null;default:false"`  // Error melebihi MaxImportErrors, sisanya tidak disimpan
+    Errors          []ProductImportError `json:"errors,omitempty" gorm:"foreignKey:ImportID"`  // Sebagian error pertama, hanya di respons impor
+    CreatedAt       time.Time `json:"created_at"`  // Waktu impor
+}
+
+type ProductImportError struct {              // Mendefinisikan struct ProductImportError (satu baris laporan error)
+    ID       uint   `json:"-" gorm:"primaryKey"`  // ID error sebagai primary key
+    ImportID uint   `json:"-" gorm:"index;not null"`  // ID impor pemilik error
+    Row      int    `json:"row" gorm:"not null"`  // Nomor baris di file
+    Column   string `json:"column" gorm:"size:255"`  // Nama kolom di file, kosong jika error tidak terkait satu kolom
+    Value    string `json:"value" gorm:"type:text"`  // Nilai sel yang ditolak
+    Message  string `json:"error" gorm:"type:text;not null"`  // Pesan error
+}
+
+
+//  {{{ Penjelasan Struktur ProductImport }}}
+
+/*
+## Penjelasan Detail
+File import.go ini mendefinisikan laporan impor product. Berikut penjelasan detailnya:
+
+1. ProductImport :
+
+    - Satu record per request impor, termasuk dry run, sehingga laporan error dapat diunduh setelahnya
+    - Total, Created, Updated, Failed : Ringkasan hasil per baris
+    - Committed : false jika dry run atau mode atomic dengan baris yang gagal
+    - IgnoredColumns : Kolom file yang tidak dikenal, disimpan sebagai JSON
+    - Errors : Hanya diisi pada respons impor (sebagian error pertama), laporan lengkap diunduh lewat endpoint errors
+2. ProductImportError :
+
+    - Satu record per error: nomor baris, nama kolom di file (bukan nama field product jika kolom dipetakan), nilai sel, dan pesan
+    - Satu baris dapat memiliki beberapa error (misal title kosong dan price tidak valid)
+*/
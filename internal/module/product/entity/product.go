@@ -1,24 +1,76 @@
 package entity                                // Mendefinisikan package entity untuk modul product
 
 import (
+    "rest-api-go/pkg/money"                   // Package money untuk harga dengan mata uang
+    "rest-api-go/pkg/slug"                    // Package slug untuk URL product
+    "rest-api-go/pkg/validation"              // Package validation dengan validator bersama
     "time"                                    // Package time untuk tipe data waktu
-    
-    "github.com/go-playground/validator/v10"  // Package validator untuk validasi data
+
+    "gorm.io/gorm"                            // Mengimpor ORM GORM untuk hook
 )
 
 type Product struct {                         // Mendefinisikan struct Product
     ID          uint      `json:"id" gorm:"primaryKey"`  // ID produk sebagai primary key
-    Title       string    `json:"title" binding:"max=255"`  // Judul produk dengan validasi maksimal 255 karakter
-    Price       float64   `json:"price" binding:"numeric"`  // Harga produk dengan validasi harus numerik
+    Title       string    `json:"title" binding:"required,notblank,max=255"`  // Judul produk wajib diisi, tidak boleh kosong, maksimal 255 karakter
+    Slug        string    `json:"slug" gorm:"size:191;uniqueIndex" binding:"omitempty,slug"`  // Slug URL unik, dibuat dari Title jika kosong
+    Price       money.Money `json:"price" gorm:"-" binding:"money"`  // Harga produk dalam minor unit beserta mata uang, tidak boleh negatif
+    PriceAmount string    `json:"-" gorm:"column:price;type:decimal(19,4);not null;default:0"`  // Kolom bayangan: harga sebagai DECIMAL di database
+    Currency    string    `json:"-" gorm:"column:currency;type:char(3);not null;default:'IDR'"`  // Kolom bayangan: kode mata uang ISO 4217
     Description string    `json:"description" binding:"max=255"`  // Deskripsi produk dengan validasi maksimal 255 karakter
-    CategoryID  uint      `json:"category_id" gorm:"index"`  // ID kategori sebagai foreign key dengan indeks untuk performa query
+    CategoryID  uint      `json:"category_id" gorm:"index" binding:"required"`  // ID kategori sebagai foreign key dengan indeks untuk performa query, wajib diisi
+    SKU         *string   `json:"sku" gorm:"size:64;uniqueIndex" binding:"omitempty,notblank,max=64"`  // Kode stok unik, NULL jika belum diisi
+    Stock       int64     `json:"stock" gorm:"not null;default:0"`  // Jumlah stok saat ini, hanya berubah melalui stock movement
+    LowStockThreshold int64 `json:"low_stock_threshold" gorm:"not null;default:0" binding:"gte=0"`  // Batas stok rendah, 0 berarti tidak dipantau
+    Images      []ProductImage `json:"images" gorm:"foreignKey:ProductID"`  // Gambar product, diurutkan berdasarkan Position
+    Variants    []ProductVariant `json:"variants" gorm:"foreignKey:ProductID"`  // Varian product, stok product adalah jumlah stok varian
+    Category    *ProductCategory `json:"category,omitempty" gorm:"-"`  // Kategori product, hanya diisi oleh service untuk ?include=category
     CreatedAt   time.Time `json:"created_at"`  // Waktu pembuatan record
     UpdatedAt   time.Time `json:"updated_at"`  // Waktu pembaruan record
 }
 
+// ProductCategory - ringkasan kategori untuk ?include=category; modul category memakai entity product sehingga struct Category tidak dapat diimpor di sini
+type ProductCategory struct {
+    ID       uint   `json:"id"`                // ID kategori
+    Name     string `json:"name"`              // Nama kategori
+    Slug     string `json:"slug"`              // Slug URL kategori
+    ParentID *uint  `json:"parent_id"`         // ID kategori induk, null untuk kategori paling atas
+}
+
+func (ProductCategory) TableName() string {   // Dibaca dari tabel categories
+    return "categories"
+}
+
 func (p *Product) Validate() error {          // Method untuk validasi struct Product
-    validate := validator.New()               // Membuat instance validator baru
-    return validate.Struct(p)                 // Memvalidasi struct berdasarkan tag binding
+    return validation.Struct(p)               // Memvalidasi struct berdasarkan tag binding dengan validator bersama
+}
+
+func (p *Product) IsLowStock() bool {        // Method untuk memeriksa apakah stok sudah di bawah batas
+    return p.LowStockThreshold > 0 && p.Stock <= p.LowStockThreshold
+}
+
+func (p *Product) BeforeCreate(tx *gorm.DB) (err error) {  // Hook GORM sebelum create
+    if p.Slug == "" {                         // Slug dibuat otomatis dari judul, termasuk saat seeding
+        p.Slug, err = slug.Generate(tx.Session(&gorm.Session{NewDB: true}), "products", 0, p.Title, "product")
+    }
+    return err
+}
+
+func (p *Product) BeforeSave(tx *gorm.DB) error {  // Hook GORM sebelum create/update
+    p.PriceAmount = p.Price.Decimal(money.StorageScale)  // Salin harga ke kolom DECIMAL(19,4)
+    p.Currency = p.Price.Currency             // Salin mata uang ke kolom currency
+    return nil
+}
+
+func (p *Product) AfterFind(tx *gorm.DB) error {  // Hook GORM setelah data dibaca dari database
+    if p.Currency == "" {                     // Kolom harga tidak dipilih, misal ?fields=id,title
+        return nil
+    }
+    price, err := money.Parse(p.PriceAmount, p.Currency)  // Ubah DECIMAL menjadi minor unit tanpa float
+    if err != nil {
+        return err
+    }
+    p.Price = price
+    return nil
 }
 
 
@@ -29,23 +81,75 @@ func (p *Product) Validate() error {          // Method untuk validasi struct Pr
 package entity                                // Mendefinisikan package entity untuk modul product
 
 import (
+    "rest-api-go/pkg/money"                   // Package money untuk harga dengan mata uang
+    "rest-api-go/pkg/slug"                    // Package slug untuk URL product
+    "rest-api-go/pkg/validation"              // Package validation dengan validator bersama
     "time"                                    // Package time untuk tipe data waktu
-    
-    "github.com/go-playground/validator/v10"  // Package validator untuk validasi data
+
+    "gorm.io/gorm"                            // Mengimpor ORM GORM untuk hook
 )
 
 type Product struct {                         // Mendefinisikan struct Product
     ID          uint      `json:"id" gorm:"primaryKey"`  // ID produk sebagai primary key
-    Title       string    `json:"title" binding:"max=255"`  // Judul produk dengan validasi maksimal 255 karakter
-    Price       float64   `json:"price" binding:"numeric"`  // Harga produk dengan validasi harus numerik
+    Title       string    `json:"title" binding:"required,notblank,max=255"`  // Judul produk wajib diisi, tidak boleh kosong, maksimal 255 karakter
+    Slug        string    `json:"slug" gorm:"size:191;uniqueIndex" binding:"omitempty,slug"`  // Slug URL unik, dibuat dari Title jika kosong
+    Price       money.Money `json:"price" gorm:"-" binding:"money"`  // Harga produk dalam minor unit beserta mata uang, tidak boleh negatif
+    PriceAmount string    `json:"-" gorm:"column:price;type:decimal(19,4);not null;default:0"`  // Kolom bayangan: harga sebagai DECIMAL di database
+    Currency    string    `json:"-" gorm:"column:currency;type:char(3);not null;default:'IDR'"`  // Kolom bayangan: kode mata uang ISO 4217
     Description string    `json:"description" binding:"max=255"`  // Deskripsi produk dengan validasi maksimal 255 karakter
-    CategoryID  uint      `json:"category_id" gorm:"index"`  // ID kategori sebagai foreign key dengan indeks untuk performa query
+    CategoryID  uint      `json:"category_id" gorm:"index" binding:"required"`  // ID kategori sebagai foreign key dengan indeks untuk performa query, wajib diisi
+    SKU         *string   `json:"sku" gorm:"size:64;uniqueIndex" binding:"omitempty,notblank,max=64"`  // Kode stok unik, NULL jika belum diisi
+    Stock       int64     `json:"stock" gorm:"not null;default:0"`  // Jumlah stok saat ini, hanya berubah melalui stock movement
+    LowStockThreshold int64 `json:"low_stock_threshold" gorm:"not null;default:0" binding:"gte=0"`  // Batas stok rendah, 0 berarti tidak dipantau
+    Images      []ProductImage `json:"images" gorm:"foreignKey:ProductID"`  // Gambar product, diurutkan berdasarkan Position
+    Variants    []ProductVariant `json:"variants" gorm:"foreignKey:ProductID"`  // Varian product, stok product adalah jumlah stok varian
+    Category    *ProductCategory `json:"category,omitempty" gorm:"-"`  // Kategori product, hanya diisi oleh service untuk ?include=category
     CreatedAt   time.Time `json:"created_at"`  // Waktu pembuatan record
     UpdatedAt   time.Time `json:"updated_at"`  // Waktu pembaruan record
 }
 
+// ProductCategory - ringkasan kategori untuk ?include=category; modul category memakai entity product sehingga struct Category tidak dapat diimpor di sini
+type ProductCategory struct {
+    ID       uint   `json:"id"`                // ID kategori
+    Name     string `json:"name"`              // Nama kategori
+    Slug     string `json:"slug"`              // Slug URL kategori
+    ParentID *uint  `json:"parent_id"`         // ID kategori induk, null untuk kategori paling atas
+}
+
+func (ProductCategory) TableName() string {   // Dibaca dari tabel categories
+    return "categories"
+}
+
 func (p *Product) Validate() error {          // Method untuk validasi struct Product
-    validate := validator.New()               // Membuat instance validator baru
-    return validate.Struct(p)                 // Memvalidasi struct berdasarkan tag binding
+    return validation.Struct(p)               // Memvalidasi struct berdasarkan tag binding dengan validator bersama
+}
+
+func (p *Product) IsLowStock() bool {        // Method untuk memeriksa apakah stok sudah di bawah batas
+    return p.LowStockThreshold > 0 && p.Stock <= p.LowStockThreshold
+}
+
+func (p *Product) BeforeCreate(tx *gorm.DB) (err error) {  // Hook GORM sebelum create
+    if p.Slug == "" {                         // Slug dibuat otomatis dari judul, termasuk saat seeding
+        p.Slug, err = slug.Generate(tx.Session(&gorm.Session{NewDB: true}), "products", 0, p.Title, "product")
+    }
+    return err
+}
+
+func (p *Product) BeforeSave(tx *gorm.DB) error {  // Hook GORM sebelum create/update
+    p.PriceAmount = p.Price.Decimal(money.StorageScale)  // Salin harga ke kolom DECIMAL(19,4)
+    p.Currency = p.Price.Currency             // Salin mata uang ke kolom currency
+    return nil
+}
+
+func (p *Product) AfterFind(tx *gorm.DB) error {  // Hook GORM setelah data dibaca dari database
+    if p.Currency == "" {                     // Kolom harga tidak dipilih, misal ?fields=id,title
+        return nil
+    }
+    price, err := money.Parse(p.PriceAmount, p.Currency)  // Ubah DECIMAL menjadi minor unit tanpa float
+    if err != nil {
+        return err
+    }
+    p.Price = price
+    return nil
 }
 */
\ No newline at end of file
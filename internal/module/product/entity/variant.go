@@ -0,0 +1,113 @@
+package entity                                // Mendefinisikan package entity untuk modul product
+
+import (
+    "rest-api-go/pkg/money"                   // Package money untuk harga varian
+    "rest-api-go/pkg/validation"              // Package validation dengan validator bersama
+    "strings"                                 // Package untuk menyusun label varian
+    "time"                                    // Package time untuk tipe data waktu
+
+    "gorm.io/gorm"                            // Mengimpor ORM GORM untuk hook
+)
+
+type ProductVariant struct {                  // Mendefinisikan struct ProductVariant (misal kaos ukuran M warna merah)
+    ID          uint              `json:"id" gorm:"primaryKey"`  // ID varian sebagai primary key
+    ProductID   uint              `json:"product_id" gorm:"not null;index;uniqueIndex:idx_variant_combination"`  // ID product induk
+    Combination string            `json:"-" gorm:"size:64;not null;uniqueIndex:idx_variant_combination"`  // Hash pasangan atribut=nilai, satu varian per kombinasi
+    SKU         *string           `json:"sku" gorm:"size:64;uniqueIndex"`  // Kode stok unik varian, NULL jika belum diisi
+    Price       *money.Money      `json:"price" gorm:"-"`  // Harga khusus varian, null berarti memakai harga product
+    PriceAmount *string           `json:"-" gorm:"column:price;type:decimal(19,4)"`  // Kolom bayangan: harga khusus sebagai DECIMAL, NULL jika tidak ada
+    Currency    *string           `json:"-" gorm:"column:currency;type:char(3)"`  // Kolom bayangan: kode mata uang harga khusus
+    Stock       int64             `json:"stock" gorm:"not null;default:0"`  // Stok varian, hanya berubah melalui stock movement
+    Attributes  map[string]string `json:"attributes" gorm:"-"`  // Nilai atribut per kode, misal {"color": "Red", "size": "M"}, diisi oleh service
+    Values      []VariantValue    `json:"-" gorm:"foreignKey:VariantID"`  // Nilai atribut seperti disimpan di database
+    CreatedAt   time.Time         `json:"created_at"`  // Waktu pembuatan record
+    UpdatedAt   time.Time         `json:"updated_at"`  // Waktu pembaruan record
+}
+
+type VariantValue struct {                    // Mendefinisikan struct VariantValue (satu nilai atribut milik varian)
+    ID          uint   `gorm:"primaryKey"`     // ID nilai sebagai primary key
+    VariantID   uint   `gorm:"index;not null"`  // ID varian pemilik nilai
+    AttributeID uint   `gorm:"not null;index:idx_variant_value,priority:1"`  // ID atribut dari modul attribute
+    Value       string `gorm:"size:64;not null;index:idx_variant_value,priority:2"`  // Nilai atribut, indeks dipakai untuk filter daftar product
+}
+
+type VariantRequest struct {                  // Mendefinisikan struct body request untuk membuat atau mengganti varian
+    SKU        *string           `json:"sku" binding:"omitempty,notblank,max=64"`  // Kode stok unik opsional
+    Price      *money.Money      `json:"price" binding:"omitempty,money"`  // Harga khusus opsional, mata uang harus sama dengan product
+    Attributes map[string]string `json:"attributes" binding:"required,min=1,max=20,dive,keys,slug,max=64,endkeys,required,notblank,max=64"`  // Nilai per kode atribut
+}
+
+func (r *VariantRequest) Validate() error {   // Method untuk validasi struct VariantRequest
+    return validation.Struct(r)               // Memvalidasi struct berdasarkan tag binding dengan validator bersama
+}
+
+// AttributeFilter - filter daftar product berdasarkan nilai atribut varian, misal {"color": ["Red", "Blue"], "size": ["M"]}
+type AttributeFilter map[string][]string
+
+// PriceOr - harga khusus varian, atau harga product jika varian tidak memiliki harga khusus
+func (v *ProductVariant) PriceOr(fallback money.Money) money.Money {
+    if v.Price != nil {
+        return *v.Price
+    }
+    return fallback
+}
+
+// Label - nilai atribut varian untuk ditampilkan, misal "Red / M"
+func (v *ProductVariant) Label() string {
+    values := make([]string, 0, len(v.Values))
+    for _, value := range v.Values {
+        values = append(values, value.Value)
+    }
+    return strings.Join(values, " / ")
+}
+
+func (v *ProductVariant) BeforeSave(tx *gorm.DB) error {  // Hook GORM sebelum create/update
+    v.PriceAmount, v.Currency = nil, nil      // Tanpa harga khusus kedua kolom bernilai NULL
+    if v.Price != nil {
+        amount := v.Price.Decimal(money.StorageScale)  // Salin harga ke kolom DECIMAL(19,4)
+        currency := v.Price.Currency
+        v.PriceAmount, v.Currency = &amount, &currency
+    }
+    return nil
+}
+
+func (v *ProductVariant) AfterFind(tx *gorm.DB) error {  // Hook GORM setelah data dibaca dari database
+    if v.PriceAmount == nil || v.Currency == nil {
+        return nil                            // Varian memakai harga product
+    }
+    price, err := money.Parse(*v.PriceAmount, *v.Currency)  // Ubah DECIMAL menjadi minor unit tanpa float
+    if err != nil {
+        return err
+    }
+    v.Price = &price
+    return nil
+}
+
+
+//  {{{ Penjelasan Struktur ProductVariant }}}
+
+/*
+## Penjelasan Detail
+File variant.go ini mendefinisikan struktur data untuk varian product. Berikut penjelasan detailnya:
+
+1. ProductVariant :
+
+    - Satu product dapat memiliki banyak varian, misal kaos dengan 3 ukuran dan 2 warna menjadi 6 varian
+    - SKU unik untuk setiap varian, tidak boleh sama dengan SKU product lain
+    - Price adalah harga khusus varian; null berarti varian memakai harga product
+    - Stock varian hanya berubah melalui stock movement; stok product adalah jumlah stok seluruh variannya
+    - Combination adalah hash SHA-256 dari pasangan atribut=nilai yang diurutkan, dengan unique index bersama ProductID sehingga kombinasi yang sama tidak bisa dibuat dua kali
+2. VariantValue :
+
+    - Menyimpan satu nilai atribut varian, misal atribut "size" bernilai "M"
+    - Indeks (attribute_id, value) dipakai oleh filter ?attr[size]=M pada daftar product
+3. VariantRequest :
+
+    - Body untuk membuat atau mengganti varian; stok tidak dapat diisi dari body
+    - Attributes berisi kode atribut (format slug) dan nilainya, misal {"color": "Red", "size": "M"}
+4. Helper :
+
+    - PriceOr : Harga yang berlaku untuk varian
+    - Label : Nilai atribut yang digabung, dipakai untuk judul baris order dan cart
+    - BeforeSave dan AfterFind menyalin harga khusus ke kolom bayangan seperti pada Product
+*/
@@ -1,11 +1,15 @@
 package handler                                // Mendefinisikan package handler untuk modul product
 
 import (
+    "fmt"                                      // Package untuk formatting pesan error
     "net/http"                                 // Package untuk konstanta HTTP
     "rest-api-go/internal/module/product/entity"  // Mengimpor entity product
     "rest-api-go/internal/module/product/service" // Mengimpor service product
+    "rest-api-go/pkg/bulk"                     // Mengimpor package bulk untuk laporan per item
+    "rest-api-go/pkg/fieldset"                 // Mengimpor package fieldset untuk ?fields= dan ?include=
     "rest-api-go/pkg/utils"                    // Mengimpor utilitas aplikasi
     "strconv"                                  // Package untuk konversi string
+    "strings"                                  // Package untuk memecah nilai filter
 
     "github.com/gin-gonic/gin"                 // Framework web Gin
 )
@@ -20,13 +24,13 @@ func NewProductHandler(service *service.ProductService) *ProductHandler {  // Co
 
 func (h *ProductHandler) Create(c *gin.Context) {  // Handler untuk membuat product baru
     var product entity.Product                 // Variabel untuk menampung data product dari request
-    if err := c.ShouldBindJSON(&product); err != nil {  // Binding JSON request ke struct product
-        c.JSON(http.StatusBadRequest, utils.ErrorResponse(err.Error()))  // Respons error jika binding gagal
+    if err := utils.BindJSON(c, &product); err != nil {  // Binding JSON request ke struct product secara ketat
+        utils.HandleError(c, http.StatusBadRequest, err)  // Respons error jika binding gagal (400, 413, atau 415)
         return
     }
 
-    if err := h.service.Create(&product); err != nil {  // Memanggil service untuk membuat product
-        c.JSON(http.StatusInternalServerError, utils.ErrorResponse(err.Error()))  // Respons error jika gagal
+    if err := h.service.Create(c.Request.Context(), &product); err != nil {  // Memanggil service untuk membuat product
+        utils.HandleError(c, http.StatusInternalServerError, err)  // Respons error jika gagal
         return
     }
 
@@ -36,47 +40,81 @@ func (h *ProductHandler) Create(c *gin.Context) {  // Handler untuk membuat prod
 func (h *ProductHandler) GetByID(c *gin.Context) {  // Handler untuk mendapatkan product berdasarkan ID
     id, err := strconv.ParseUint(c.Param("id"), 10, 32)  // Mengambil dan mengkonversi parameter ID
     if err != nil {
-        c.JSON(http.StatusBadRequest, utils.ErrorResponse("Invalid ID"))  // Respons error jika ID tidak valid
+        utils.ErrorJSON(c, http.StatusBadRequest, "Invalid ID")  // Respons error jika ID tidak valid
         return
     }
 
-    product, err := h.service.GetByID(uint(id))  // Memanggil service untuk mendapatkan product
+    sel, ok := service.Fields.Bind(c)          // Field dan relasi opsional ?fields=id,title,price&include=category
+    if !ok {
+        return
+    }
+
+    product, err := h.service.GetByID(c.Request.Context(), uint(id), sel)  // Memanggil service untuk mendapatkan product
     if err != nil {
-        c.JSON(http.StatusNotFound, utils.ErrorResponse("Product not found"))  // Respons error jika tidak ditemukan
+        utils.ErrorJSON(c, http.StatusNotFound, "Product not found")  // Respons error jika tidak ditemukan
         return
     }
 
-    c.JSON(http.StatusOK, utils.SuccessResponse(product))  // Respons sukses dengan data product
+    fieldset.Respond(c, http.StatusOK, sel, product)  // Respons sukses dengan field product yang diminta
+}
+
+func (h *ProductHandler) GetBySlug(c *gin.Context) {  // Handler untuk mendapatkan product berdasarkan slug
+    sel, ok := service.Fields.Bind(c)          // Field dan relasi opsional, ikut dibawa saat redirect
+    if !ok {
+        return
+    }
+
+    product, current, err := h.service.GetBySlug(c.Request.Context(), c.Param("slug"), sel)  // Memanggil service untuk mencari slug
+    if err != nil {
+        utils.HandleError(c, http.StatusInternalServerError, err)  // Respons error (404 atau 500)
+        return
+    }
+    if product == nil {                        // Slug lama, arahkan ke slug terbaru
+        utils.RedirectParam(c, http.StatusMovedPermanently, "slug", current)
+        return
+    }
+
+    fieldset.Respond(c, http.StatusOK, sel, product)  // Respons sukses dengan field product yang diminta
 }
 
 func (h *ProductHandler) GetAll(c *gin.Context) {  // Handler untuk mendapatkan semua product
-    products, err := h.service.GetAll()  // Memanggil service untuk mendapatkan semua product
+    filter, ok := attributeFilter(c)           // Filter opsional ?attr[color]=Red,Blue&attr[size]=M
+    if !ok {
+        return
+    }
+
+    sel, ok := service.Fields.Bind(c)          // Field dan relasi opsional ?fields=id,title,price&include=category
+    if !ok {
+        return
+    }
+
+    products, err := h.service.GetAll(c.Request.Context(), filter, sel)  // Memanggil service untuk mendapatkan semua product
     if err != nil {
-        c.JSON(http.StatusInternalServerError, utils.ErrorResponse(err.Error()))  // Respons error jika gagal
+        utils.HandleError(c, http.StatusInternalServerError, err)  // Respons error jika gagal
         return
     }
 
-    c.JSON(http.StatusOK, utils.SuccessResponse(products))  // Respons sukses dengan data products
+    fieldset.Respond(c, http.StatusOK, sel, products)  // Respons sukses dengan field products yang diminta
 }
 
 func (h *ProductHandler) Update(c *gin.Context) {  // Handler untuk memperbarui product
     id, err := strconv.ParseUint(c.Param("id"), 10, 32)  // Mengambil dan mengkonversi parameter ID
     if err != nil {
-        c.JSON(http.StatusBadRequest, utils.ErrorResponse("Invalid ID"))  // Respons error jika ID tidak valid
+        utils.ErrorJSON(c, http.StatusBadRequest, "Invalid ID")  // Respons error jika ID tidak valid
         return
     }
 
     var product entity.Product  // Variabel untuk menampung data product dari request
-    if err := c.ShouldBindJSON(&product); err != nil {  // Binding JSON request ke struct product
-        c.JSON(http.StatusBadRequest, utils.ErrorResponse(err.Error()))  // Respons error jika binding gagal
+    if err := utils.BindJSON(c, &product); err != nil {  // Binding JSON request ke struct product secara ketat
+        utils.HandleError(c, http.StatusBadRequest, err)  // Respons error jika binding gagal (400, 413, atau 415)
         return
     }
 
     // Set ID dari parameter URL
     product.ID = uint(id)  // Mengatur ID product dari parameter URL
 
-    if err := h.service.Update(&product); err != nil {  // Memanggil service untuk memperbarui product
-        c.JSON(http.StatusInternalServerError, utils.ErrorResponse(err.Error()))  // Respons error jika gagal
+    if err := h.service.Update(c.Request.Context(), &product); err != nil {  // Memanggil service untuk memperbarui product
+        utils.HandleError(c, http.StatusInternalServerError, err)  // Respons error jika gagal
         return
     }
 
@@ -86,34 +124,116 @@ func (h *ProductHandler) Update(c *gin.Context) {  // Handler untuk memperbarui
 func (h *ProductHandler) Delete(c *gin.Context) {  // Handler untuk menghapus product
     id, err := strconv.ParseUint(c.Param("id"), 10, 32)  // Mengambil dan mengkonversi parameter ID
     if err != nil {
-        c.JSON(http.StatusBadRequest, utils.ErrorResponse("Invalid ID"))  // Respons error jika ID tidak valid
+        utils.ErrorJSON(c, http.StatusBadRequest, "Invalid ID")  // Respons error jika ID tidak valid
         return
     }
 
-    if err := h.service.Delete(uint(id)); err != nil {  // Memanggil service untuk menghapus product
-        c.JSON(http.StatusInternalServerError, utils.ErrorResponse(err.Error()))  // Respons error jika gagal
+    if err := h.service.Delete(c.Request.Context(), uint(id)); err != nil {  // Memanggil service untuk menghapus product
+        utils.HandleError(c, http.StatusInternalServerError, err)  // Respons error jika gagal
         return
     }
 
     c.JSON(http.StatusOK, utils.SuccessResponse("Product deleted successfully"))  // Respons sukses dengan pesan
 }
 
+func (h *ProductHandler) BulkCreate(c *gin.Context) {  // Handler untuk membuat banyak product sekaligus
+    var req bulk.Request                       // Variabel untuk menampung mode dan item dari request
+    if err := utils.BindJSON(c, &req); err != nil {  // Binding JSON request secara ketat
+        utils.HandleError(c, http.StatusBadRequest, err)  // Respons error jika binding gagal (400, 413, atau 415)
+        return
+    }
+
+    report, err := h.service.BulkCreate(c.Request.Context(), &req)  // Memanggil service untuk membuat product
+    if err != nil {
+        utils.HandleError(c, http.StatusInternalServerError, err)  // Respons error jika request ditolak seluruhnya
+        return
+    }
+
+    bulk.Respond(c, http.StatusCreated, report)  // Laporan per item (201, 207, atau 422)
+}
+
+func (h *ProductHandler) BulkUpdate(c *gin.Context) {  // Handler untuk memperbarui banyak product sekaligus
+    var req bulk.Request                       // Variabel untuk menampung mode dan item dari request
+    if err := utils.BindJSON(c, &req); err != nil {  // Binding JSON request secara ketat
+        utils.HandleError(c, http.StatusBadRequest, err)  // Respons error jika binding gagal (400, 413, atau 415)
+        return
+    }
+
+    report, err := h.service.BulkUpdate(c.Request.Context(), &req)  // Memanggil service untuk memperbarui product
+    if err != nil {
+        utils.HandleError(c, http.StatusInternalServerError, err)  // Respons error jika request ditolak seluruhnya
+        return
+    }
+
+    bulk.Respond(c, http.StatusOK, report)     // Laporan per item (200, 207, atau 422)
+}
+
+func (h *ProductHandler) BulkDelete(c *gin.Context) {  // Handler untuk menghapus banyak product sekaligus
+    var req bulk.DeleteRequest                 // Variabel untuk menampung mode dan ID dari request
+    if err := utils.BindJSON(c, &req); err != nil {  // Binding JSON request secara ketat
+        utils.HandleError(c, http.StatusBadRequest, err)  // Respons error jika binding gagal (400, 413, atau 415)
+        return
+    }
+
+    report, err := h.service.BulkDelete(c.Request.Context(), &req)  // Memanggil service untuk menghapus product
+    if err != nil {
+        utils.HandleError(c, http.StatusInternalServerError, err)  // Respons error jika request ditolak seluruhnya
+        return
+    }
+
+    bulk.Respond(c, http.StatusOK, report)     // Laporan per item (200, 207, atau 422)
+}
+
 // Add this method to the existing ProductHandler struct
 
 func (h *ProductHandler) GetByCategoryID(c *gin.Context) {  // Handler untuk mendapatkan product berdasarkan CategoryID
     categoryID, err := strconv.ParseUint(c.Param("categoryId"), 10, 32)  // Mengambil dan mengkonversi parameter categoryId
     if err != nil {
-        c.JSON(http.StatusBadRequest, utils.ErrorResponse("Invalid Category ID"))  // Respons error jika CategoryID tidak valid
+        utils.ErrorJSON(c, http.StatusBadRequest, "Invalid Category ID")  // Respons error jika CategoryID tidak valid
+        return
+    }
+
+    includeDescendants := false               // Default: hanya product di kategori itu sendiri
+    if raw := c.Query("include_descendants"); raw != "" {
+        if includeDescendants, err = strconv.ParseBool(raw); err != nil {
+            utils.ErrorJSON(c, http.StatusBadRequest, "include_descendants must be true or false")  // Respons error jika nilai tidak valid
+            return
+        }
+    }
+
+    filter, ok := attributeFilter(c)           // Filter opsional ?attr[color]=Red,Blue&attr[size]=M
+    if !ok {
+        return
+    }
+
+    sel, ok := service.Fields.Bind(c)          // Field dan relasi opsional ?fields=id,title,price&include=category
+    if !ok {
         return
     }
 
-    products, err := h.service.GetByCategoryID(uint(categoryID))  // Memanggil service untuk mendapatkan product berdasarkan CategoryID
+    products, err := h.service.GetByCategoryID(c.Request.Context(), uint(categoryID), includeDescendants, filter, sel)  // Memanggil service untuk mendapatkan product berdasarkan CategoryID
     if err != nil {
-        c.JSON(http.StatusInternalServerError, utils.ErrorResponse(err.Error()))  // Respons error jika gagal
+        utils.HandleError(c, http.StatusInternalServerError, err)  // Respons error jika gagal
         return
     }
 
-    c.JSON(http.StatusOK, utils.SuccessResponse(products))  // Respons sukses dengan data products
+    fieldset.Respond(c, http.StatusOK, sel, products)  // Respons sukses dengan field products yang diminta
+}
+
+func attributeFilter(c *gin.Context) (entity.AttributeFilter, bool) {  // Fungsi untuk membaca filter atribut dari query string
+    filter := entity.AttributeFilter{}
+    for code, raw := range c.QueryMap("attr") {
+        for _, value := range strings.Split(raw, ",") {  // Beberapa nilai dipisahkan koma berarti salah satu cocok
+            if value = strings.TrimSpace(value); value != "" {
+                filter[code] = append(filter[code], value)
+            }
+        }
+        if len(filter[code]) == 0 {
+            utils.ErrorJSON(c, http.StatusBadRequest, fmt.Sprintf("attr[%s] must not be empty", code))  // Respons error jika nilai filter kosong
+            return nil, false
+        }
+    }
+    return filter, true
 }
 
 
@@ -132,10 +252,12 @@ File handler.go ini berisi implementasi handler HTTP untuk modul Product. Beriku
 
     - Create : Membuat product baru dari data JSON request
     - GetByID : Mendapatkan product berdasarkan ID dari parameter URL
-    - GetAll : Mendapatkan semua product
+    - GetBySlug : Mendapatkan product berdasarkan slug; slug lama dijawab 301 Moved Permanently ke URL dengan slug terbaru
+    - GetAll : Mendapatkan semua product, dengan filter atribut varian ?attr[color]=Red,Blue&attr[size]=M
     - Update : Memperbarui product berdasarkan ID dan data JSON request
     - Delete : Menghapus product berdasarkan ID
     - GetByCategoryID : Mendapatkan product berdasarkan CategoryID (fitur tambahan)
+    - GetByID, GetBySlug, GetAll, dan GetByCategoryID menerima ?fields= dan ?include=category (whitelist service.Fields), nama yang tidak dikenal dijawab 400
 4. Alur Request :
 
     - Menerima HTTP request dari router
@@ -144,7 +266,7 @@ File handler.go ini berisi implementasi handler HTTP untuk modul Product. Beriku
     - Mengembalikan respons HTTP yang sesuai
 5. Penanganan Error :
 
-    - Error binding JSON: Status 400 Bad Request
+    - Error binding JSON: Status 400 Bad Request (field tidak dikenal, tipe salah, JSON rusak), 413 jika body terlalu besar, 415 jika Content-Type bukan JSON
     - Error validasi atau tidak ditemukan: Status 404 Not Found
     - Error internal: Status 500 Internal Server Error
 6. Format Respons :
@@ -0,0 +1,218 @@
+package handler                                // Mendefinisikan package handler untuk modul product
+
+import (
+    "errors"                                   // Package untuk pengecekan tipe error
+    "fmt"                                      // Package untuk formatting pesan error
+    "io"                                       // Package untuk membaca file unggahan
+    "mime"                                     // Package untuk parsing Content-Type
+    "mime/multipart"                           // Tipe file dari form multipart
+    "net/http"                                 // Package untuk konstanta HTTP
+    "rest-api-go/internal/module/product/entity"  // Mengimpor entity product
+    "rest-api-go/internal/module/product/service" // Mengimpor service product
+    "rest-api-go/pkg/utils"                    // Mengimpor utilitas aplikasi
+    "strconv"                                  // Package untuk konversi string
+
+    "github.com/gin-gonic/gin"                 // Framework web Gin
+)
+
+const (
+    imageField        = "image"                // Nama field form untuk file gambar, boleh diulang
+    MaxImagesPerUpload = 10                    // Jumlah file maksimum dalam satu request
+)
+
+type ImageHandler struct {                     // Mendefinisikan struct handler gambar product
+    service *service.ImageService              // Dependency service
+}
+
+func NewImageHandler(service *service.ImageService) *ImageHandler {  // Constructor untuk handler
+    return &ImageHandler{service}              // Mengembalikan instance handler dengan service yang diinjeksi
+}
+
+func (h *ImageHandler) Upload(c *gin.Context) {  // Handler untuk mengunggah gambar product (multipart/form-data)
+    productID, ok := parseID(c, "id", "Invalid ID")
+    if !ok {
+        return
+    }
+
+    mediaType, _, err := mime.ParseMediaType(c.GetHeader("Content-Type"))
+    if err != nil || mediaType != "multipart/form-data" {
+        utils.ErrorJSON(c, http.StatusUnsupportedMediaType, "Content-Type must be multipart/form-data")
+        return
+    }
+
+    form, err := c.MultipartForm()             // Body sudah dibatasi middleware BodyLimit pada route
+    if err != nil {
+        var maxBytesErr *http.MaxBytesError
+        if errors.As(err, &maxBytesErr) {
+            utils.ErrorJSON(c, http.StatusRequestEntityTooLarge, fmt.Sprintf("request body must not be larger than %d bytes", maxBytesErr.Limit))
+            return
+        }
+        utils.ErrorJSON(c, http.StatusBadRequest, "request body must be a valid multipart form")
+        return
+    }
+    defer form.RemoveAll()                     // Menghapus file sementara milik form
+
+    headers := form.File[imageField]
+    if len(headers) == 0 {
+        utils.ErrorJSON(c, http.StatusBadRequest, fmt.Sprintf("form field %q must contain at least one file", imageField))
+        return
+    }
+    if len(headers) > MaxImagesPerUpload {
+        utils.ErrorJSON(c, http.StatusBadRequest, fmt.Sprintf("at most %d images can be uploaded at once", MaxImagesPerUpload))
+        return
+    }
+
+    files := make([][]byte, 0, len(headers))
+    for _, header := range headers {           // Semua file dibaca dan diperiksa sebelum ada yang disimpan
+        data, err := h.readFile(header)
+        if err == nil {
+            err = h.service.Check(data)
+        }
+        if err != nil {
+            var httpErr *utils.HTTPError
+            if errors.As(err, &httpErr) {      // Pesan diawali nama file agar client tahu file mana yang ditolak
+                err = utils.NewHTTPError(httpErr.Status, header.Filename+": "+httpErr.Message)
+            }
+            utils.HandleError(c, http.StatusBadRequest, err)
+            return
+        }
+        files = append(files, data)
+    }
+
+    images := make([]entity.ProductImage, 0, len(files))
+    for _, data := range files {
+        image, err := h.service.Upload(c.Request.Context(), uint(productID), data)  // Memanggil service untuk menyimpan gambar
+        if err != nil {
+            utils.HandleError(c, http.StatusInternalServerError, err)  // Respons error jika gagal
+            return
+        }
+        images = append(images, *image)
+    }
+
+    c.JSON(http.StatusCreated, utils.SuccessResponse(images))  // Respons sukses dengan gambar yang baru dibuat
+}
+
+func (h *ImageHandler) List(c *gin.Context) {  // Handler untuk mendapatkan gambar product
+    productID, ok := parseID(c, "id", "Invalid ID")
+    if !ok {
+        return
+    }
+
+    images, err := h.service.List(c.Request.Context(), uint(productID))  // Memanggil service untuk mendapatkan gambar
+    if err != nil {
+        utils.HandleError(c, http.StatusInternalServerError, err)  // Respons error jika gagal
+        return
+    }
+
+    c.JSON(http.StatusOK, utils.SuccessResponse(images))  // Respons sukses dengan data gambar
+}
+
+func (h *ImageHandler) Reorder(c *gin.Context) {  // Handler untuk mengurutkan ulang gambar product
+    productID, ok := parseID(c, "id", "Invalid ID")
+    if !ok {
+        return
+    }
+
+    var req entity.ImageOrderRequest           // Variabel untuk menampung urutan baru dari request
+    if err := utils.BindJSON(c, &req); err != nil {  // Binding JSON request ke struct secara ketat
+        utils.HandleError(c, http.StatusBadRequest, err)  // Respons error jika binding gagal (400, 413, atau 415)
+        return
+    }
+
+    images, err := h.service.Reorder(c.Request.Context(), uint(productID), &req)  // Memanggil service untuk menyimpan urutan
+    if err != nil {
+        utils.HandleError(c, http.StatusInternalServerError, err)  // Respons error jika gagal
+        return
+    }
+
+    c.JSON(http.StatusOK, utils.SuccessResponse(images))  // Respons sukses dengan gambar dalam urutan baru
+}
+
+func (h *ImageHandler) SetPrimary(c *gin.Context) {  // Handler untuk memilih gambar utama
+    productID, ok := parseID(c, "id", "Invalid ID")
+    if !ok {
+        return
+    }
+    imageID, ok := parseID(c, "imageId", "Invalid Image ID")
+    if !ok {
+        return
+    }
+
+    images, err := h.service.SetPrimary(c.Request.Context(), uint(productID), uint(imageID))  // Memanggil service untuk mengganti gambar utama
+    if err != nil {
+        utils.HandleError(c, http.StatusInternalServerError, err)  // Respons error jika gagal
+        return
+    }
+
+    c.JSON(http.StatusOK, utils.SuccessResponse(images))  // Respons sukses dengan seluruh gambar product
+}
+
+func (h *ImageHandler) Delete(c *gin.Context) {  // Handler untuk menghapus gambar product
+    productID, ok := parseID(c, "id", "Invalid ID")
+    if !ok {
+        return
+    }
+    imageID, ok := parseID(c, "imageId", "Invalid Image ID")
+    if !ok {
+        return
+    }
+
+    if err := h.service.Delete(c.Request.Context(), uint(productID), uint(imageID)); err != nil {  // Memanggil service untuk menghapus gambar
+        utils.HandleError(c, http.StatusInternalServerError, err)  // Respons error jika gagal
+        return
+    }
+
+    c.JSON(http.StatusOK, utils.SuccessResponse("Image deleted successfully"))  // Respons sukses dengan pesan
+}
+
+func (h *ImageHandler) readFile(header *multipart.FileHeader) ([]byte, error) {  // Fungsi untuk membaca isi file tanpa melebihi batas ukuran
+    max := h.service.MaxBytes()
+    if header.Size > max {
+        return nil, h.service.ErrTooLarge()
+    }
+    file, err := header.Open()
+    if err != nil {
+        return nil, err
+    }
+    defer file.Close()
+
+    data, err := io.ReadAll(io.LimitReader(file, max+1))  // Satu byte lebih untuk mendeteksi file yang terlalu besar
+    if err == nil && int64(len(data)) > max {
+        return nil, h.service.ErrTooLarge()
+    }
+    return data, err
+}
+
+func parseID(c *gin.Context, param, message string) (uint64, bool) {  // Fungsi untuk membaca parameter ID dari URL
+    id, err := strconv.ParseUint(c.Param(param), 10, 32)
+    if err != nil {
+        utils.ErrorJSON(c, http.StatusBadRequest, message)  // Respons error jika ID tidak valid
+        return 0, false
+    }
+    return id, true
+}
+
+
+// {{{ Penjelasan Fungsi ImageHandler }}}
+
+/*
+## Penjelasan Detail
+File image.go ini berisi handler HTTP untuk gambar product. Berikut penjelasan detailnya:
+
+1. Upload :
+
+    - Body harus multipart/form-data (selain itu 415) dengan satu atau lebih file pada field "image", maksimal MaxImagesPerUpload file
+    - Ukuran body seluruhnya dibatasi middleware BodyLimit pada route, ukuran tiap file dibatasi MAX_IMAGE_BYTES (413)
+    - File dibaca dengan io.LimitReader sehingga header Size yang salah tidak membuat file besar terbaca seluruhnya
+    - Semua file diperiksa (ukuran, jenis, dimensi) sebelum ada yang disimpan; pesan error diawali nama file yang bermasalah
+    - Respons 201 berisi gambar yang baru dibuat beserta URL file asli dan thumbnail
+2. List, Reorder, SetPrimary :
+
+    - Selalu mengembalikan seluruh gambar product sesuai urutan terbaru
+3. Delete :
+
+    - Menghapus satu gambar; 404 jika gambar tidak ada atau milik product lain
+4. Penanganan Error :
+
+    - Error dari service sudah membawa status sendiri (404, 413, 415, 422) dan dikirim melalui utils.HandleError
+*/
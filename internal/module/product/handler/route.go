@@ -1,18 +1,47 @@
 package handler                                // Mendefinisikan package handler untuk modul product
 
 import (
+    "rest-api-go/pkg/bulk"                     // Mengimpor package bulk untuk batas body request bulk
+    "rest-api-go/pkg/middleware"               // Mengimpor middleware untuk batas body unggahan
+
     "github.com/gin-gonic/gin"                 // Mengimpor framework web Gin
 )
 
-func RegisterRoutes(router *gin.RouterGroup, handler *ProductHandler) {  // Fungsi untuk mendaftarkan route
+func RegisterRoutes(router *gin.RouterGroup, handler *ProductHandler, images *ImageHandler, variants *VariantHandler, cacheControl gin.HandlerFunc) {  // Fungsi untuk mendaftarkan route
     products := router.Group("/products")      // Membuat grup route dengan prefix "/products"
     {
         products.POST("", handler.Create)      // Mendaftarkan endpoint POST untuk membuat product baru
-        products.GET("/:id", handler.GetByID)  // Mendaftarkan endpoint GET dengan parameter id untuk mendapatkan product berdasarkan ID
-        products.GET("", handler.GetAll)       // Mendaftarkan endpoint GET untuk mendapatkan semua product
+        products.GET("/by-slug/:slug", handler.GetBySlug)  // Mendaftarkan endpoint GET untuk mendapatkan product berdasarkan slug
+        products.GET("/:id", cacheControl, handler.GetByID)  // Mendaftarkan endpoint GET dengan parameter id untuk mendapatkan product berdasarkan ID
+        products.GET("", cacheControl, handler.GetAll)  // Mendaftarkan endpoint GET untuk mendapatkan semua product
         products.GET("/category/:categoryId", handler.GetByCategoryID)  // Mendaftarkan endpoint GET untuk mendapatkan product berdasarkan kategori
         products.PUT("/:id", handler.Update)   // Mendaftarkan endpoint PUT dengan parameter id untuk memperbarui product
         products.DELETE("/:id", handler.Delete)  // Mendaftarkan endpoint DELETE dengan parameter id untuk menghapus product
+        products.POST("/bulk", middleware.BodyLimit(bulk.MaxBodyBytes), handler.BulkCreate)  // Mendaftarkan endpoint POST untuk membuat banyak product
+        products.PATCH("/bulk", middleware.BodyLimit(bulk.MaxBodyBytes), handler.BulkUpdate)  // Mendaftarkan endpoint PATCH untuk memperbarui banyak product
+        products.DELETE("/bulk", middleware.BodyLimit(bulk.MaxBodyBytes), handler.BulkDelete)  // Mendaftarkan endpoint DELETE untuk menghapus banyak product
+        products.GET("/export", handler.Export)  // Mendaftarkan endpoint GET untuk mengekspor product sebagai file
+        products.POST("/import", middleware.BodyLimit(MaxImportBytes), handler.Import)  // Mendaftarkan endpoint POST untuk mengimpor product dari file
+        products.GET("/imports/:importId", handler.GetImport)  // Mendaftarkan endpoint GET untuk mendapatkan ringkasan impor
+        products.GET("/imports/:importId/errors", handler.ImportErrors)  // Mendaftarkan endpoint GET untuk mengunduh laporan error impor
+    }
+
+    uploadLimit := images.service.MaxBytes()*MaxImagesPerUpload + 1<<20  // Seluruh file ditambah 1 MiB untuk header multipart
+    productImages := router.Group("/products/:id/images")  // Gambar berada di bawah resource product
+    {
+        productImages.POST("", middleware.BodyLimit(uploadLimit), images.Upload)  // Mendaftarkan endpoint POST untuk mengunggah gambar
+        productImages.GET("", images.List)     // Mendaftarkan endpoint GET untuk mendapatkan gambar product
+        productImages.PUT("/order", images.Reorder)  // Mendaftarkan endpoint PUT untuk mengurutkan ulang gambar
+        productImages.PUT("/:imageId/primary", images.SetPrimary)  // Mendaftarkan endpoint PUT untuk memilih gambar utama
+        productImages.DELETE("/:imageId", images.Delete)  // Mendaftarkan endpoint DELETE untuk menghapus gambar
+    }
+
+    productVariants := router.Group("/products/:id/variants")  // Varian berada di bawah resource product
+    {
+        productVariants.GET("", variants.List)  // Mendaftarkan endpoint GET untuk mendapatkan varian product
+        productVariants.POST("", variants.Create)  // Mendaftarkan endpoint POST untuk membuat varian
+        productVariants.PUT("/:variantId", variants.Update)  // Mendaftarkan endpoint PUT untuk mengganti varian
+        productVariants.DELETE("/:variantId", variants.Delete)  // Mendaftarkan endpoint DELETE untuk menghapus varian
     }
 }
 
@@ -31,15 +60,35 @@ File route.go ini berisi konfigurasi routing untuk modul Product. Berikut penjel
 3. Endpoint API :
 
     - POST /products : Membuat product baru
-    - GET /products/:id : Mendapatkan product berdasarkan ID
-    - GET /products : Mendapatkan semua product
-    - GET /products/category/:categoryId : Mendapatkan product berdasarkan kategori
+    - GET /products/by-slug/:slug : Mendapatkan product berdasarkan slug, slug lama dijawab 301 ke slug terbaru
+    - GET /products/:id : Mendapatkan product berdasarkan ID, dari cache dengan header Cache-Control
+    - GET /products : Mendapatkan semua product, ?attr[color]=Red,Blue&attr[size]=M untuk filter atribut varian, dari cache dengan header Cache-Control
+    - GET /products/category/:categoryId : Mendapatkan product berdasarkan kategori, ?include_descendants=true untuk seluruh sub-kategori
     - PUT /products/:id : Memperbarui product berdasarkan ID
     - DELETE /products/:id : Menghapus product berdasarkan ID
+    - POST /products/bulk : Membuat banyak product sekaligus, laporan per item
+    - PATCH /products/bulk : Memperbarui banyak product sekaligus, hanya field yang dikirim
+    - DELETE /products/bulk : Menghapus banyak product sekaligus berdasarkan daftar ID
+    - GET /products/export : Mengekspor product sebagai CSV, JSON Lines, atau XLSX dengan filter yang sama dengan GET /products
+    - POST /products/import : Mengimpor product dari CSV atau JSON Lines (upsert berdasarkan SKU atau ID)
+    - GET /products/imports/:importId : Mendapatkan ringkasan impor
+    - GET /products/imports/:importId/errors : Mengunduh laporan error impor
+    - POST /products/:id/images : Mengunggah satu atau lebih gambar (multipart/form-data, field "image")
+    - GET /products/:id/images : Mendapatkan gambar product sesuai urutan
+    - PUT /products/:id/images/order : Mengurutkan ulang gambar
+    - PUT /products/:id/images/:imageId/primary : Memilih gambar utama
+    - DELETE /products/:id/images/:imageId : Menghapus gambar
+    - GET /products/:id/variants : Mendapatkan varian product
+    - POST /products/:id/variants : Membuat varian
+    - PUT /products/:id/variants/:variantId : Mengganti varian
+    - DELETE /products/:id/variants/:variantId : Menghapus varian tanpa stok
 4. Parameter URL :
 
     - :id : Parameter dinamis untuk ID product
+    - :imageId : Parameter dinamis untuk ID gambar
+    - :variantId : Parameter dinamis untuk ID varian
     - :categoryId : Parameter dinamis untuk ID kategori
+    - :importId : Parameter dinamis untuk ID impor
 5. Handler Mapping :
 
     - Setiap endpoint dipetakan ke method handler yang sesuai
@@ -0,0 +1,221 @@
+package handler                                // Mendefinisikan package handler untuk modul product
+
+import (
+    "fmt"                                      // Package untuk formatting pesan error dan nama file
+    "io"                                       // Package untuk membaca body impor
+    "mime"                                     // Package untuk parsing Content-Type
+    "net/http"                                 // Package untuk konstanta HTTP
+    "path/filepath"                            // Package untuk ekstensi nama file
+    "rest-api-go/internal/module/product/service" // Mengimpor service product
+    "rest-api-go/pkg/bulk"                     // Mengimpor package bulk untuk mode impor
+    "rest-api-go/pkg/tabular"                  // Mengimpor package tabular untuk format file
+    "rest-api-go/pkg/utils"                    // Mengimpor utilitas aplikasi
+    "rest-api-go/pkg/validation"               // Mengimpor package validation untuk locale
+    "strconv"                                  // Package untuk parsing dry_run
+    "time"                                     // Package untuk tanggal di nama file
+
+    "github.com/gin-gonic/gin"                 // Framework web Gin
+)
+
+const (
+    importField    = "file"                    // Nama field form untuk file impor
+    MaxImportBytes = 64 << 20                  // Batas body impor (64 MiB), menggantikan batas global pada route impor
+    flushRows      = 500                       // Data dikirim ke client setiap 500 baris
+)
+
+func (h *ProductHandler) Export(c *gin.Context) {  // Handler untuk mengekspor product sebagai CSV, JSON Lines, atau XLSX
+    format, err := tabular.ParseFormat(c.DefaultQuery("format", string(tabular.CSV)))
+    if err != nil {
+        utils.HandleError(c, http.StatusBadRequest, err)
+        return
+    }
+    filter, ok := attributeFilter(c)           // Filter yang sama dengan GET /products
+    if !ok {
+        return
+    }
+
+    filename := fmt.Sprintf("products-%s.%s", time.Now().UTC().Format("20060102"), format)
+    stream(c, format, filename, service.ExportColumns, func(write func([]any) error) error {
+        return h.service.Export(c.Request.Context(), filter, write)
+    })
+}
+
+func (h *ProductHandler) Import(c *gin.Context) {  // Handler untuk mengimpor product dari CSV atau JSON Lines
+    body, format, err := importBody(c)
+    if err != nil {
+        utils.HandleError(c, http.StatusBadRequest, err)
+        return
+    }
+    if raw := c.Query("format"); raw != "" {   // Format di query string mengalahkan Content-Type dan ekstensi file
+        if format, err = tabular.ParseFormat(raw); err != nil {
+            utils.HandleError(c, http.StatusBadRequest, err)
+            return
+        }
+    }
+    dryRun := false
+    if raw := c.Query("dry_run"); raw != "" {
+        if dryRun, err = strconv.ParseBool(raw); err != nil {
+            utils.ErrorJSON(c, http.StatusBadRequest, "dry_run must be true or false")
+            return
+        }
+    }
+
+    opts := service.ImportOptions{
+        Format:  format,
+        Key:     c.Query("key"),
+        Mode:    bulk.Mode(c.Query("mode")),
+        DryRun:  dryRun,
+        Mapping: c.QueryMap("map"),            // ?map[Product Name]=title
+        Locale:  validation.LocaleFromHeader(c.GetHeader("Accept-Language")),
+    }
+    result, err := h.service.Import(c.Request.Context(), body, opts)  // Memanggil service untuk mengimpor product
+    if err != nil {
+        utils.HandleError(c, http.StatusInternalServerError, err)  // Respons error jika file tidak dapat dibaca (400, 413) atau gagal
+        return
+    }
+    if result.Failed > 0 {
+        c.Header("Link", fmt.Sprintf(`</api/products/imports/%d/errors>; rel="errors"`, result.ID))  // Laporan error lengkap
+    }
+
+    c.JSON(http.StatusCreated, utils.SuccessResponse(result))  // Respons sukses dengan laporan impor
+}
+
+func (h *ProductHandler) GetImport(c *gin.Context) {  // Handler untuk mendapatkan ringkasan impor
+    id, ok := parseID(c, "importId", "Invalid import ID")
+    if !ok {
+        return
+    }
+
+    result, err := h.service.GetImport(c.Request.Context(), uint(id))  // Memanggil service untuk mendapatkan impor
+    if err != nil {
+        utils.HandleError(c, http.StatusInternalServerError, err)  // Respons error (404 atau 500)
+        return
+    }
+
+    c.JSON(http.StatusOK, utils.SuccessResponse(result))  // Respons sukses dengan ringkasan impor
+}
+
+func (h *ProductHandler) ImportErrors(c *gin.Context) {  // Handler untuk mengunduh laporan error impor
+    id, ok := parseID(c, "importId", "Invalid import ID")
+    if !ok {
+        return
+    }
+    format, err := tabular.ParseFormat(c.DefaultQuery("format", string(tabular.CSV)))
+    if err != nil {
+        utils.HandleError(c, http.StatusBadRequest, err)
+        return
+    }
+
+    filename := fmt.Sprintf("product-import-%d-errors.%s", id, format)
+    stream(c, format, filename, service.ImportErrorColumns, func(write func([]any) error) error {
+        return h.service.ImportErrors(c.Request.Context(), uint(id), write)
+    })
+}
+
+func importBody(c *gin.Context) (io.Reader, tabular.Format, error) {  // Fungsi untuk mendapatkan file impor dari body mentah atau form multipart
+    mediaType, _, _ := mime.ParseMediaType(c.GetHeader("Content-Type"))
+    if format, ok := tabular.FormatFromContentType(mediaType); ok {  // Body berisi file itu sendiri
+        return c.Request.Body, format, nil
+    }
+    if mediaType != "multipart/form-data" {
+        return nil, "", utils.NewHTTPError(http.StatusUnsupportedMediaType, "Content-Type must be text/csv, application/x-ndjson or multipart/form-data")
+    }
+
+    reader, err := c.Request.MultipartReader()  // Dibaca sebagai stream, tanpa file sementara
+    if err != nil {
+        return nil, "", utils.NewHTTPError(http.StatusBadRequest, "request body must be a valid multipart form")
+    }
+    for {
+        part, err := reader.NextPart()
+        if err == io.EOF {
+            return nil, "", utils.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("form field %q must contain a file", importField))
+        }
+        if err != nil {
+            return nil, "", utils.NewHTTPError(http.StatusBadRequest, "request body must be a valid multipart form")
+        }
+        if part.FormName() != importField {
+            continue
+        }
+        format, err := tabular.ParseFormat(filepath.Ext(part.FileName()))  // Format dari ekstensi file, misal products.csv
+        if err != nil {
+            partType, _, _ := mime.ParseMediaType(part.Header.Get("Content-Type"))
+            format, _ = tabular.FormatFromContentType(partType)  // Kosong jika tidak dikenal, ditolak oleh service
+        }
+        return part, format, nil
+    }
+}
+
+func stream(c *gin.Context, format tabular.Format, filename string, columns []string, run func(write func([]any) error) error) {  // Fungsi untuk menulis baris ke respons sebagai file unduhan
+    var out tabular.Writer                     // Dibuat saat baris pertama, sehingga error sebelum itu masih bisa dikirim sebagai JSON
+    rows := 0
+    start := func() error {
+        if out != nil {
+            return nil
+        }
+        c.Header("Content-Type", format.ContentType())
+        c.Header("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": filename}))
+        c.Status(http.StatusOK)
+        var err error
+        out, err = tabular.NewWriter(c.Writer, format, columns)
+        return err
+    }
+
+    err := run(func(row []any) error {
+        if err := start(); err != nil {
+            return err
+        }
+        if err := out.Write(row); err != nil {
+            return err
+        }
+        if rows++; rows%flushRows == 0 {       // Kirim sebagian data agar client mulai menerima file
+            if err := out.Flush(); err != nil {
+                return err
+            }
+            c.Writer.Flush()
+        }
+        return nil
+    })
+    if err != nil {
+        if out == nil {
+            utils.HandleError(c, http.StatusInternalServerError, err)  // Belum ada data terkirim
+            return
+        }
+        c.Error(err)                           // Status sudah terkirim, error hanya dicatat di access log dan file terpotong
+        return
+    }
+    if err := start(); err != nil {            // Hasil kosong tetap berisi header
+        c.Error(err)
+        return
+    }
+    if err := out.Close(); err != nil {
+        c.Error(err)
+    }
+}
+
+
+// {{{ Penjelasan Impor dan Ekspor }}}
+
+/*
+## Penjelasan Detail
+File transfer.go ini berisi handler impor dan ekspor product. Berikut penjelasan detailnya:
+
+1. Export :
+
+    - GET /products/export?format=csv|jsonl|xlsx, default csv, dengan filter ?attr[...] yang sama dengan GET /products
+    - File dikirim sebagai unduhan (Content-Disposition: attachment) sambil dibaca dari database
+2. Import :
+
+    - POST /products/import dengan body file mentah (Content-Type text/csv atau application/x-ndjson) atau form multipart dengan field "file"
+    - Format dari ?format=, Content-Type, atau ekstensi file
+    - Opsi query string: key=sku|id, mode=atomic|best_effort, dry_run=true, map[Kolom File]=kolom_product
+    - Respons 201 berisi laporan impor; jika ada baris yang gagal, header Link menunjuk ke laporan error
+3. GetImport dan ImportErrors :
+
+    - GET /products/imports/:importId : Ringkasan impor
+    - GET /products/imports/:importId/errors?format=csv|jsonl|xlsx : Laporan error lengkap sebagai unduhan
+4. stream :
+
+    - Header respons baru ditulis saat baris pertama, sehingga error sebelum itu (misal atribut filter tidak dikenal, impor tidak ada) tetap dijawab dengan JSON dan status yang tepat
+    - Data di-flush ke client setiap 500 baris
+    - Error setelah data terkirim tidak dapat mengubah status, sehingga hanya dicatat di access log
+*/
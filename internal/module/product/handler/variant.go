@@ -0,0 +1,116 @@
+package handler                                // Mendefinisikan package handler untuk modul product
+
+import (
+    "net/http"                                 // Package untuk konstanta HTTP
+    "rest-api-go/internal/module/product/entity"  // Mengimpor entity product
+    "rest-api-go/internal/module/product/service" // Mengimpor service product
+    "rest-api-go/pkg/utils"                    // Mengimpor utilitas aplikasi
+
+    "github.com/gin-gonic/gin"                 // Framework web Gin
+)
+
+type VariantHandler struct {                   // Mendefinisikan struct handler varian product
+    service *service.VariantService            // Dependency service
+}
+
+func NewVariantHandler(service *service.VariantService) *VariantHandler {  // Constructor untuk handler
+    return &VariantHandler{service}            // Mengembalikan instance handler dengan service yang diinjeksi
+}
+
+func (h *VariantHandler) List(c *gin.Context) {  // Handler untuk mendapatkan varian product
+    productID, ok := parseID(c, "id", "Invalid ID")
+    if !ok {
+        return
+    }
+
+    variants, err := h.service.List(c.Request.Context(), uint(productID))  // Memanggil service untuk mendapatkan varian
+    if err != nil {
+        utils.HandleError(c, http.StatusInternalServerError, err)  // Respons error jika gagal
+        return
+    }
+
+    c.JSON(http.StatusOK, utils.SuccessResponse(variants))  // Respons sukses dengan data varian
+}
+
+func (h *VariantHandler) Create(c *gin.Context) {  // Handler untuk membuat varian baru
+    productID, ok := parseID(c, "id", "Invalid ID")
+    if !ok {
+        return
+    }
+
+    var req entity.VariantRequest              // Variabel untuk menampung data varian dari request
+    if err := utils.BindJSON(c, &req); err != nil {  // Binding JSON request ke struct secara ketat
+        utils.HandleError(c, http.StatusBadRequest, err)  // Respons error jika binding gagal (400, 413, atau 415)
+        return
+    }
+
+    variant, err := h.service.Create(c.Request.Context(), uint(productID), &req)  // Memanggil service untuk membuat varian
+    if err != nil {
+        utils.HandleError(c, http.StatusInternalServerError, err)  // Respons error jika gagal
+        return
+    }
+
+    c.JSON(http.StatusCreated, utils.SuccessResponse(variant))  // Respons sukses dengan data varian
+}
+
+func (h *VariantHandler) Update(c *gin.Context) {  // Handler untuk mengganti varian
+    productID, ok := parseID(c, "id", "Invalid ID")
+    if !ok {
+        return
+    }
+    variantID, ok := parseID(c, "variantId", "Invalid Variant ID")
+    if !ok {
+        return
+    }
+
+    var req entity.VariantRequest              // Variabel untuk menampung data varian dari request
+    if err := utils.BindJSON(c, &req); err != nil {  // Binding JSON request ke struct secara ketat
+        utils.HandleError(c, http.StatusBadRequest, err)  // Respons error jika binding gagal (400, 413, atau 415)
+        return
+    }
+
+    variant, err := h.service.Update(c.Request.Context(), uint(productID), uint(variantID), &req)  // Memanggil service untuk memperbarui varian
+    if err != nil {
+        utils.HandleError(c, http.StatusInternalServerError, err)  // Respons error jika gagal
+        return
+    }
+
+    c.JSON(http.StatusOK, utils.SuccessResponse(variant))  // Respons sukses dengan data varian yang diperbarui
+}
+
+func (h *VariantHandler) Delete(c *gin.Context) {  // Handler untuk menghapus varian
+    productID, ok := parseID(c, "id", "Invalid ID")
+    if !ok {
+        return
+    }
+    variantID, ok := parseID(c, "variantId", "Invalid Variant ID")
+    if !ok {
+        return
+    }
+
+    if err := h.service.Delete(c.Request.Context(), uint(productID), uint(variantID)); err != nil {  // Memanggil service untuk menghapus varian
+        utils.HandleError(c, http.StatusInternalServerError, err)  // Respons error jika gagal
+        return
+    }
+
+    c.JSON(http.StatusOK, utils.SuccessResponse("Variant deleted successfully"))  // Respons sukses dengan pesan
+}
+
+
+// {{{ Penjelasan Fungsi VariantHandler }}}
+
+/*
+## Penjelasan Detail
+File variant.go ini berisi handler HTTP untuk varian product. Berikut penjelasan detailnya:
+
+1. Endpoint :
+
+    - List : Semua varian product beserta map attributes
+    - Create : Membuat varian dari body {"sku", "price", "attributes"}
+    - Update : Mengganti SKU, harga khusus, dan atribut varian; stok tidak berubah
+    - Delete : Menghapus varian yang stoknya 0
+2. Penanganan Error :
+
+    - Error binding JSON: 400, 413, atau 415
+    - Error dari service sudah membawa status sendiri (400, 404, 409) dan dikirim melalui utils.HandleError
+*/
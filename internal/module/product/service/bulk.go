@@ -0,0 +1,164 @@
+package service                                // Mendefinisikan package service untuk modul product
+
+import (
+    "context"                                 // Package untuk context request
+    "encoding/json"                           // Package untuk item JSON mentah
+    "errors"                                  // Package untuk pengecekan error
+    "net/http"                                // Package untuk konstanta HTTP
+    "rest-api-go/internal/module/product/entity"  // Mengimpor entity product
+    "rest-api-go/pkg/bulk"                    // Package bulk untuk laporan per item
+    "rest-api-go/pkg/tracing"                 // Mengimpor package tracing untuk span service
+    "rest-api-go/pkg/utils"                   // Mengimpor utilitas aplikasi untuk decode JSON
+
+    "gorm.io/gorm"                            // Mengimpor ORM GORM
+)
+
+func (s *ProductService) BulkCreate(ctx context.Context, req *bulk.Request) (*bulk.Report, error) {  // Method untuk membuat banyak product sekaligus
+    ctx, span := tracing.Start(ctx, "ProductService.BulkCreate")  // Membuat child span untuk method service
+    defer span.End()                          // Menutup span saat method selesai
+
+    if err := req.Validate(); err != nil {    // Validasi mode dan jumlah item
+        return nil, err
+    }
+
+    report := bulk.NewReport(req.Mode, len(req.Items))
+    err := bulk.Run(s.db.WithContext(ctx), report, func(tx *gorm.DB) error {
+        batch := bulk.NewBatch(tx, report, func(p *entity.Product) uint { return p.ID }).AfterCreate(recordCreated)
+        claims := bulk.Claims{}               // SKU dan slug item sebelumnya yang belum tersimpan
+        for i, raw := range req.Items {
+            product, err := s.newProduct(tx, raw, claims)
+            if err != nil {
+                report.Fail(i, err)           // Status item ditentukan dari error, seperti utils.HandleError
+                continue
+            }
+            batch.Add(i, product)
+        }
+        batch.Flush()
+        return nil
+    })
+    return report, err
+}
+
+func (s *ProductService) BulkUpdate(ctx context.Context, req *bulk.Request) (*bulk.Report, error) {  // Method untuk memperbarui banyak product sekaligus, hanya field yang dikirim yang berubah
+    ctx, span := tracing.Start(ctx, "ProductService.BulkUpdate")  // Membuat child span untuk method service
+    defer span.End()                          // Menutup span saat method selesai
+
+    if err := req.Validate(); err != nil {    // Validasi mode dan jumlah item
+        return nil, err
+    }
+
+    report := bulk.NewReport(req.Mode, len(req.Items))
+    err := bulk.Run(s.db.WithContext(ctx), report, func(tx *gorm.DB) error {
+        for i, raw := range req.Items {
+            bulk.Item(tx, report, i, http.StatusOK, func(tx *gorm.DB) (uint, error) {
+                id, err := bulk.ID(raw)
+                if err != nil {
+                    return 0, err
+                }
+                var product entity.Product
+                if err := tx.First(&product, id).Error; err != nil {
+                    if errors.Is(err, gorm.ErrRecordNotFound) {
+                        return 0, ErrProductNotFound
+                    }
+                    return 0, err
+                }
+                sent, err := bulk.Patch(raw, &product)  // Field yang tidak dikirim tetap bernilai lama
+                if err != nil {
+                    return 0, err
+                }
+                product.ID = id
+                if !sent["slug"] {            // Slug dibuat ulang jika judul berubah, sama seperti update tanpa slug
+                    product.Slug = ""
+                }
+                if err := product.Validate(); err != nil {
+                    return 0, err
+                }
+                return id, s.update(tx, &product)
+            })
+        }
+        return nil
+    })
+    return report, err
+}
+
+func (s *ProductService) BulkDelete(ctx context.Context, req *bulk.DeleteRequest) (*bulk.Report, error) {  // Method untuk menghapus banyak product sekaligus
+    ctx, span := tracing.Start(ctx, "ProductService.BulkDelete")  // Membuat child span untuk method service
+    defer span.End()                          // Menutup span saat method selesai
+
+    if err := req.Validate(); err != nil {    // Validasi mode dan daftar ID
+        return nil, err
+    }
+
+    report := bulk.NewReport(req.Mode, len(req.IDs))
+    images := make([][]entity.ProductImage, len(req.IDs))  // Gambar per item, filenya dihapus setelah commit
+    err := bulk.Run(s.db.WithContext(ctx), report, func(tx *gorm.DB) error {
+        for i, id := range req.IDs {
+            bulk.Item(tx, report, i, http.StatusOK, func(tx *gorm.DB) (uint, error) {
+                var count int64
+                if err := tx.Model(&entity.Product{}).Where("id = ?", id).Count(&count).Error; err != nil {
+                    return 0, err
+                }
+                if count == 0 {
+                    return 0, ErrProductNotFound
+                }
+                var err error
+                images[i], err = s.remove(tx, id)
+                return id, err
+            })
+        }
+        return nil
+    })
+    if err != nil {
+        return nil, err
+    }
+
+    for i := range images {                   // Hanya file milik product yang benar-benar terhapus
+        if report.Saved(i) {
+            s.deleteFiles(ctx, images[i])
+        }
+    }
+    return report, nil
+}
+
+func (s *ProductService) newProduct(tx *gorm.DB, raw json.RawMessage, claims bulk.Claims) (*entity.Product, error) {  // Fungsi untuk membaca dan memeriksa satu item bulk create
+    var product entity.Product
+    if err := utils.DecodeJSON(raw, &product); err != nil {  // Field yang tidak dikenal ditolak seperti BindJSON
+        return nil, err
+    }
+    if err := product.Validate(); err != nil {
+        return nil, err
+    }
+    product.ID = 0                            // ID selalu dibuat database
+    product.Stock = 0                         // Stok awal selalu 0, sama dengan Create
+    product.Images = nil
+    product.Variants = nil
+    if err := s.checkNew(tx, &product, claims); err != nil {
+        return nil, err
+    }
+    return &product, nil
+}
+
+
+// {{{ Penjelasan Bulk Product }}}
+
+/*
+## Penjelasan Detail
+File bulk.go ini berisi operasi bulk untuk product. Berikut penjelasan detailnya:
+
+1. BulkCreate :
+
+    - Setiap item didecode dan divalidasi sendiri, item yang rusak hanya menggagalkan item itu
+    - SKU dan slug diperiksa terhadap database dan terhadap item sebelumnya dalam request yang sama (bulk.Claims)
+    - Item yang lolos disimpan dengan INSERT multi-baris per 500 product
+    - Stok awal selalu 0, gambar dan varian diabaikan seperti pada Create
+2. BulkUpdate :
+
+    - Setiap item wajib berisi id dan hanya field yang dikirim yang berubah
+    - Jika slug tidak dikirim dan judul berubah, slug dibuat ulang dan slug lama disimpan sebagai redirect
+    - Aturan yang sama dengan Update dipakai melalui fungsi update
+3. BulkDelete :
+
+    - Relasi (gambar, varian, slug lama) dihapus melalui fungsi remove yang juga dipakai Delete
+    - File gambar dihapus setelah commit dan hanya untuk product yang benar-benar terhapus
+4. Mode atomic dan best_effort ditangani oleh bulk.Run, lihat pkg/bulk.
+*/
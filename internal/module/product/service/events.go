@@ -0,0 +1,74 @@
+package service                                // Mendefinisikan package service untuk modul product
+
+import (
+    "rest-api-go/internal/module/product/entity"  // Mengimpor entity product
+    "rest-api-go/pkg/events"                  // Mengimpor outbox domain event
+    "rest-api-go/pkg/money"                   // Package money untuk harga lama dan baru
+
+    "gorm.io/gorm"                            // Mengimpor ORM GORM
+)
+
+const (
+    EventProductCreated      = "product.created"        // Product baru disimpan
+    EventProductUpdated      = "product.updated"        // Field product berubah lewat update, bulk update, atau impor
+    EventProductPriceChanged = "product.price_changed"  // Harga atau mata uang berubah, dikirim bersama product.updated
+    EventProductDeleted      = "product.deleted"        // Product beserta gambar dan variannya dihapus
+
+    eventEntity = "product"                   // Nama entitas di event
+)
+
+type productData struct {                     // Mendefinisikan struct data event product, tanpa gambar dan varian yang tidak dimuat saat menyimpan
+    *entity.Product
+    Images   any `json:"images,omitempty"`   // Menutupi field Images, selalu kosong
+    Variants any `json:"variants,omitempty"`  // Menutupi field Variants, selalu kosong
+}
+
+type priceChangedData struct {                // Mendefinisikan struct data event product.price_changed
+    ProductID  uint        `json:"product_id"`  // ID product
+    CategoryID uint        `json:"category_id"` // Kategori product, untuk subscriber WebSocket per kategori
+    Before     money.Money `json:"before"`      // Harga sebelum perubahan
+    After      money.Money `json:"after"`       // Harga sesudah perubahan
+}
+
+type deletedData struct {                     // Mendefinisikan struct data event product.deleted
+    ID uint `json:"id"`                       // ID product yang dihapus
+}
+
+func recordCreated(tx *gorm.DB, product *entity.Product) error {  // Fungsi untuk mencatat product.created di transaksi tx
+    return events.Record(tx, EventProductCreated, eventEntity, product.ID, productData{Product: product})
+}
+
+func recordUpdated(tx *gorm.DB, before, after *entity.Product) error {  // Fungsi untuk mencatat product.updated dan product.price_changed jika harga berubah
+    if err := events.Record(tx, EventProductUpdated, eventEntity, after.ID, productData{Product: after}); err != nil {
+        return err
+    }
+    if before.Price == after.Price {
+        return nil
+    }
+    return events.Record(tx, EventProductPriceChanged, eventEntity, after.ID, priceChangedData{ProductID: after.ID, CategoryID: after.CategoryID, Before: before.Price, After: after.Price})
+}
+
+func recordDeleted(tx *gorm.DB, id uint) error {  // Fungsi untuk mencatat product.deleted di transaksi tx
+    return events.Record(tx, EventProductDeleted, eventEntity, id, deletedData{ID: id})
+}
+
+
+// {{{ Penjelasan Event Product }}}
+
+/*
+## Penjelasan Detail
+File events.go ini berisi domain event yang dicatat service product. Berikut penjelasan detailnya:
+
+1. Tipe Event :
+
+    - product.created : Create, bulk create, dan baris impor baru
+    - product.updated : Update, bulk update, dan baris impor yang sudah ada; data berisi product setelah perubahan
+    - product.price_changed : Dicatat setelah product.updated jika harga atau mata uang berbeda, data berisi product_id, category_id, before, dan after
+    - product.deleted : Delete dan bulk delete, data berisi ID product
+2. Transaksi :
+
+    - Semua event dicatat dengan events.Record di transaksi yang sama dengan perubahan, sehingga event dari item bulk atau baris impor yang di-rollback tidak pernah dikirim
+3. Data :
+
+    - Field product sama dengan respons API, tanpa images dan variants (diubah lewat endpoint sendiri)
+*/
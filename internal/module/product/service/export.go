@@ -0,0 +1,58 @@
+package service                                // Mendefinisikan package service untuk modul product
+
+import (
+    "context"                                 // Package untuk context request
+    "rest-api-go/internal/module/product/entity"  // Mengimpor entity product
+    "rest-api-go/pkg/tracing"                 // Mengimpor package tracing untuk span service
+
+    "gorm.io/gorm"                            // Mengimpor ORM GORM
+)
+
+const exportBatchSize = 500                   // Jumlah product yang dibaca per query saat ekspor
+
+// ExportColumns - kolom file ekspor, juga nama kolom yang dikenali saat impor
+var ExportColumns = []string{"id", "sku", "title", "slug", "description", "price", "currency", "category_id", "stock", "low_stock_threshold", "created_at", "updated_at"}
+
+func (s *ProductService) Export(ctx context.Context, filter entity.AttributeFilter, write func(row []any) error) error {  // Method untuk membaca product per batch dan menulis setiap baris dengan write
+    ctx, span := tracing.Start(ctx, "ProductService.Export")  // Membuat child span untuk method service
+    defer span.End()                          // Menutup span saat method selesai
+
+    query, err := s.filtered(s.db.WithContext(ctx), filter, nil)  // Filter yang sama dengan GetAll
+    if err != nil {
+        return err
+    }
+
+    var batch []entity.Product                // Hanya satu batch yang disimpan di memori
+    return query.FindInBatches(&batch, exportBatchSize, func(tx *gorm.DB, _ int) error {  // Diurutkan berdasarkan ID
+        for i := range batch {
+            if err := write(exportRow(&batch[i])); err != nil {
+                return err                    // Misal client memutus koneksi
+            }
+        }
+        return nil
+    }).Error
+}
+
+func exportRow(p *entity.Product) []any {     // Fungsi untuk mengubah product menjadi satu baris sesuai ExportColumns
+    return []any{p.ID, p.SKU, p.Title, p.Slug, p.Description, p.Price.String(), p.Price.Currency, p.CategoryID, p.Stock, p.LowStockThreshold, p.CreatedAt, p.UpdatedAt}
+}
+
+
+// {{{ Penjelasan Ekspor Product }}}
+
+/*
+## Penjelasan Detail
+File export.go ini berisi ekspor product. Berikut penjelasan detailnya:
+
+1. Export :
+
+    - Memakai filter atribut yang sama dengan GetAll (?attr[color]=Red)
+    - Product dibaca dengan FindInBatches per 500 baris, sehingga ekspor besar tidak dimuat sekaligus ke memori
+    - Setiap baris langsung diberikan ke write (handler menulisnya ke respons), format file ditentukan handler
+2. ExportColumns :
+
+    - id, sku, title, slug, description, price, currency, category_id, stock, low_stock_threshold, created_at, updated_at
+    - price ditulis sebagai string desimal (misal "12.50") agar tidak ada pembulatan
+    - Nama kolom yang sama dikenali saat impor, sehingga file ekspor dapat diedit lalu diimpor kembali
+    - Gambar dan varian tidak ikut diekspor
+*/
@@ -0,0 +1,68 @@
+package service                                // Mendefinisikan package service untuk modul product
+
+import (
+    "context"                                 // Package untuk context request dan job
+    "errors"                                  // Package untuk menggabungkan error
+    "log/slog"                                // Package structured logging bawaan Go
+    "rest-api-go/pkg/jobs"                    // Mengimpor runner job latar belakang
+    "rest-api-go/pkg/storage"                 // Mengimpor storage file gambar
+)
+
+const DeleteFilesJob = "product.delete_files" // Tipe job untuk menghapus file gambar dari storage
+
+type deleteFilesPayload struct {              // Mendefinisikan struct payload job DeleteFilesJob
+    Keys []string `json:"keys"`               // Key file di storage
+}
+
+// DeleteFiles - handler job DeleteFilesJob; file yang sudah tidak ada tidak dianggap error sehingga job aman dicoba ulang
+func DeleteFiles(store storage.Storage) jobs.Handler {
+    return func(ctx context.Context, job *jobs.Job) error {
+        var payload deleteFilesPayload
+        if err := job.Decode(&payload); err != nil {
+            return jobs.Permanent(err)        // Payload rusak tidak akan berhasil walau dicoba lagi
+        }
+        var errs []error
+        for _, key := range payload.Keys {
+            if err := store.Delete(ctx, key); err != nil {
+                errs = append(errs, err)
+            }
+        }
+        return errors.Join(errs...)           // Percobaan berikutnya menghapus ulang semua key, yang sudah terhapus dilewati storage
+    }
+}
+
+func removeFiles(ctx context.Context, runner *jobs.Runner, store storage.Storage, keys []string) {  // Fungsi untuk menghapus file di latar belakang dengan retry
+    if len(keys) == 0 {
+        return
+    }
+    ctx = context.WithoutCancel(ctx)          // Tetap berjalan walau request dibatalkan
+    if _, err := runner.Enqueue(ctx, DeleteFilesJob, deleteFilesPayload{Keys: keys}); err == nil {
+        return
+    } else {
+        slog.ErrorContext(ctx, "failed to enqueue file deletion, deleting now", "error", err)
+    }
+    for _, key := range keys {                // Tanpa antrean, file yang gagal dihapus hanya dicatat di log
+        if err := store.Delete(ctx, key); err != nil {
+            slog.ErrorContext(ctx, "failed to delete image file", "key", key, "error", err)
+        }
+    }
+}
+
+
+// {{{ Penjelasan Penghapusan File }}}
+
+/*
+## Penjelasan Detail
+File files.go ini berisi penghapusan file gambar product lewat job latar belakang. Berikut penjelasan detailnya:
+
+1. removeFiles :
+
+    - Dipanggil setelah commit (hapus product, hapus gambar) dan saat unggahan gagal di tengah jalan
+    - Menambahkan satu job product.delete_files berisi semua key, sehingga request tidak menunggu storage (misal S3)
+    - Jika job gagal dibuat (misal database tidak tersedia), file langsung dihapus seperti sebelumnya
+2. DeleteFiles :
+
+    - Handler job yang didaftarkan di bootstrap.go
+    - Jika storage gagal, job dicoba lagi dengan backoff; setelah percobaan habis job menjadi dead dan dapat di-retry lewat GET /api/jobs
+    - Storage.Delete tidak error untuk file yang sudah tidak ada, sehingga percobaan ulang aman
+*/
@@ -0,0 +1,353 @@
+package service                                // Mendefinisikan package service untuk modul product
+
+import (
+    "context"                                 // Package untuk context request
+    "crypto/rand"                             // Token acak untuk key file
+    "encoding/hex"                            // Encoding token acak
+    "errors"                                  // Package untuk pengecekan error
+    "fmt"                                     // Package untuk formatting key dan pesan
+    "net/http"                                // Package untuk konstanta HTTP
+    "rest-api-go/internal/module/product/entity"  // Mengimpor entity product
+    "rest-api-go/pkg/imaging"                 // Sniffing, decode, dan thumbnail gambar
+    "rest-api-go/pkg/jobs"                    // Antrean job untuk menghapus file
+    "rest-api-go/pkg/storage"                 // Penyimpanan file gambar
+    "rest-api-go/pkg/tracing"                 // Mengimpor package tracing untuk span service
+    "rest-api-go/pkg/utils"                   // Mengimpor utilitas aplikasi untuk HTTPError
+
+    "gorm.io/gorm"                            // Mengimpor ORM GORM
+    "gorm.io/gorm/clause"                     // Klausa FOR UPDATE
+)
+
+var (
+    ErrImageNotFound     = utils.NewHTTPError(http.StatusNotFound, "Image not found")  // Gambar tidak ada atau milik product lain
+    ErrImageUnsupported  = utils.NewHTTPError(http.StatusUnsupportedMediaType, imaging.ErrUnsupported.Error())  // Isi file bukan JPEG, PNG, atau GIF
+    ErrImageTooManyPixels = utils.NewHTTPError(http.StatusUnprocessableEntity, imaging.ErrTooManyPixels.Error())  // Dimensi gambar terlalu besar
+    ErrImageOrder        = utils.NewHTTPError(http.StatusBadRequest, "image_ids must list every image of the product exactly once")  // Urutan tidak lengkap atau ganda
+)
+
+type ImageService struct {                     // Mendefinisikan struct service gambar product
+    db    *gorm.DB                            // Dependency database
+    store storage.Storage                     // Tempat file gambar disimpan
+    opts  imaging.Options                     // Batas ukuran dan daftar thumbnail
+    jobs  *jobs.Runner                        // Antrean job untuk menghapus file
+}
+
+func NewImageService(db *gorm.DB, store storage.Storage, opts imaging.Options, runner *jobs.Runner) *ImageService {  // Constructor untuk service
+    return &ImageService{db, store, opts, runner}     // Mengembalikan instance service dengan dependency yang diinjeksi
+}
+
+func (s *ImageService) MaxBytes() int64 {      // Ukuran maksimum satu file, dipakai handler untuk membatasi pembacaan
+    return s.opts.MaxBytes
+}
+
+func (s *ImageService) Check(data []byte) error {  // Method untuk memeriksa ukuran, jenis, dan dimensi file tanpa menyimpannya
+    if s.opts.MaxBytes > 0 && int64(len(data)) > s.opts.MaxBytes {
+        return s.ErrTooLarge()
+    }
+    return imageError(imaging.Check(data))
+}
+
+func (s *ImageService) ErrTooLarge() error {   // Error 413 untuk file di atas batas ukuran
+    return utils.NewHTTPError(http.StatusRequestEntityTooLarge, fmt.Sprintf("image must not be larger than %d bytes", s.opts.MaxBytes))
+}
+
+func (s *ImageService) Upload(ctx context.Context, productID uint, data []byte) (*entity.ProductImage, error) {  // Method untuk mengunggah satu gambar product
+    ctx, span := tracing.Start(ctx, "ImageService.Upload")  // Membuat child span untuk method service
+    defer span.End()                          // Menutup span saat method selesai
+
+    if err := s.Check(data); err != nil {
+        return nil, err
+    }
+    if err := s.productExists(s.db.WithContext(ctx), productID); err != nil {  // Cek sebelum memproses gambar
+        return nil, err
+    }
+
+    contentType, ext, err := imaging.Sniff(data)  // Content type dari isi file, bukan dari client
+    if err != nil {
+        return nil, ErrImageUnsupported
+    }
+    img, err := imaging.Decode(data)
+    if err != nil {
+        return nil, imageError(err)
+    }
+
+    token, err := randomToken()
+    if err != nil {
+        return nil, err
+    }
+    prefix := fmt.Sprintf("products/%d/%s", productID, token)  // Folder unik per unggahan
+    bounds := img.Bounds()
+    image := entity.ProductImage{
+        ProductID:     productID,
+        Key:           prefix + "/original." + ext,
+        ThumbnailKeys: make(map[string]string, len(s.opts.Thumbnails)),
+        ContentType:   contentType,
+        Size:          int64(len(data)),
+        Width:         bounds.Dx(),
+        Height:        bounds.Dy(),
+    }
+
+    written := []string{}                     // File yang sudah ditulis, dihapus lagi jika proses gagal
+    cleanup := func() { removeFiles(ctx, s.jobs, s.store, written) }
+    if err := s.store.Put(ctx, image.Key, data, contentType); err != nil {
+        return nil, err
+    }
+    written = append(written, image.Key)
+
+    for _, size := range s.opts.Thumbnails {  // Satu file per ukuran thumbnail
+        thumb, thumbType, thumbExt, err := imaging.Encode(imaging.Thumbnail(img, size.Max), contentType)
+        if err != nil {
+            cleanup()
+            return nil, err
+        }
+        key := prefix + "/" + size.Name + "." + thumbExt
+        if err := s.store.Put(ctx, key, thumb, thumbType); err != nil {
+            cleanup()
+            return nil, err
+        }
+        written = append(written, key)
+        image.ThumbnailKeys[size.Name] = key
+    }
+
+    err = s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+        var product entity.Product
+        if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Select("id").First(&product, productID).Error; err != nil {  // Kunci product agar posisi tidak bentrok dengan unggahan lain
+            if errors.Is(err, gorm.ErrRecordNotFound) {
+                return ErrProductNotFound     // Product dihapus saat gambar diproses
+            }
+            return err
+        }
+        var count int64
+        if err := tx.Model(&entity.ProductImage{}).Where("product_id = ?", productID).Count(&count).Error; err != nil {
+            return err
+        }
+        image.Position = int(count)           // Gambar baru ditaruh paling akhir
+        image.IsPrimary = count == 0          // Gambar pertama otomatis menjadi gambar utama
+        return tx.Create(&image).Error
+    })
+    if err != nil {
+        cleanup()
+        return nil, err
+    }
+
+    image.ResolveURLs(s.store)
+    return &image, nil
+}
+
+func (s *ImageService) List(ctx context.Context, productID uint) ([]entity.ProductImage, error) {  // Method untuk mendapatkan gambar product sesuai urutan
+    ctx, span := tracing.Start(ctx, "ImageService.List")  // Membuat child span untuk method service
+    defer span.End()                          // Menutup span saat method selesai
+
+    db := s.db.WithContext(ctx)
+    if err := s.productExists(db, productID); err != nil {
+        return nil, err
+    }
+    images := []entity.ProductImage{}
+    if err := db.Where("product_id = ?", productID).Order("position, id").Find(&images).Error; err != nil {
+        return nil, err
+    }
+    for i := range images {
+        images[i].ResolveURLs(s.store)
+    }
+    return images, nil
+}
+
+func (s *ImageService) Reorder(ctx context.Context, productID uint, req *entity.ImageOrderRequest) ([]entity.ProductImage, error) {  // Method untuk mengurutkan ulang gambar product
+    ctx, span := tracing.Start(ctx, "ImageService.Reorder")  // Membuat child span untuk method service
+    defer span.End()                          // Menutup span saat method selesai
+
+    if err := req.Validate(); err != nil {    // Validasi body request
+        return nil, err
+    }
+
+    err := s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+        images, err := s.lockImages(tx, productID)
+        if err != nil {
+            return err
+        }
+        if len(req.ImageIDs) != len(images) {  // Harus berisi semua gambar
+            return ErrImageOrder
+        }
+        current := make(map[uint]bool, len(images))
+        for _, image := range images {
+            current[image.ID] = true
+        }
+        for position, id := range req.ImageIDs {
+            if !current[id] {                 // ID milik product lain, tidak ada, atau ganda
+                return ErrImageOrder
+            }
+            delete(current, id)
+            if err := tx.Model(&entity.ProductImage{}).Where("id = ?", id).Update("position", position).Error; err != nil {
+                return err
+            }
+        }
+        return nil
+    })
+    if err != nil {
+        return nil, err
+    }
+    return s.List(ctx, productID)
+}
+
+func (s *ImageService) SetPrimary(ctx context.Context, productID, imageID uint) ([]entity.ProductImage, error) {  // Method untuk memilih gambar utama
+    ctx, span := tracing.Start(ctx, "ImageService.SetPrimary")  // Membuat child span untuk method service
+    defer span.End()                          // Menutup span saat method selesai
+
+    err := s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+        images, err := s.lockImages(tx, productID)
+        if err != nil {
+            return err
+        }
+        if !containsImage(images, imageID) {
+            return ErrImageNotFound
+        }
+        if err := tx.Model(&entity.ProductImage{}).Where("product_id = ? AND id <> ?", productID, imageID).Update("is_primary", false).Error; err != nil {
+            return err
+        }
+        return tx.Model(&entity.ProductImage{}).Where("id = ?", imageID).Update("is_primary", true).Error
+    })
+    if err != nil {
+        return nil, err
+    }
+    return s.List(ctx, productID)
+}
+
+func (s *ImageService) Delete(ctx context.Context, productID, imageID uint) error {  // Method untuk menghapus satu gambar product
+    ctx, span := tracing.Start(ctx, "ImageService.Delete")  // Membuat child span untuk method service
+    defer span.End()                          // Menutup span saat method selesai
+
+    var removed entity.ProductImage
+    err := s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+        images, err := s.lockImages(tx, productID)
+        if err != nil {
+            return err
+        }
+        remaining := images[:0:0]
+        for _, image := range images {
+            if image.ID == imageID {
+                removed = image
+            } else {
+                remaining = append(remaining, image)
+            }
+        }
+        if removed.ID == 0 {
+            return ErrImageNotFound
+        }
+        if err := tx.Delete(&entity.ProductImage{}, imageID).Error; err != nil {
+            return err
+        }
+        for position, image := range remaining {  // Posisi dirapatkan kembali, gambar pertama menjadi utama jika yang dihapus adalah gambar utama
+            updates := map[string]interface{}{"position": position}
+            if removed.IsPrimary && position == 0 {
+                updates["is_primary"] = true
+            }
+            if err := tx.Model(&entity.ProductImage{}).Where("id = ?", image.ID).Updates(updates).Error; err != nil {
+                return err
+            }
+        }
+        return nil
+    })
+    if err != nil {
+        return err
+    }
+
+    removeFiles(ctx, s.jobs, s.store, imageKeys(removed))  // File dihapus setelah commit agar data tidak menunjuk file yang hilang
+    return nil
+}
+
+func (s *ImageService) productExists(db *gorm.DB, productID uint) error {  // Fungsi untuk memeriksa keberadaan product
+    var count int64
+    if err := db.Model(&entity.Product{}).Where("id = ?", productID).Count(&count).Error; err != nil {
+        return err
+    }
+    if count == 0 {
+        return ErrProductNotFound
+    }
+    return nil
+}
+
+func (s *ImageService) lockImages(tx *gorm.DB, productID uint) ([]entity.ProductImage, error) {  // Fungsi untuk mengunci product beserta gambarnya
+    var product entity.Product
+    if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Select("id").First(&product, productID).Error; err != nil {  // Kunci yang sama dengan Upload
+        if errors.Is(err, gorm.ErrRecordNotFound) {
+            return nil, ErrProductNotFound
+        }
+        return nil, err
+    }
+    var images []entity.ProductImage
+    err := tx.Where("product_id = ?", productID).Order("position, id").Find(&images).Error
+    return images, err
+}
+
+func imageError(err error) error {             // Fungsi untuk mengubah error package imaging menjadi HTTPError
+    switch {
+    case err == nil:
+        return nil
+    case errors.Is(err, imaging.ErrTooManyPixels):
+        return ErrImageTooManyPixels
+    default:
+        return ErrImageUnsupported
+    }
+}
+
+func containsImage(images []entity.ProductImage, id uint) bool {  // Fungsi untuk mencari ID gambar
+    for _, image := range images {
+        if image.ID == id {
+            return true
+        }
+    }
+    return false
+}
+
+func imageKeys(image entity.ProductImage) []string {  // Fungsi untuk mengumpulkan key file asli dan seluruh thumbnail
+    keys := []string{image.Key}
+    for _, key := range image.ThumbnailKeys {
+        keys = append(keys, key)
+    }
+    return keys
+}
+
+func randomToken() (string, error) {           // Fungsi untuk membuat token acak 16 karakter hex
+    buf := make([]byte, 8)
+    if _, err := rand.Read(buf); err != nil {
+        return "", err
+    }
+    return hex.EncodeToString(buf), nil
+}
+
+
+// {{{ Penjelasan Fungsi ImageService }}}
+
+/*
+## Penjelasan Detail
+File image.go ini berisi logika bisnis untuk gambar product. Berikut penjelasan detailnya:
+
+1. Check dan Upload :
+
+    - Check dipanggil handler untuk semua file dalam satu request terlebih dahulu, sehingga satu file yang ditolak tidak menyisakan sebagian unggahan
+    - File di atas MAX_IMAGE_BYTES ditolak dengan 413
+
+    - Content type ditentukan dari isi file (Sniff), sehingga file .jpg yang sebenarnya bukan gambar ditolak dengan 415
+    - Dimensi diperiksa sebelum decode penuh untuk mencegah decompression bomb (422)
+    - File asli dan setiap thumbnail disimpan di products/<id>/<token acak>/, misal products/1/9f2c.../small.jpg
+    - Baris database dibuat dalam transaksi yang mengunci product, sehingga Position selalu berurutan walau ada unggahan bersamaan
+    - Gambar pertama product otomatis menjadi gambar utama
+    - Jika penyimpanan atau transaksi gagal, file yang sudah ditulis dihapus kembali
+2. Reorder :
+
+    - Body harus berisi semua ID gambar product tepat satu kali, jika tidak 400
+    - Position diisi sesuai urutan di body, dimulai dari 0
+3. SetPrimary :
+
+    - Hanya satu gambar utama per product; gambar lain otomatis bukan utama
+4. Delete :
+
+    - Posisi gambar lain dirapatkan kembali
+    - Jika gambar utama dihapus, gambar pertama yang tersisa menjadi gambar utama
+    - File dihapus dari storage setelah transaksi commit; penghapusan dilakukan job product.delete_files (files.go) dengan retry
+5. Penanganan Error :
+
+    - ErrImageNotFound 404 juga dipakai jika gambar milik product lain
+    - ErrProductNotFound 404 jika product tidak ada
+Service ini tidak mengenal HTTP multipart; handler membaca file lalu mengirim isinya sebagai []byte.
+*/
@@ -0,0 +1,419 @@
+package service                                // Mendefinisikan package service untuk modul product
+
+import (
+    "context"                                 // Package untuk context request
+    "errors"                                  // Package untuk pengecekan error
+    "fmt"                                     // Package untuk formatting pesan error
+    "io"                                      // Package untuk membaca file impor
+    "net/http"                                // Package untuk konstanta HTTP
+    "rest-api-go/internal/module/product/entity"  // Mengimpor entity product
+    "rest-api-go/pkg/bulk"                    // Package bulk untuk mode atomic dan best_effort
+    "rest-api-go/pkg/money"                   // Package money untuk parsing harga
+    "rest-api-go/pkg/tabular"                 // Package tabular untuk membaca CSV dan JSON Lines
+    "rest-api-go/pkg/tracing"                 // Mengimpor package tracing untuk span service
+    "rest-api-go/pkg/utils"                   // Mengimpor utilitas aplikasi untuk HTTPError
+    "rest-api-go/pkg/validation"              // Package validation untuk pesan error per field
+    "sort"                                    // Package untuk mengurutkan kolom
+    "strconv"                                 // Package untuk parsing angka
+    "strings"                                 // Package untuk manipulasi string
+
+    "gorm.io/gorm"                            // Mengimpor ORM GORM
+)
+
+const (
+    MaxImportErrors    = 10000                // Jumlah error yang disimpan per impor
+    importErrorPreview = 100                  // Jumlah error yang ikut dikirim di respons impor
+)
+
+var ErrImportNotFound = utils.NewHTTPError(http.StatusNotFound, "Import not found")  // Laporan impor tidak ada
+
+var errNotCommitted = errors.New("import: not committed")  // Penanda rollback untuk dry run dan mode atomic, tidak pernah dikirim ke client
+
+// ImportErrorColumns - kolom laporan error yang dapat diunduh
+var ImportErrorColumns = []string{"row", "column", "value", "error"}
+
+var importColumns = map[string]bool{          // Kolom product yang dapat diisi dari file impor
+    "id": true, "sku": true, "title": true, "slug": true, "description": true,
+    "price": true, "currency": true, "category_id": true, "low_stock_threshold": true,
+}
+
+var readOnlyColumns = map[string]bool{"stock": true, "created_at": true, "updated_at": true}  // Kolom ekspor yang diabaikan saat impor
+
+type ImportOptions struct {                   // Mendefinisikan struct opsi impor
+    Format  tabular.Format                    // csv atau jsonl
+    Key     string                            // Kolom pencocokan product yang sudah ada: sku (default) atau id
+    Mode    bulk.Mode                         // atomic (default) atau best_effort
+    DryRun  bool                              // Hanya validasi, semua perubahan di-rollback
+    Mapping map[string]string                 // Nama kolom di file -> kolom product, kolom product kosong berarti diabaikan
+    Locale  string                            // Bahasa pesan validasi
+}
+
+type importField struct {                     // Mendefinisikan struct satu sel yang dikenali
+    Source string                             // Nama kolom di file
+    Value  string                             // Nilai sel tanpa spasi di awal dan akhir
+}
+
+type columnError struct {                     // Mendefinisikan struct error untuk satu kolom product
+    column  string                            // Nama kolom product
+    message string                            // Pesan error
+}
+
+func (e *columnError) Error() string {
+    return e.message
+}
+
+func (s *ProductService) Import(ctx context.Context, r io.Reader, opts ImportOptions) (*entity.ProductImport, error) {  // Method untuk mengimpor product dari CSV atau JSON Lines
+    ctx, span := tracing.Start(ctx, "ProductService.Import")  // Membuat child span untuk method service
+    defer span.End()                          // Menutup span saat method selesai
+
+    if err := opts.normalize(); err != nil {
+        return nil, err
+    }
+    reader, err := tabular.NewReader(r, opts.Format)  // Header CSV dibaca di sini
+    if err != nil {
+        return nil, err
+    }
+
+    result := &entity.ProductImport{Format: string(opts.Format), Key: opts.Key, Mode: string(opts.Mode), DryRun: opts.DryRun}
+    var rowErrors []entity.ProductImportError  // Dibatasi MaxImportErrors
+    fail := func(errs []entity.ProductImportError) {
+        result.Failed++
+        for _, e := range errs {
+            if len(rowErrors) >= MaxImportErrors {
+                result.ErrorsTruncated = true
+                return
+            }
+            rowErrors = append(rowErrors, e)
+        }
+    }
+
+    ignored := map[string]bool{}              // Kolom file yang tidak dikenal
+    err = s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+        for {
+            record, err := reader.Read()      // Satu baris per iterasi, file tidak dimuat sekaligus
+            if errors.Is(err, io.EOF) {
+                break
+            }
+            var rowErr *tabular.RowError
+            if errors.As(err, &rowErr) {      // Baris rusak dicatat lalu lanjut ke baris berikutnya
+                result.Total++
+                fail([]entity.ProductImportError{{Row: rowErr.Row, Message: rowErr.Err.Error()}})
+                continue
+            }
+            if err != nil {
+                return err                    // Body terputus atau terlalu besar, seluruh impor gagal
+            }
+
+            result.Total++
+            fields := opts.fields(record, ignored)
+            var created bool
+            err = tx.Transaction(func(tx *gorm.DB) (err error) {  // SAVEPOINT per baris, sama seperti bulk.Item
+                created, err = s.importRow(tx, fields, &opts)
+                return err
+            })
+            switch {
+            case err != nil:
+                fail(describeRow(record.Row, fields, err, &opts))
+            case created:
+                result.Created++
+            default:
+                result.Updated++
+            }
+        }
+        if opts.DryRun || (opts.Mode == bulk.Atomic && result.Failed > 0) {
+            return errNotCommitted            // Rollback, laporan tetap disimpan di bawah
+        }
+        return nil
+    })
+    if err != nil && !errors.Is(err, errNotCommitted) {
+        return nil, err
+    }
+    result.Committed = err == nil
+
+    result.IgnoredColumns = make([]string, 0, len(ignored))
+    for column := range ignored {
+        result.IgnoredColumns = append(result.IgnoredColumns, column)
+    }
+    sort.Strings(result.IgnoredColumns)
+
+    err = s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {  // Laporan disimpan terpisah agar tetap ada setelah rollback
+        if err := tx.Omit("Errors").Create(result).Error; err != nil {
+            return err
+        }
+        if len(rowErrors) == 0 {
+            return nil
+        }
+        for i := range rowErrors {
+            rowErrors[i].ImportID = result.ID
+        }
+        return tx.CreateInBatches(rowErrors, bulk.BatchSize).Error
+    })
+    if err != nil {
+        return nil, err
+    }
+    result.Errors = rowErrors[:min(len(rowErrors), importErrorPreview)]
+    return result, nil
+}
+
+func (s *ProductService) GetImport(ctx context.Context, id uint) (*entity.ProductImport, error) {  // Method untuk mendapatkan ringkasan impor
+    ctx, span := tracing.Start(ctx, "ProductService.GetImport")  // Membuat child span untuk method service
+    defer span.End()                          // Menutup span saat method selesai
+
+    var result entity.ProductImport
+    if err := s.db.WithContext(ctx).First(&result, id).Error; err != nil {
+        if errors.Is(err, gorm.ErrRecordNotFound) {
+            return nil, ErrImportNotFound
+        }
+        return nil, err
+    }
+    return &result, nil
+}
+
+func (s *ProductService) ImportErrors(ctx context.Context, id uint, write func(row []any) error) error {  // Method untuk membaca laporan error impor per batch sesuai ImportErrorColumns
+    ctx, span := tracing.Start(ctx, "ProductService.ImportErrors")  // Membuat child span untuk method service
+    defer span.End()                          // Menutup span saat method selesai
+
+    if _, err := s.GetImport(ctx, id); err != nil {
+        return err
+    }
+    var batch []entity.ProductImportError
+    return s.db.WithContext(ctx).Where("import_id = ?", id).FindInBatches(&batch, exportBatchSize, func(tx *gorm.DB, _ int) error {  // Urutan sama dengan urutan baris di file
+        for _, e := range batch {
+            if err := write([]any{e.Row, e.Column, e.Value, e.Message}); err != nil {
+                return err
+            }
+        }
+        return nil
+    }).Error
+}
+
+func (s *ProductService) importRow(tx *gorm.DB, fields map[string]importField, opts *ImportOptions) (bool, error) {  // Fungsi untuk membuat atau memperbarui product dari satu baris, mengembalikan true jika product baru
+    var product entity.Product
+    found := false
+    switch opts.Key {
+    case "id":
+        if value := fields["id"].Value; value != "" {  // id kosong berarti product baru
+            id, err := strconv.ParseUint(value, 10, 32)
+            if err != nil || id == 0 {
+                return false, &columnError{"id", "id must be a positive integer"}
+            }
+            if err := tx.First(&product, id).Error; err != nil {
+                if errors.Is(err, gorm.ErrRecordNotFound) {
+                    return false, &columnError{"id", ErrProductNotFound.Message}
+                }
+                return false, err
+            }
+            found = true
+        }
+    default:
+        sku := fields["sku"].Value
+        if sku == "" {
+            return false, &columnError{"sku", "sku is required to match products by sku"}
+        }
+        err := tx.Where("sku = ?", sku).First(&product).Error
+        if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+            return false, err
+        }
+        found = err == nil
+    }
+
+    if err := applyFields(&product, fields, found); err != nil {
+        return false, err
+    }
+    if err := product.Validate(); err != nil {
+        return false, err
+    }
+    if found {                                // Kolom yang tidak ada di file tetap bernilai lama
+        if fields["slug"].Value == "" {       // Slug dibuat ulang jika judul berubah, sama seperti update tanpa slug
+            product.Slug = ""
+        }
+        return false, s.update(tx, &product)
+    }
+
+    product.ID = 0                            // ID selalu dibuat database
+    if err := s.checkNew(tx, &product, bulk.Claims{}); err != nil {  // Baris sebelumnya sudah tersimpan di transaksi, sehingga claims tidak diperlukan
+        return false, err
+    }
+    if err := tx.Create(&product).Error; err != nil {
+        return false, err
+    }
+    return true, recordCreated(tx, &product)
+}
+
+func applyFields(product *entity.Product, fields map[string]importField, found bool) error {  // Fungsi untuk menyalin sel ke field product
+    if f, ok := fields["title"]; ok {
+        product.Title = f.Value
+    }
+    if f, ok := fields["slug"]; ok {
+        product.Slug = f.Value
+    }
+    if f, ok := fields["description"]; ok {
+        product.Description = f.Value
+    }
+    if f, ok := fields["sku"]; ok {
+        product.SKU = nil                     // Sel kosong menghapus SKU
+        if f.Value != "" {
+            sku := f.Value
+            product.SKU = &sku
+        }
+    }
+    if f, ok := fields["category_id"]; ok {
+        id, err := strconv.ParseUint(f.Value, 10, 32)
+        if err != nil && f.Value != "" {
+            return &columnError{"category_id", "category_id must be a positive integer"}
+        }
+        product.CategoryID = uint(id)         // Kosong menjadi 0 dan ditolak oleh validasi required
+    }
+    if f, ok := fields["low_stock_threshold"]; ok {
+        threshold, err := strconv.ParseInt(f.Value, 10, 64)
+        if err != nil && f.Value != "" {
+            return &columnError{"low_stock_threshold", "low_stock_threshold must be an integer"}
+        }
+        product.LowStockThreshold = threshold
+    }
+
+    amount, hasAmount := fields["price"]
+    currency, hasCurrency := fields["currency"]
+    if !hasAmount && !found {
+        return &columnError{"price", "price is required for new products"}
+    }
+    if hasAmount || hasCurrency {
+        value := product.Price.String()       // Harga lama jika hanya mata uang yang dikirim
+        if hasAmount {
+            value = amount.Value
+        }
+        code := currency.Value
+        if code == "" {
+            code = product.Price.Currency     // Mata uang lama, atau default untuk product baru
+        }
+        if code == "" {
+            code = money.DefaultCurrency
+        }
+        price, err := money.Parse(value, code)
+        if err != nil {
+            column := "price"
+            if errors.Is(err, money.ErrUnknownCurrency) {
+                column = "currency"
+            }
+            return &columnError{column, err.Error()}
+        }
+        product.Price = price
+    }
+    return nil
+}
+
+func describeRow(row int, fields map[string]importField, err error, opts *ImportOptions) []entity.ProductImportError {  // Fungsi untuk mengubah error satu baris menjadi baris laporan
+    cell := func(column, message string) entity.ProductImportError {
+        f, ok := fields[column]
+        if !ok {
+            f.Source = column                 // Kolom tidak ada di file, tampilkan nama kolom product
+        }
+        return entity.ProductImportError{Row: row, Column: f.Source, Value: f.Value, Message: message}
+    }
+
+    var colErr *columnError
+    if errors.As(err, &colErr) {
+        return []entity.ProductImportError{cell(colErr.column, colErr.message)}
+    }
+    if messages, ok := validation.Translate(err, opts.Locale); ok {  // Satu baris laporan per field yang tidak valid
+        columns := make([]string, 0, len(messages))
+        for column := range messages {
+            columns = append(columns, column)
+        }
+        sort.Strings(columns)
+        errs := make([]entity.ProductImportError, 0, len(columns))
+        for _, column := range columns {
+            errs = append(errs, cell(column, messages[column]))
+        }
+        return errs
+    }
+
+    _, message, _ := utils.Describe(err, http.StatusInternalServerError, opts.Locale)
+    switch {                                  // Konflik ditunjukkan pada kolomnya
+    case errors.Is(err, ErrSKUTaken):
+        return []entity.ProductImportError{cell("sku", message)}
+    case errors.Is(err, ErrSlugTaken):
+        return []entity.ProductImportError{cell("slug", message)}
+    }
+    return []entity.ProductImportError{{Row: row, Message: message}}
+}
+
+func (o *ImportOptions) normalize() error {   // Method untuk mengisi default dan memeriksa opsi impor
+    if o.Key == "" {
+        o.Key = "sku"
+    }
+    if o.Key != "sku" && o.Key != "id" {
+        return utils.NewHTTPError(http.StatusBadRequest, "key must be sku or id")
+    }
+    if o.Mode == "" {
+        o.Mode = bulk.Atomic
+    }
+    if o.Mode != bulk.Atomic && o.Mode != bulk.BestEffort {
+        return utils.NewHTTPError(http.StatusBadRequest, "mode must be atomic or best_effort")
+    }
+    targets := map[string]string{}            // Kolom product -> nama kolom di file, untuk menolak pemetaan ganda
+    for source, column := range o.Mapping {
+        column = strings.ToLower(strings.TrimSpace(column))
+        o.Mapping[source] = column
+        if column == "" {
+            continue                          // Kolom sengaja diabaikan
+        }
+        if !importColumns[column] {
+            return utils.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("map[%s]: %q is not an importable product column", source, column))
+        }
+        if other, ok := targets[column]; ok {
+            return utils.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("map[%s] and map[%s] both map to %q", other, source, column))
+        }
+        targets[column] = source
+    }
+    return nil
+}
+
+func (o *ImportOptions) fields(record tabular.Record, ignored map[string]bool) map[string]importField {  // Method untuk mencocokkan kolom file dengan kolom product
+    fields := make(map[string]importField, len(record.Values))
+    for source, value := range record.Values {
+        column, mapped := o.Mapping[source]
+        if !mapped {
+            column = strings.ToLower(strings.TrimSpace(source))  // Tanpa pemetaan, nama kolom dicocokkan tanpa membedakan huruf besar
+        }
+        switch {
+        case mapped && column == "":          // Diabaikan lewat pemetaan
+        case importColumns[column]:
+            fields[column] = importField{Source: source, Value: strings.TrimSpace(value)}
+        case readOnlyColumns[column]:         // Stok hanya berubah lewat stock movement, waktu diatur database
+        default:
+            ignored[source] = true
+        }
+    }
+    return fields
+}
+
+
+// {{{ Penjelasan Impor Product }}}
+
+/*
+## Penjelasan Detail
+File import.go ini berisi impor product dari CSV atau JSON Lines. Berikut penjelasan detailnya:
+
+1. Alur Import :
+
+    - File dibaca baris per baris dengan pkg/tabular, sehingga file besar tidak dimuat sekaligus ke memori
+    - Semua baris diproses dalam satu transaksi, setiap baris di dalam SAVEPOINT sendiri
+    - Baris yang gagal dicatat dengan nomor baris, kolom, nilai sel, dan pesan; baris berikutnya tetap diproses
+    - Dry run dan mode atomic dengan baris yang gagal di-rollback di akhir, sehingga validasi dry run sama persis dengan impor sungguhan (termasuk SKU dan slug yang bentrok dengan baris sebelumnya)
+    - Laporan (ProductImport dan ProductImportError) disimpan setelah transaksi selesai, termasuk untuk dry run
+2. Pencocokan (key) :
+
+    - sku (default) : Baris dengan SKU yang sudah ada memperbarui product itu, SKU baru membuat product baru, SKU kosong ditolak
+    - id : Baris dengan id memperbarui product itu (404 jika tidak ada), id kosong membuat product baru
+3. Kolom :
+
+    - Nama kolom sama dengan ekspor (ExportColumns) dan dicocokkan tanpa membedakan huruf besar
+    - Mapping (?map[Product Name]=title) memetakan nama kolom file ke kolom product, target kosong berarti kolom diabaikan
+    - stock, created_at, updated_at diabaikan sehingga file ekspor dapat diimpor kembali
+    - Kolom lain dilaporkan di IgnoredColumns
+    - Pada update hanya kolom yang ada di file yang berubah; slug kosong berarti slug dibuat ulang jika judul berubah
+    - price wajib untuk product baru; currency kosong memakai mata uang lama atau DEFAULT_CURRENCY
+4. Aturan bisnis sama dengan Create dan Update melalui checkNew dan update.
+5. GetImport dan ImportErrors : Ringkasan impor dan laporan error lengkap untuk diunduh (ImportErrorColumns).
+*/
@@ -1,66 +1,466 @@
 package service                                // Mendefinisikan package service untuk modul product
 
 import (
+    "context"                                 // Package untuk context request
+    "errors"                                  // Package untuk pengecekan error
+    "fmt"                                     // Package untuk formatting pesan error
+    "encoding/json"                           // Package untuk key cache filter atribut
+    "net/http"                                // Package untuk konstanta HTTP
+    "rest-api-go/pkg/bulk"                    // Package bulk untuk pemeriksaan keunikan dalam satu request
+    "rest-api-go/pkg/cache"                   // Package cache untuk hasil GetByID dan GetAll
+    "rest-api-go/pkg/fieldset"                // Package fieldset untuk ?fields= dan ?include=
+    "rest-api-go/pkg/jobs"                    // Package jobs untuk menghapus file gambar di latar belakang
+    "rest-api-go/pkg/slug"                    // Package slug untuk keunikan dan riwayat slug
+    "rest-api-go/pkg/storage"                 // Package storage untuk URL dan penghapusan file gambar
+    "rest-api-go/pkg/tracing"                 // Mengimpor package tracing untuk span service
+    "rest-api-go/pkg/utils"                   // Mengimpor utilitas aplikasi untuk HTTPError
+    attributeService "rest-api-go/internal/module/attribute/service"  // Mengimpor service attribute untuk filter atribut
     "rest-api-go/internal/module/product/entity"  // Mengimpor entity product
     "gorm.io/gorm"                            // Mengimpor ORM GORM
 )
 
+const slugScope = "products"                  // Nama tabel product untuk package slug
+
+const cacheNamespace = "products"             // Namespace cache product, diinvalidasi oleh plugin cache dan event product.*
+
+var (
+    ErrProductNotFound = utils.NewHTTPError(http.StatusNotFound, "Product not found")  // Product tidak ada
+    ErrSlugTaken       = utils.NewHTTPError(http.StatusConflict, "slug is already in use")  // Slug manual sudah dipakai product lain
+    ErrUnknownCategory = utils.NewHTTPError(http.StatusUnprocessableEntity, "category_id does not refer to an existing category")  // Kategori product tidak ada
+)
+
+const maxCategoryDepth = 32                   // Sama dengan batas kedalaman pohon di modul category
+
+// Fields - field dan relasi product yang boleh dipilih client lewat ?fields= dan ?include=
+var Fields = &fieldset.Schema{
+    Fields: map[string][]string{
+        "id":                  {"id"},
+        "title":               {"title"},
+        "slug":                {"slug"},
+        "price":               {"price", "currency"},
+        "description":         {"description"},
+        "category_id":         {"category_id"},
+        "sku":                 {"sku"},
+        "stock":               {"stock"},
+        "low_stock_threshold": {"low_stock_threshold"},
+        "images":              nil,           // Dimuat dengan preload, tidak dimuat jika tidak diminta
+        "variants":            nil,
+        "created_at":          {"created_at"},
+        "updated_at":          {"updated_at"},
+    },
+    Always:   []string{"id"},                 // Dibutuhkan untuk preload gambar dan varian
+    Includes: map[string][]string{"category": {"category_id"}},
+}
+
 type ProductService struct {                   // Mendefinisikan struct service
-    db *gorm.DB                               // Dependency database
+    db         *gorm.DB                       // Dependency database
+    store      storage.Storage                // Storage gambar product
+    attributes *attributeService.AttributeService  // Definisi atribut untuk filter varian
+    jobs       *jobs.Runner                   // Antrean job untuk menghapus file gambar
+    cache      *cache.Cache                   // Cache hasil baca, nil jika CACHE_DRIVER=none
 }
 
-func NewProductService(db *gorm.DB) *ProductService {  // Constructor untuk service
-    return &ProductService{db}                // Mengembalikan instance service dengan database yang diinjeksi
+func NewProductService(db *gorm.DB, store storage.Storage, attributes *attributeService.AttributeService, runner *jobs.Runner, responseCache *cache.Cache) *ProductService {  // Constructor untuk service
+    return &ProductService{db, store, attributes, runner, responseCache}  // Mengembalikan instance service dengan dependency yang diinjeksi
 }
 
-func (s *ProductService) Create(product *entity.Product) error {  // Method untuk membuat product baru
+func (s *ProductService) Create(ctx context.Context, product *entity.Product) error {  // Method untuk membuat product baru
+    ctx, span := tracing.Start(ctx, "ProductService.Create")  // Membuat child span untuk method service
+    defer span.End()                          // Menutup span saat method selesai
+
     if err := product.Validate(); err != nil {  // Validasi data product
         return err                            // Mengembalikan error jika validasi gagal
     }
     
-    // Verify that the category exists
-    var count int64                           // Variabel untuk menampung jumlah kategori
-    if err := s.db.Model(&entity.Product{}).Where("id = ?", product.CategoryID).Count(&count).Error; err != nil {  // Memeriksa apakah kategori ada
-        return err                            // Mengembalikan error jika query gagal
-    }
-    
-    return s.db.Create(product).Error         // Menyimpan product ke database dan mengembalikan error jika ada
+    product.Stock = 0                         // Stok awal selalu 0, stok hanya bertambah melalui stock movement
+    product.Images = nil                      // Gambar hanya ditambahkan melalui endpoint unggah
+    product.Variants = nil                    // Varian hanya ditambahkan melalui endpoint varian
+
+    return s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+        if err := s.checkNew(tx, product, bulk.Claims{}); err != nil {  // Kategori harus ada, SKU dan slug harus unik
+            return err
+        }
+        if err := tx.Create(product).Error; err != nil {  // Menyimpan product ke database dan mengembalikan error jika ada
+            return err
+        }
+        return recordCreated(tx, product)     // Event product.created hanya ada jika product ikut di-commit
+    })
 }
 
-func (s *ProductService) GetByID(id uint) (*entity.Product, error) {  // Method untuk mendapatkan product berdasarkan ID
-    var product entity.Product                // Variabel untuk menampung hasil query
-    err := s.db.First(&product, id).Error     // Query product berdasarkan ID
-    return &product, err                      // Mengembalikan product dan error jika ada
+func (s *ProductService) GetByID(ctx context.Context, id uint, sel fieldset.Selection) (*entity.Product, error) {  // Method untuk mendapatkan product berdasarkan ID
+    ctx, span := tracing.Start(ctx, "ProductService.GetByID")  // Membuat child span untuk method service
+    defer span.End()                          // Menutup span saat method selesai
+
+    return cache.Load(ctx, s.cache, cacheNamespace, cacheKey(fmt.Sprintf("id:%d", id), sel), func(ctx context.Context) (*entity.Product, error) {  // Query hanya dijalankan saat cache miss
+        db := s.db.WithContext(ctx)
+        var product entity.Product            // Variabel untuk menampung hasil query
+        if err := withRelations(db, sel).First(&product, id).Error; err != nil {  // Query product berdasarkan ID beserta gambar dan variannya
+            return nil, err
+        }
+        return &product, s.resolve(db, sel, &product)  // Mengembalikan product dan error jika ada
+    })
 }
 
-func (s *ProductService) GetAll() ([]entity.Product, error) {  // Method untuk mendapatkan semua product
-    var products []entity.Product             // Variabel untuk menampung hasil query
-    err := s.db.Find(&products).Error         // Query semua product
-    return products, err                      // Mengembalikan products dan error jika ada
+func (s *ProductService) GetAll(ctx context.Context, filter entity.AttributeFilter, sel fieldset.Selection) ([]entity.Product, error) {  // Method untuk mendapatkan semua product, filter atribut opsional
+    ctx, span := tracing.Start(ctx, "ProductService.GetAll")  // Membuat child span untuk method service
+    defer span.End()                          // Menutup span saat method selesai
+
+    key := "all"
+    if len(filter) > 0 {
+        raw, err := json.Marshal(filter)      // Key map diurutkan oleh encoding/json, sehingga filter yang sama selalu menghasilkan key yang sama
+        if err != nil {
+            return nil, err
+        }
+        key += ":" + string(raw)
+    }
+    return cache.Load(ctx, s.cache, cacheNamespace, cacheKey(key, sel), func(ctx context.Context) ([]entity.Product, error) {
+        return s.find(s.db.WithContext(ctx), filter, nil, sel)  // Query semua product beserta gambar dan variannya
+    })
 }
 
-func (s *ProductService) Update(product *entity.Product) error {  // Method untuk memperbarui product
+func (s *ProductService) Update(ctx context.Context, product *entity.Product) error {  // Method untuk memperbarui product
+    ctx, span := tracing.Start(ctx, "ProductService.Update")  // Membuat child span untuk method service
+    defer span.End()                          // Menutup span saat method selesai
+
     if err := product.Validate(); err != nil {  // Validasi data product
         return err                            // Mengembalikan error jika validasi gagal
     }
 
+    return s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+        return s.update(tx, product)
+    })
+}
+
+func (s *ProductService) GetBySlug(ctx context.Context, value string, sel fieldset.Selection) (*entity.Product, string, error) {  // Method untuk mendapatkan product berdasarkan slug, mengembalikan slug terbaru jika value adalah slug lama
+    ctx, span := tracing.Start(ctx, "ProductService.GetBySlug")  // Membuat child span untuk method service
+    defer span.End()                          // Menutup span saat method selesai
+
+    db := s.db.WithContext(ctx)
+    var product entity.Product
+    err := withRelations(db, sel).Where("slug = ?", value).First(&product).Error  // Slug yang sedang dipakai
+    if err == nil {
+        return &product, "", s.resolve(db, sel, &product)
+    }
+    if !errors.Is(err, gorm.ErrRecordNotFound) {
+        return nil, "", err
+    }
+
+    id, found, err := slug.Resolve(db, slugScope, value)  // Slug lama
+    if err != nil {
+        return nil, "", err
+    }
+    if !found {
+        return nil, "", ErrProductNotFound
+    }
+    if err := db.Select("id", "slug").First(&product, id).Error; err != nil {
+        if errors.Is(err, gorm.ErrRecordNotFound) {
+            return nil, "", ErrProductNotFound
+        }
+        return nil, "", err
+    }
+    return nil, product.Slug, nil
+}
+
+func (s *ProductService) Delete(ctx context.Context, id uint) error {  // Method untuk menghapus product
+    ctx, span := tracing.Start(ctx, "ProductService.Delete")  // Membuat child span untuk method service
+    defer span.End()                          // Menutup span saat method selesai
+
+    var images []entity.ProductImage          // Gambar product, filenya dihapus setelah commit
+    err := s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) (err error) {
+        images, err = s.remove(tx, id)
+        return err
+    })
+    if err != nil {
+        return err
+    }
+    s.deleteFiles(ctx, images)
+    return nil
+}
+
+func (s *ProductService) GetByCategoryID(ctx context.Context, categoryID uint, includeDescendants bool, filter entity.AttributeFilter, sel fieldset.Selection) ([]entity.Product, error) {  // Method untuk mendapatkan product berdasarkan CategoryID
+    ctx, span := tracing.Start(ctx, "ProductService.GetByCategoryID")  // Membuat child span untuk method service
+    defer span.End()                          // Menutup span saat method selesai
+
+    categoryIDs := []uint{categoryID}
+    if includeDescendants {                   // Sertakan product dari seluruh sub-kategori
+        var err error
+        if categoryIDs, err = s.subtreeIDs(s.db.WithContext(ctx), categoryID); err != nil {
+            return nil, err
+        }
+    }
+
+    return s.find(s.db.WithContext(ctx), filter, categoryIDs, sel)  // Query product berdasarkan CategoryID
+}
+
+func (s *ProductService) checkNew(tx *gorm.DB, product *entity.Product, claims bulk.Claims) error {  // Fungsi untuk memeriksa kategori, SKU, dan slug product baru, termasuk terhadap item lain dalam request bulk
+    if err := checkCategory(tx, product.CategoryID); err != nil {
+        return err
+    }
+    if err := checkSKU(tx, product); err != nil {  // SKU product dan varian berbagi satu ruang nama
+        return err
+    }
+    if product.SKU != nil && claims.Has("sku", *product.SKU) {
+        return ErrSKUTaken
+    }
+
+    if product.Slug != "" {                   // Slug manual harus unik
+        ok, err := slug.Available(tx, slugScope, 0, product.Slug)
+        if err != nil {
+            return err
+        }
+        if !ok || claims.Has("slug", product.Slug) {
+            return ErrSlugTaken
+        }
+    } else {                                  // Slug dibuat dari judul, melewati slug item lain yang belum disimpan
+        generated, err := slug.GenerateExcept(tx, slugScope, 0, product.Title, "product", func(s string) bool { return claims.Has("slug", s) })
+        if err != nil {
+            return err
+        }
+        product.Slug = generated
+    }
+
+    if product.SKU != nil {
+        claims.Claim("sku", *product.SKU)
+    }
+    claims.Claim("slug", product.Slug)
+    return nil
+}
+
+func (s *ProductService) update(tx *gorm.DB, product *entity.Product) error {  // Fungsi untuk menyimpan perubahan product di dalam transaksi
     // Cek apakah product ada
     var existingProduct entity.Product        // Variabel untuk menampung hasil query
-    if err := s.db.First(&existingProduct, product.ID).Error; err != nil {  // Query product berdasarkan ID
+    if err := tx.First(&existingProduct, product.ID).Error; err != nil {  // Query product berdasarkan ID
         return err                            // Mengembalikan error jika product tidak ditemukan
     }
+    product.Stock = existingProduct.Stock     // Stok tidak dapat diubah lewat update product
+    product.Images = nil                      // Gambar diubah melalui endpoint gambar, bukan update product
+    product.Variants = nil                    // Varian diubah melalui endpoint varian, bukan update product
+    if err := checkCategory(tx, product.CategoryID); err != nil {
+        return err
+    }
+    if err := checkSKU(tx, product); err != nil {
+        return err
+    }
 
-    return s.db.Save(product).Error           // Menyimpan perubahan product ke database dan mengembalikan error jika ada
+    if err := s.resolveSlug(tx, product, &existingProduct); err != nil {  // Slug manual, slug baru dari judul, atau slug lama
+        return err
+    }
+    if err := slug.Rename(tx, slugScope, product.ID, existingProduct.Slug, product.Slug); err != nil {  // Slug lama tetap bisa dibuka lewat redirect
+        return err
+    }
+    if err := tx.Omit("Stock").Save(product).Error; err != nil {  // Menyimpan perubahan product ke database dan mengembalikan error jika ada
+        return err
+    }
+    return recordUpdated(tx, &existingProduct, product)  // product.updated, dan product.price_changed jika harga berubah
 }
 
-func (s *ProductService) Delete(id uint) error {  // Method untuk menghapus product
-    return s.db.Delete(&entity.Product{}, id).Error  // Menghapus product dari database dan mengembalikan error jika ada
+func checkCategory(tx *gorm.DB, categoryID uint) error {  // Fungsi untuk memastikan category_id menunjuk kategori yang ada
+    var count int64
+    if err := tx.Model(&entity.ProductCategory{}).Where("id = ?", categoryID).Count(&count).Error; err != nil {
+        return err
+    }
+    if count == 0 {
+        return ErrUnknownCategory
+    }
+    return nil
 }
 
-func (s *ProductService) GetByCategoryID(categoryID uint) ([]entity.Product, error) {  // Method untuk mendapatkan product berdasarkan CategoryID
-    var products []entity.Product             // Variabel untuk menampung hasil query
-    err := s.db.Where("category_id = ?", categoryID).Find(&products).Error  // Query product berdasarkan CategoryID
-    return products, err                      // Mengembalikan products dan error jika ada
+func (s *ProductService) remove(tx *gorm.DB, id uint) ([]entity.ProductImage, error) {  // Fungsi untuk menghapus product beserta relasinya di dalam transaksi, mengembalikan gambar yang filenya perlu dihapus
+    if err := slug.Forget(tx, slugScope, id); err != nil {  // Slug lama product ini bisa dipakai product lain
+        return nil, err
+    }
+    var images []entity.ProductImage
+    if err := tx.Where("product_id = ?", id).Find(&images).Error; err != nil {
+        return nil, err
+    }
+    if err := tx.Where("product_id = ?", id).Delete(&entity.ProductImage{}).Error; err != nil {
+        return nil, err
+    }
+    variants := tx.Model(&entity.ProductVariant{}).Select("id").Where("product_id = ?", id)
+    if err := tx.Where("variant_id IN (?)", variants).Delete(&entity.VariantValue{}).Error; err != nil {
+        return nil, err
+    }
+    if err := tx.Where("product_id = ?", id).Delete(&entity.ProductVariant{}).Error; err != nil {
+        return nil, err
+    }
+    result := tx.Delete(&entity.Product{}, id)  // Menghapus product dari database dan mengembalikan error jika ada
+    if result.Error != nil {
+        return nil, result.Error
+    }
+    if result.RowsAffected == 0 {             // Product yang tidak ada tidak menghasilkan event product.deleted
+        return nil, ErrProductNotFound
+    }
+    return images, recordDeleted(tx, id)
+}
+
+func (s *ProductService) deleteFiles(ctx context.Context, images []entity.ProductImage) {  // Fungsi untuk menghapus file gambar setelah commit
+    var keys []string
+    for _, image := range images {
+        keys = append(keys, imageKeys(image)...)
+    }
+    removeFiles(ctx, s.jobs, s.store, keys)   // Dihapus oleh job latar belakang dengan retry
+}
+
+func (s *ProductService) resolveSlug(tx *gorm.DB, product, existing *entity.Product) error {  // Fungsi untuk menentukan slug product saat update
+    switch {
+    case product.Slug == "" && product.Title != existing.Title:  // Judul berubah, slug dibuat ulang
+        generated, err := slug.Generate(tx, slugScope, product.ID, product.Title, "product")
+        if err != nil {
+            return err
+        }
+        product.Slug = generated
+    case product.Slug == "":                  // Judul tetap, slug tetap
+        product.Slug = existing.Slug
+    case product.Slug != existing.Slug:       // Slug diisi manual
+        ok, err := slug.Available(tx, slugScope, product.ID, product.Slug)
+        if err != nil {
+            return err
+        }
+        if !ok {
+            return ErrSlugTaken
+        }
+    }
+    return nil
+}
+
+func (s *ProductService) find(db *gorm.DB, filter entity.AttributeFilter, categoryIDs []uint, sel fieldset.Selection) ([]entity.Product, error) {  // Fungsi untuk mencari product dengan filter kategori dan atribut
+    query, err := s.filtered(db, filter, categoryIDs)
+    if err != nil {
+        return nil, err
+    }
+
+    products := []entity.Product{}           // Variabel untuk menampung hasil query
+    if err := withRelations(query, sel).Find(&products).Error; err != nil {
+        return nil, err
+    }
+    refs := make([]*entity.Product, len(products))
+    for i := range products {
+        refs[i] = &products[i]
+    }
+    return products, s.resolve(db, sel, refs...)
+}
+
+func (s *ProductService) filtered(db *gorm.DB, filter entity.AttributeFilter, categoryIDs []uint) (*gorm.DB, error) {  // Fungsi untuk membangun query product dengan filter kategori dan atribut, dipakai juga oleh ekspor
+    query := db.Model(&entity.Product{})
+    if categoryIDs != nil {
+        query = query.Where("category_id IN ?", categoryIDs)
+    }
+    if len(filter) > 0 {
+        codes := make([]string, 0, len(filter))
+        for code := range filter {
+            codes = append(codes, code)
+        }
+        attributes, err := s.attributes.ByCode(db, codes)
+        if err != nil {
+            return nil, err
+        }
+        variants := db.Model(&entity.ProductVariant{}).Select("product_id")  // Product yang memiliki satu varian dengan semua nilai yang diminta
+        for code, values := range filter {
+            attribute, ok := attributes[code]
+            if !ok {
+                return nil, utils.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("unknown attribute %q", code))
+            }
+            matching := db.Model(&entity.VariantValue{}).Select("variant_id").Where("attribute_id = ? AND value IN ?", attribute.ID, values)
+            variants = variants.Where("id IN (?)", matching)
+        }
+        query = query.Where("id IN (?)", variants)
+    }
+    return query, nil
+}
+
+func withRelations(db *gorm.DB, sel fieldset.Selection) *gorm.DB {  // Fungsi untuk memilih kolom dan memuat gambar dan varian product sesuai urutan, hanya yang diminta ?fields=
+    if columns := sel.Columns(); columns != nil {
+        db = db.Select(columns)
+    }
+    if sel.Field("images") {
+        db = db.Preload("Images", func(db *gorm.DB) *gorm.DB { return db.Order("position, id") })
+    }
+    if sel.Field("variants") {
+        db = db.Preload("Variants", func(db *gorm.DB) *gorm.DB { return db.Order("id") }).
+            Preload("Variants.Values", orderValues)
+    }
+    return db
+}
+
+func cacheKey(key string, sel fieldset.Selection) string {  // Fungsi untuk membedakan entri cache per ?fields= dan ?include=
+    if selection := sel.Key(); selection != "" {
+        return key + "|" + selection
+    }
+    return key
+}
+
+func (s *ProductService) resolve(db *gorm.DB, sel fieldset.Selection, products ...*entity.Product) error {  // Fungsi untuk mengisi URL gambar, atribut varian, dan relasi yang di-include
+    if sel.Include("category") {
+        if err := includeCategory(db, products); err != nil {
+            return err
+        }
+    }
+    var variants []*entity.ProductVariant
+    for _, product := range products {
+        if product.Images == nil {
+            product.Images = []entity.ProductImage{}  // Selalu dikirim sebagai array, bukan null
+        }
+        if product.Variants == nil {
+            product.Variants = []entity.ProductVariant{}
+        }
+        for i := range product.Images {
+            product.Images[i].ResolveURLs(s.store)
+        }
+        for i := range product.Variants {
+            variants = append(variants, &product.Variants[i])
+        }
+    }
+    return resolveAttributes(db, variants...)
+}
+
+func includeCategory(db *gorm.DB, products []*entity.Product) error {  // Fungsi untuk memuat kategori semua product dalam satu query, bukan satu query per product
+    if len(products) == 0 {
+        return nil
+    }
+    ids := make([]uint, 0, len(products))
+    for _, product := range products {
+        ids = append(ids, product.CategoryID)
+    }
+    var categories []entity.ProductCategory
+    if err := db.Where("id IN ?", ids).Find(&categories).Error; err != nil {
+        return err
+    }
+    byID := make(map[uint]*entity.ProductCategory, len(categories))
+    for i := range categories {
+        byID[categories[i].ID] = &categories[i]
+    }
+    for _, product := range products {
+        product.Category = byID[product.CategoryID]
+    }
+    return nil
+}
+
+func checkSKU(tx *gorm.DB, product *entity.Product) error {  // Fungsi untuk memastikan SKU product tidak dipakai product atau varian lain
+    if product.SKU == nil {
+        return nil
+    }
+    taken, err := skuTaken(tx, *product.SKU, product.ID, 0)
+    if err != nil {
+        return err
+    }
+    if taken {
+        return ErrSKUTaken
+    }
+    return nil
+}
+
+func (s *ProductService) subtreeIDs(db *gorm.DB, categoryID uint) ([]uint, error) {  // Fungsi untuk mengumpulkan ID kategori beserta seluruh turunannya
+    ids := []uint{categoryID}
+    frontier := []uint{categoryID}
+    for depth := 0; len(frontier) > 0 && depth < maxCategoryDepth; depth++ {  // Satu query per tingkat pohon
+        var next []uint
+        if err := db.Table("categories").Where("parent_id IN ?", frontier).Pluck("id", &next).Error; err != nil {  // Tabel dibaca langsung karena modul category sudah mengimpor entity product
+            return nil, err
+        }
+        ids = append(ids, next...)
+        frontier = next
+    }
+    return ids, nil
 }
 
 
@@ -81,9 +481,21 @@ File service.go ini berisi implementasi service untuk modul Product. Berikut pen
     - Create : Membuat product baru setelah validasi dan verifikasi kategori
     - GetByID : Mendapatkan product berdasarkan ID
     - GetAll : Mendapatkan semua product
+    - Fields : Whitelist ?fields= dan ?include=; kolom, gambar, dan varian yang tidak diminta tidak ikut dimuat, dan ?include=category memuat kategori semua product dalam satu query
     - Update : Memperbarui product setelah validasi dan pengecekan keberadaan
-    - Delete : Menghapus product berdasarkan ID
-    - GetByCategoryID : Mendapatkan product berdasarkan CategoryID (fitur tambahan)
+    - Stock tidak pernah diubah oleh Create dan Update, perubahan stok hanya melalui modul inventory
+    - Delete : Menghapus product berdasarkan ID beserta riwayat slug dan gambarnya; file gambar dihapus dari storage oleh job latar belakang setelah commit; ID yang tidak ada dijawab 404 tanpa event product.deleted
+    - checkNew, update, remove : Isi transaksi Create, Update, dan Delete, dipakai juga oleh operasi bulk (bulk.go) dan impor (import.go)
+    - checkCategory : checkNew dan update menolak category_id yang tidak ada dengan ErrUnknownCategory (422), dihitung dari tabel categories
+    - Domain Event : Create, update, dan remove mencatat product.created, product.updated, product.price_changed, dan product.deleted di outbox dalam transaksi yang sama (events.go)
+    - Images : GetByID, GetAll, GetBySlug, dan GetByCategoryID memuat gambar sesuai Position beserta URL dari storage; Create dan Update mengabaikan field images
+    - Variants : Dimuat bersama product dengan map attributes; Create dan Update mengabaikan field variants
+    - Filter Atribut : GetAll dan GetByCategoryID menerima AttributeFilter, product dipilih jika memiliki satu varian yang cocok dengan semua atribut (nilai dalam satu atribut bersifat OR)
+    - SKU : SKU product tidak boleh sama dengan SKU product atau varian lain (409)
+    - Slug : Dibuat dari Title oleh hook BeforeCreate jika kosong; saat update dibuat ulang hanya jika Title berubah dan slug tidak diisi; slug manual yang sudah dipakai ditolak dengan 409; slug lama disimpan di slug_redirects
+    - filtered : Query product dengan filter kategori dan atribut tanpa relasi, dipakai find (GetAll, GetByCategoryID) dan Export (export.go)
+    - GetBySlug : Mendapatkan product berdasarkan slug; untuk slug lama mengembalikan slug terbaru agar handler mengirim 301
+    - GetByCategoryID : Mendapatkan product berdasarkan CategoryID (fitur tambahan), dengan includeDescendants juga dari seluruh sub-kategori
 4. Fitur GORM :
 
     - First : Mengambil record pertama yang cocok dengan kondisi
@@ -99,6 +511,8 @@ File service.go ini berisi implementasi service untuk modul Product. Berikut pen
 
     - Mengembalikan error dari validasi atau operasi database ke handler
     - Memeriksa keberadaan record sebelum update untuk mencegah error
+    - Setiap method menerima context.Context dari handler dan meneruskannya ke GORM dengan db.WithContext(ctx), sehingga log query membawa request ID yang sama dengan access log
+    - Setiap method membuat span OpenTelemetry bernama <Service>.<Method> sehingga waktu di service terlihat terpisah dari query database
 7. Fitur Tambahan :
 
     - Method GetByCategoryID memungkinkan pencarian product berdasarkan kategori
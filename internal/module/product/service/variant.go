@@ -0,0 +1,358 @@
+package service                                // Mendefinisikan package service untuk modul product
+
+import (
+    "context"                                 // Package untuk context request
+    "crypto/sha256"                           // Hash kombinasi atribut varian
+    "encoding/hex"                            // Encoding hash kombinasi
+    "errors"                                  // Package untuk pengecekan error
+    "fmt"                                     // Package untuk formatting pesan error
+    "net/http"                                // Package untuk konstanta HTTP
+    attributeEntity "rest-api-go/internal/module/attribute/entity"    // Mengimpor entity attribute
+    attributeService "rest-api-go/internal/module/attribute/service"  // Mengimpor service attribute untuk template kategori
+    "rest-api-go/internal/module/product/entity"  // Mengimpor entity product
+    "rest-api-go/pkg/tracing"                 // Mengimpor package tracing untuk span service
+    "rest-api-go/pkg/utils"                   // Mengimpor utilitas aplikasi untuk HTTPError
+    "sort"                                    // Package untuk mengurutkan kode atribut
+    "strings"                                 // Package untuk menyusun pesan dan kombinasi
+
+    "gorm.io/gorm"                            // Mengimpor ORM GORM
+    "gorm.io/gorm/clause"                     // Klausa SELECT ... FOR UPDATE
+)
+
+var (
+    ErrVariantNotFound = utils.NewHTTPError(http.StatusNotFound, "Variant not found")  // Varian tidak ada atau milik product lain
+    ErrVariantExists   = utils.NewHTTPError(http.StatusConflict, "a variant with these attributes already exists")  // Kombinasi atribut sudah dipakai varian lain
+    ErrSKUTaken        = utils.NewHTTPError(http.StatusConflict, "sku is already in use")  // SKU sudah dipakai product atau varian lain
+    ErrStockNotZero    = utils.NewHTTPError(http.StatusConflict, "product stock must be 0 before adding the first variant; record an adjustment first")  // Stok product tanpa varian tidak bisa dibagi otomatis
+    ErrVariantHasStock = utils.NewHTTPError(http.StatusConflict, "variant still has stock; record an adjustment to 0 first")  // Varian dengan stok tidak dapat dihapus
+)
+
+type VariantService struct {                   // Mendefinisikan struct service varian product
+    db         *gorm.DB                       // Dependency database
+    attributes *attributeService.AttributeService  // Definisi atribut dan template kategori
+}
+
+func NewVariantService(db *gorm.DB, attributes *attributeService.AttributeService) *VariantService {  // Constructor untuk service
+    return &VariantService{db, attributes}    // Mengembalikan instance service dengan dependency yang diinjeksi
+}
+
+func (s *VariantService) List(ctx context.Context, productID uint) ([]entity.ProductVariant, error) {  // Method untuk mendapatkan varian product
+    ctx, span := tracing.Start(ctx, "VariantService.List")  // Membuat child span untuk method service
+    defer span.End()                          // Menutup span saat method selesai
+
+    db := s.db.WithContext(ctx)
+    var product entity.Product
+    if err := db.Select("id").First(&product, productID).Error; err != nil {
+        if errors.Is(err, gorm.ErrRecordNotFound) {
+            return nil, ErrProductNotFound
+        }
+        return nil, err
+    }
+    variants := []entity.ProductVariant{}
+    if err := db.Preload("Values", orderValues).Where("product_id = ?", productID).Order("id").Find(&variants).Error; err != nil {
+        return nil, err
+    }
+    refs := make([]*entity.ProductVariant, len(variants))
+    for i := range variants {
+        refs[i] = &variants[i]
+    }
+    if err := resolveAttributes(db, refs...); err != nil {
+        return nil, err
+    }
+    return variants, nil
+}
+
+func (s *VariantService) Create(ctx context.Context, productID uint, req *entity.VariantRequest) (*entity.ProductVariant, error) {  // Method untuk membuat varian baru
+    ctx, span := tracing.Start(ctx, "VariantService.Create")  // Membuat child span untuk method service
+    defer span.End()                          // Menutup span saat method selesai
+
+    if err := req.Validate(); err != nil {    // Validasi data request
+        return nil, err
+    }
+
+    variant := &entity.ProductVariant{ProductID: productID}
+    err := s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+        product, siblings, err := s.lockProduct(tx, productID, 0)
+        if err != nil {
+            return err
+        }
+        if len(siblings) == 0 && product.Stock != 0 {  // Stok lama tidak bisa dibagi ke varian secara otomatis
+            return ErrStockNotZero
+        }
+        values, err := s.check(tx, product, siblings, 0, req)
+        if err != nil {
+            return err
+        }
+
+        variant.SKU, variant.Price, variant.Combination = req.SKU, req.Price, combination(values)
+        if err := tx.Create(variant).Error; err != nil {
+            return err
+        }
+        return s.saveValues(tx, variant, values)
+    })
+    if err != nil {
+        return nil, err
+    }
+    return variant, nil
+}
+
+func (s *VariantService) Update(ctx context.Context, productID, variantID uint, req *entity.VariantRequest) (*entity.ProductVariant, error) {  // Method untuk mengganti SKU, harga, dan atribut varian
+    ctx, span := tracing.Start(ctx, "VariantService.Update")  // Membuat child span untuk method service
+    defer span.End()                          // Menutup span saat method selesai
+
+    if err := req.Validate(); err != nil {    // Validasi data request
+        return nil, err
+    }
+
+    var variant entity.ProductVariant
+    err := s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+        product, siblings, err := s.lockProduct(tx, productID, variantID)
+        if err != nil {
+            return err
+        }
+        if err := tx.Where("product_id = ?", productID).First(&variant, variantID).Error; err != nil {
+            if errors.Is(err, gorm.ErrRecordNotFound) {
+                return ErrVariantNotFound
+            }
+            return err
+        }
+        values, err := s.check(tx, product, siblings, variantID, req)
+        if err != nil {
+            return err
+        }
+
+        variant.SKU, variant.Price, variant.Combination = req.SKU, req.Price, combination(values)
+        if err := tx.Omit("Stock", clause.Associations).Save(&variant).Error; err != nil {  // Stok hanya berubah melalui stock movement
+            return err
+        }
+        if err := tx.Where("variant_id = ?", variant.ID).Delete(&entity.VariantValue{}).Error; err != nil {  // Nilai atribut diganti seluruhnya
+            return err
+        }
+        return s.saveValues(tx, &variant, values)
+    })
+    if err != nil {
+        return nil, err
+    }
+    return &variant, nil
+}
+
+func (s *VariantService) Delete(ctx context.Context, productID, variantID uint) error {  // Method untuk menghapus varian tanpa stok
+    ctx, span := tracing.Start(ctx, "VariantService.Delete")  // Membuat child span untuk method service
+    defer span.End()                          // Menutup span saat method selesai
+
+    return s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+        if _, _, err := s.lockProduct(tx, productID, variantID); err != nil {
+            return err
+        }
+        var variant entity.ProductVariant
+        if err := tx.Where("product_id = ?", productID).First(&variant, variantID).Error; err != nil {
+            if errors.Is(err, gorm.ErrRecordNotFound) {
+                return ErrVariantNotFound
+            }
+            return err
+        }
+        if variant.Stock != 0 {               // Stok product harus tetap sama dengan jumlah stok varian
+            return ErrVariantHasStock
+        }
+        if err := tx.Where("variant_id = ?", variant.ID).Delete(&entity.VariantValue{}).Error; err != nil {
+            return err
+        }
+        return tx.Delete(&variant).Error
+    })
+}
+
+func (s *VariantService) lockProduct(tx *gorm.DB, productID, exclude uint) (*entity.Product, []entity.ProductVariant, error) {  // Fungsi untuk mengunci product dan membaca varian lainnya
+    var product entity.Product
+    if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&product, productID).Error; err != nil {  // Kunci yang sama dengan stock movement
+        if errors.Is(err, gorm.ErrRecordNotFound) {
+            return nil, nil, ErrProductNotFound
+        }
+        return nil, nil, err
+    }
+    var siblings []entity.ProductVariant
+    err := tx.Preload("Values").Where("product_id = ? AND id <> ?", productID, exclude).Find(&siblings).Error
+    return &product, siblings, err
+}
+
+func (s *VariantService) check(tx *gorm.DB, product *entity.Product, siblings []entity.ProductVariant, variantID uint, req *entity.VariantRequest) ([]entity.VariantValue, error) {  // Fungsi untuk memeriksa request terhadap template kategori dan varian lain
+    codes := make([]string, 0, len(req.Attributes))
+    for code := range req.Attributes {
+        codes = append(codes, code)
+    }
+    sort.Strings(codes)
+
+    attributes, err := s.attributes.ByCode(tx, codes)
+    if err != nil {
+        return nil, err
+    }
+    template, err := s.attributes.Effective(tx, product.CategoryID)
+    if err != nil {
+        return nil, err
+    }
+
+    order := make(map[uint]int, len(template))  // Urutan nilai mengikuti template, atribut di luar template diurutkan berdasarkan kode
+    for i, item := range template {
+        order[item.ID] = i
+        if _, ok := req.Attributes[item.Code]; item.Required && !ok {
+            return nil, utils.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("attribute %q is required for products in this category", item.Code))
+        }
+    }
+
+    values := make([]entity.VariantValue, 0, len(codes))
+    for _, code := range codes {
+        attribute, ok := attributes[code]
+        if !ok {
+            return nil, utils.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("unknown attribute %q", code))
+        }
+        if _, ok := order[attribute.ID]; !ok && len(template) > 0 {
+            return nil, utils.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("attribute %q is not part of the category template", code))
+        }
+        value := strings.TrimSpace(req.Attributes[code])
+        if !attribute.Allows(value) {
+            return nil, utils.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("value %q is not allowed for attribute %q (allowed: %s)", value, code, strings.Join(attribute.Values, ", ")))
+        }
+        values = append(values, entity.VariantValue{AttributeID: attribute.ID, Value: value})
+    }
+    sort.SliceStable(values, func(i, j int) bool {
+        oi, iok := order[values[i].AttributeID]
+        oj, jok := order[values[j].AttributeID]
+        if iok != jok {
+            return iok
+        }
+        return iok && oi < oj
+    })
+
+    if len(siblings) > 0 && !sameAttributes(values, siblings[0].Values) {  // Semua varian satu product memakai atribut yang sama
+        return nil, utils.NewHTTPError(http.StatusBadRequest, "all variants of a product must use the same attributes")
+    }
+    hash := combination(values)
+    for _, sibling := range siblings {
+        if sibling.Combination == hash {
+            return nil, ErrVariantExists
+        }
+    }
+
+    if req.Price != nil && req.Price.Currency != product.Price.Currency {
+        return nil, utils.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("variant price must use the product currency %s", product.Price.Currency))
+    }
+    if req.SKU != nil {
+        taken, err := skuTaken(tx, *req.SKU, 0, variantID)
+        if err != nil {
+            return nil, err
+        }
+        if taken {
+            return nil, ErrSKUTaken
+        }
+    }
+    return values, nil
+}
+
+func (s *VariantService) saveValues(tx *gorm.DB, variant *entity.ProductVariant, values []entity.VariantValue) error {  // Fungsi untuk menyimpan nilai atribut dan mengisi map Attributes
+    for i := range values {
+        values[i].ID, values[i].VariantID = 0, variant.ID
+    }
+    if err := tx.Create(&values).Error; err != nil {
+        return err
+    }
+    variant.Values = values
+    return resolveAttributes(tx, variant)
+}
+
+// skuTaken - SKU product dan varian berbagi satu ruang nama
+func skuTaken(tx *gorm.DB, sku string, productID, variantID uint) (bool, error) {
+    var count int64
+    if err := tx.Model(&entity.Product{}).Where("sku = ? AND id <> ?", sku, productID).Count(&count).Error; err != nil {
+        return false, err
+    }
+    if count > 0 {
+        return true, nil
+    }
+    err := tx.Model(&entity.ProductVariant{}).Where("sku = ? AND id <> ?", sku, variantID).Count(&count).Error
+    return count > 0, err
+}
+
+// resolveAttributes - mengisi map Attributes varian dari nilai dan kode atribut
+func resolveAttributes(db *gorm.DB, variants ...*entity.ProductVariant) error {
+    ids := []uint{}
+    for _, variant := range variants {
+        for _, value := range variant.Values {
+            ids = append(ids, value.AttributeID)
+        }
+    }
+    codes := map[uint]string{}
+    if len(ids) > 0 {                         // Satu query untuk semua varian
+        var attributes []attributeEntity.Attribute
+        if err := db.Select("id", "code").Where("id IN ?", ids).Find(&attributes).Error; err != nil {
+            return err
+        }
+        for _, attribute := range attributes {
+            codes[attribute.ID] = attribute.Code
+        }
+    }
+    for _, variant := range variants {
+        variant.Attributes = make(map[string]string, len(variant.Values))
+        for _, value := range variant.Values {
+            variant.Attributes[codes[value.AttributeID]] = value.Value
+        }
+    }
+    return nil
+}
+
+func orderValues(db *gorm.DB) *gorm.DB {      // Fungsi untuk memuat nilai atribut sesuai urutan penyimpanan (urutan template)
+    return db.Order("id")
+}
+
+func sameAttributes(values, other []entity.VariantValue) bool {  // Fungsi untuk membandingkan himpunan atribut dua varian
+    if len(values) != len(other) {
+        return false
+    }
+    ids := make(map[uint]bool, len(values))
+    for _, value := range values {
+        ids[value.AttributeID] = true
+    }
+    for _, value := range other {
+        if !ids[value.AttributeID] {
+            return false
+        }
+    }
+    return true
+}
+
+func combination(values []entity.VariantValue) string {  // Fungsi untuk membuat hash kombinasi atribut=nilai yang tidak bergantung urutan
+    pairs := make([]string, 0, len(values))
+    for _, value := range values {
+        pairs = append(pairs, fmt.Sprintf("%d=%s", value.AttributeID, value.Value))
+    }
+    sort.Strings(pairs)
+    sum := sha256.Sum256([]byte(strings.Join(pairs, "\x00")))
+    return hex.EncodeToString(sum[:])
+}
+
+
+// {{{ Penjelasan Fungsi VariantService }}}
+
+/*
+## Penjelasan Detail
+File variant.go ini berisi logika bisnis untuk varian product. Berikut penjelasan detailnya:
+
+1. Pemeriksaan Varian (check) :
+
+    - Setiap kode atribut harus terdaftar di modul attribute, nilai harus termasuk Values atribut jika daftar nilai diisi
+    - Jika kategori product (atau induknya) memiliki template, hanya atribut template yang boleh dipakai dan atribut wajib harus diisi
+    - Semua varian satu product memakai himpunan atribut yang sama, misal semua varian kaos memiliki size dan color
+    - Kombinasi nilai yang sama ditolak dengan 409
+    - Harga khusus harus memakai mata uang product agar total order tetap satu mata uang
+    - SKU tidak boleh dipakai product atau varian lain
+2. Stok :
+
+    - Varian baru selalu mulai dengan stok 0, stok hanya berubah melalui stock movement dengan variant_id
+    - Varian pertama hanya bisa ditambahkan jika stok product 0, sehingga stok product selalu sama dengan jumlah stok varian
+    - Varian yang masih memiliki stok tidak dapat dihapus
+3. Konkurensi :
+
+    - Setiap perubahan mengunci baris product dengan SELECT ... FOR UPDATE, kunci yang sama dipakai oleh stock movement
+4. Helper :
+
+    - resolveAttributes mengisi map Attributes dari nilai dan kode atribut, dipakai juga oleh ProductService
+    - skuTaken dipakai juga oleh ProductService saat membuat dan memperbarui product
+*/
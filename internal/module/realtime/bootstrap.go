@@ -0,0 +1,40 @@
+package realtime                               // Mendefinisikan package realtime
+
+import (
+	"rest-api-go/internal/module/realtime/handler"  // Mengimpor package handler dari modul realtime
+	"rest-api-go/internal/module/realtime/service"  // Mengimpor package service dari modul realtime
+	"rest-api-go/pkg/events"                        // Mengimpor stream domain event
+	"rest-api-go/pkg/realtime"                      // Mengimpor hub WebSocket
+
+	"github.com/gin-gonic/gin"                      // Mengimpor framework web Gin
+)
+
+// Initialize - Fungsi untuk menginisialisasi modul realtime
+func Initialize(router *gin.RouterGroup, hub *realtime.Hub, stream *events.Stream) {  // Fungsi untuk inisialisasi modul dengan parameter router, hub WebSocket, dan stream event
+	// Initialize service
+	realtimeService := service.NewRealtimeService(hub, stream)  // Membuat instance service dengan menyuntikkan hub dan stream
+	go realtimeService.Forward()                        // Meneruskan event ke hub sampai stream berhenti
+
+	// Initialize handler
+	realtimeHandler := handler.NewRealtimeHandler(hub)  // Membuat instance handler dengan menyuntikkan hub
+
+	// Register routes
+	handler.RegisterRoutes(router, realtimeHandler)     // Mendaftarkan route untuk modul realtime
+}
+
+
+// {{{ Penjelasan Fungsi Initialize }}}
+
+/*
+## Penjelasan Detail
+File bootstrap.go ini berfungsi sebagai titik masuk (entry point) untuk modul realtime. Berikut penjelasan detailnya:
+
+1. Tujuan : Menyediakan endpoint WebSocket di /ws untuk terminal POS yang membutuhkan update stok, harga, dan order secara langsung.
+2. Hubungan dengan pkg/realtime dan pkg/events :
+
+	- Hub dibuat di main.go dengan realtime.Options (ValidTopic dan Authorizer dari service modul ini) agar Shutdown dapat dipanggil sebelum server berhenti
+	- Event dibaca dari events.Stream yang sama dengan SSE, lalu diteruskan ke hub oleh goroutine Forward
+3. Hubungan dengan Aplikasi Utama :
+
+	- Fungsi Initialize dipanggil dari main.go
+*/
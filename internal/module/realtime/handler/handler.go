@@ -0,0 +1,49 @@
+package handler                                // Mendefinisikan package handler untuk modul realtime
+
+import (
+    "errors"                                   // Package untuk pengecekan error
+    "net/http"                                 // Package untuk konstanta HTTP
+    "rest-api-go/pkg/middleware"               // Mengimpor helper user yang login
+    "rest-api-go/pkg/realtime"                 // Mengimpor hub WebSocket
+    "rest-api-go/pkg/utils"                    // Mengimpor utilitas aplikasi
+
+    "github.com/gin-gonic/gin"                 // Framework web Gin
+)
+
+type RealtimeHandler struct {                  // Mendefinisikan struct handler
+    hub *realtime.Hub                          // Hub koneksi WebSocket
+}
+
+func NewRealtimeHandler(hub *realtime.Hub) *RealtimeHandler {  // Constructor untuk handler
+    return &RealtimeHandler{hub}               // Mengembalikan instance handler dengan hub yang diinjeksi
+}
+
+func (h *RealtimeHandler) Connect(c *gin.Context) {  // Handler untuk membuka koneksi WebSocket
+    userID, _ := middleware.CurrentUserID(c)   // Selalu ada karena route memakai RequireAuth
+    err := h.hub.Serve(c.Writer, c.Request, realtime.User{ID: userID, Role: middleware.CurrentRole(c)})  // Blok sampai koneksi ditutup
+    var handshake *realtime.HandshakeError
+    switch {
+    case errors.Is(err, realtime.ErrClosed):
+        utils.ErrorJSON(c, http.StatusServiceUnavailable, "server is shutting down")  // Client tersambung ke instance lain
+    case errors.As(err, &handshake):
+        utils.ErrorJSON(c, handshake.Status, handshake.Reason)  // Misal request biasa tanpa header Upgrade
+    }
+}
+
+
+// {{{ Penjelasan Fungsi Handler }}}
+
+/*
+## Penjelasan Detail
+File handler.go ini berisi endpoint WebSocket untuk update stok, harga, dan order secara langsung. Berikut penjelasan detailnya:
+
+1. Connect :
+
+    - Meng-upgrade request menjadi WebSocket melalui realtime.Hub; handler baru selesai saat koneksi ditutup
+    - ID dan peran user dari token diteruskan ke Hub untuk memeriksa hak akses setiap topik
+    - Protokol pesan (subscribe, unsubscribe, subscriptions, event, error) ada di pkg/realtime/client.go
+2. Penanganan Error :
+
+    - Request tanpa upgrade WebSocket atau dari origin lain: Status dari upgrader (400 atau 403) dalam format error standar
+    - Server sedang berhenti: Status 503 Service Unavailable
+*/
@@ -0,0 +1,27 @@
+package handler                                // Mendefinisikan package handler untuk modul realtime
+
+import (
+    "rest-api-go/pkg/middleware"               // Mengimpor middleware RequireAuth
+
+    "github.com/gin-gonic/gin"                 // Mengimpor framework web Gin
+)
+
+func RegisterRoutes(router *gin.RouterGroup, handler *RealtimeHandler) {  // Fungsi untuk mendaftarkan route
+    router.GET("/ws", middleware.RequireAuth(), handler.Connect)  // Mendaftarkan endpoint GET untuk upgrade WebSocket, wajib login
+}
+
+
+// {{{ Penjelasan Fungsi RegisterRoutes }}}
+
+/*
+## Penjelasan Detail
+File route.go ini berisi konfigurasi routing untuk modul Realtime. Berikut penjelasan detailnya:
+
+1. Endpoint API :
+
+    - GET /ws : Koneksi WebSocket dengan langganan topik product, category, dan order
+2. Autentikasi :
+
+    - Memakai middleware.RequireAuth pada request handshake, request tanpa token bearer yang valid dijawab 401 sebelum upgrade
+    - Terminal POS mengirim header Authorization saat handshake; WebSocket bawaan browser tidak dapat mengirim header tersebut
+*/
@@ -0,0 +1,133 @@
+package service                                // Mendefinisikan package service untuk modul realtime
+
+import (
+    "context"                                 // Package untuk context query kepemilikan order
+    "errors"                                  // Package untuk membuat error
+    "fmt"                                     // Package untuk membentuk nama topik
+    "log/slog"                                // Package structured logging bawaan Go
+    "regexp"                                  // Package untuk format nama topik
+    orderEntity "rest-api-go/internal/module/order/entity"  // Mengimpor entity order untuk memeriksa pemilik
+    "rest-api-go/pkg/events"                  // Mengimpor stream domain event
+    "rest-api-go/pkg/realtime"                // Mengimpor hub WebSocket
+    "strconv"                                 // Package untuk membaca ID dari nama topik
+    "strings"                                 // Package untuk memisahkan nama topik
+
+    "gorm.io/gorm"                            // Mengimpor ORM GORM
+)
+
+var errOrdersTopic = errors.New("topic orders requires a staff or terminal account")  // Customer berlangganan semua order
+
+// Patterns - tipe event yang diteruskan ke client WebSocket
+var Patterns = []string{"product.*", "category.*", "order.*"}
+
+var topicFormat = regexp.MustCompile(`^((product|category|order):[1-9][0-9]{0,9}|orders)$`)  // "product:42", "category:7", "order:12", "orders"
+
+// ValidTopic - memeriksa nama topik yang dikirim client
+func ValidTopic(topic string) bool {
+    return topicFormat.MatchString(topic)
+}
+
+// Authorizer - membuat Options.Authorize: orders hanya untuk staff dan terminal, order:<id> hanya untuk pemilik order atau staff dan terminal
+func Authorizer(db *gorm.DB) func(ctx context.Context, user realtime.User, topic string) error {
+    return func(ctx context.Context, user realtime.User, topic string) error {
+        if user.Role.ReadsOrders() {          // Staff, admin, dan terminal boleh semua topik
+            return nil
+        }
+        if topic == "orders" {
+            return errOrdersTopic
+        }
+        raw, ok := strings.CutPrefix(topic, "order:")
+        if !ok {                              // product:<id> dan category:<id> untuk semua user yang login
+            return nil
+        }
+        id, _ := strconv.ParseUint(raw, 10, 64)  // Format sudah diperiksa ValidTopic
+        var count int64
+        if err := db.WithContext(ctx).Model(&orderEntity.Order{}).Where("id = ? AND user_id = ?", id, user.ID).Count(&count).Error; err != nil {
+            return fmt.Errorf("could not check topic %q, try again", topic)  // Detail error database tidak dikirim ke client
+        }
+        if count == 0 {                       // Order milik user lain dan order yang tidak ada dijawab sama
+            return fmt.Errorf("topic %q is not available", topic)
+        }
+        return nil
+    }
+}
+
+// Topics - topik yang menerima event: entitasnya sendiri, kategori product, dan "orders" untuk semua order
+func Topics(event *events.Event) []string {
+    topics := []string{fmt.Sprintf("%s:%d", event.EntityType, event.EntityID)}
+    switch event.EntityType {
+    case "product":
+        var ref struct {
+            CategoryID uint `json:"category_id"`
+        }
+        if err := event.Decode(&ref); err == nil && ref.CategoryID != 0 {  // product.deleted tidak membawa category_id
+            topics = append(topics, fmt.Sprintf("category:%d", ref.CategoryID))
+        }
+    case "order":
+        topics = append(topics, "orders")
+    }
+    return topics
+}
+
+type RealtimeService struct {                 // Mendefinisikan struct service
+    hub    *realtime.Hub                      // Hub koneksi WebSocket
+    stream *events.Stream                     // Sumber event dari outbox
+}
+
+func NewRealtimeService(hub *realtime.Hub, stream *events.Stream) *RealtimeService {  // Constructor untuk service
+    return &RealtimeService{hub, stream}      // Mengembalikan instance service dengan dependency yang diinjeksi
+}
+
+// Forward - meneruskan event dari stream ke hub sampai stream berhenti; dijalankan di goroutine sendiri
+func (s *RealtimeService) Forward() {
+    var lastID uint                           // Event terakhir yang diteruskan, untuk subscribe ulang tanpa kehilangan event
+    for {
+        client, replay, complete := s.stream.Subscribe(Patterns, lastID)
+        if !complete {
+            slog.Warn("websocket forwarder missed events", "after_id", lastID)
+        }
+        for i := range replay {
+            s.publish(&replay[i])
+            lastID = replay[i].ID
+        }
+        for event := range client.Events() {
+            s.publish(&event)
+            lastID = event.ID
+        }
+        if !client.Dropped {                  // Stream berhenti saat shutdown
+            return
+        }
+        slog.Warn("websocket forwarder fell behind the event stream, resubscribing", "after_id", lastID)
+    }
+}
+
+func (s *RealtimeService) publish(event *events.Event) {  // Fungsi untuk mengirim satu event ke topiknya
+    if err := s.hub.Publish(Topics(event), event); err != nil {
+        slog.Error("failed to publish event to websocket clients", "event_id", event.ID, "error", err)
+    }
+}
+
+
+// {{{ Penjelasan Fungsi Service }}}
+
+/*
+## Penjelasan Detail
+File service.go ini berisi penghubung antara events.Stream dan hub WebSocket. Berikut penjelasan detailnya:
+
+1. Topik :
+
+    - product:<id>, category:<id>, dan order:<id> menerima event entitas tersebut
+    - Event product yang membawa category_id (created, updated, price_changed, stock_changed) juga dikirim ke category:<id>
+    - orders menerima semua event order, misal untuk layar POS yang menampilkan order baru
+2. Forward :
+
+    - Berlangganan ke Stream dengan pola product.*, category.*, dan order.*, sehingga setiap instance meneruskan semua event ke koneksi miliknya
+    - Hub.Publish tidak pernah menunggu client, sehingga forwarder hampir tidak pernah tertinggal; jika tertinggal, forwarder subscribe ulang dari event terakhir
+    - Berhenti saat Stream.Shutdown menutup channel client
+3. Hak Akses (Authorizer) :
+
+    - product:<id> dan category:<id> untuk semua user yang login, sama seperti GET /products
+    - order:<id> hanya untuk pemilik order, sama seperti GET /orders/:id; order user lain dan order yang tidak ada ditolak dengan pesan yang sama
+    - orders hanya untuk staff, admin, dan terminal karena berisi order semua user
+    - Peran dibaca dari token saat handshake; Authorizer dipasang di main.go sebagai realtime.Options.Authorize
+*/
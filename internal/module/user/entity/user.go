@@ -1,23 +1,64 @@
 package entity                                // Mendefinisikan package entity untuk modul user
 
 import (
+    "encoding/json"                           // Package untuk menyembunyikan password dari respons
+    "rest-api-go/pkg/auth"                    // Package auth untuk tipe peran user
+    "rest-api-go/pkg/validation"              // Package validation dengan validator bersama
     "time"                                    // Package time untuk tipe data waktu
-    
-    "github.com/go-playground/validator/v10"  // Package validator untuk validasi data
+
+    "golang.org/x/crypto/bcrypt"              // Hash password
 )
 
 type User struct {                            // Mendefinisikan struct User
     ID          uint      `json:"id" gorm:"primaryKey"`  // ID user sebagai primary key
-    Username    string    `json:"username" binding:"max=255"`  // Username user dengan validasi maksimal 255 karakter
-    Email       string    `json:"email" binding:"max=255"`  // Email user dengan validasi maksimal 255 karakter
-    Password    string    `json:"password" binding:"max=255"`  // Password user dengan validasi maksimal 255 karakter
+    Username    string    `json:"username" binding:"required,notblank,max=255"`  // Username user wajib diisi, tidak boleh kosong, maksimal 255 karakter
+    Email       string    `json:"email" gorm:"size:255;uniqueIndex;not null" binding:"required,email,max=255"`  // Email user wajib diisi dengan format email yang valid, maksimal 255 karakter, unik karena dipakai untuk login
+    Password    string    `json:"password" binding:"required,max=72" audit:"redact"`  // Password user wajib diisi, maksimal 72 byte (batas bcrypt), disimpan sebagai hash bcrypt, disensor di audit log
+    Role        auth.Role `json:"-" gorm:"size:16;not null;default:customer"`  // Peran user, tidak bisa dikirim di body create/update, diubah lewat PUT /api/users/:id/role
     CreatedAt   time.Time `json:"created_at"`  // Waktu pembuatan record
     UpdatedAt   time.Time `json:"updated_at"`  // Waktu pembaruan record
 }
 
 func (p *User) Validate() error {             // Method untuk validasi struct User
-    validate := validator.New()               // Membuat instance validator baru
-    return validate.Struct(p)                 // Memvalidasi struct berdasarkan tag binding
+    return validation.Struct(p)               // Memvalidasi struct berdasarkan tag binding dengan validator bersama
+}
+
+// HashPassword - mengganti password dari request dengan hash bcrypt, dipanggil service sebelum menyimpan
+func (p *User) HashPassword() error {         // Selalu meng-hash, input yang mirip hash bcrypt juga diperlakukan sebagai password biasa
+    hash, err := bcrypt.GenerateFromPassword([]byte(p.Password), bcrypt.DefaultCost)
+    if err != nil {
+        return err
+    }
+    p.Password = string(hash)
+    return nil
+}
+
+// CheckPassword - membandingkan password dengan hash yang tersimpan
+func (p *User) CheckPassword(password string) bool {
+    return bcrypt.CompareHashAndPassword([]byte(p.Password), []byte(password)) == nil
+}
+
+// MarshalJSON - menulis user ke JSON tanpa hash password
+func (p User) MarshalJSON() ([]byte, error) {
+    type plain User                           // Tipe tanpa method agar tidak rekursif
+    return json.Marshal(struct {
+        plain
+        Password string    `json:"password,omitempty"`  // Menutupi field Password, selalu kosong
+        Role     auth.Role `json:"role"`                // Peran hanya untuk output
+    }{plain: plain(p), Role: p.Role})
+}
+
+type RoleRequest struct {                     // Mendefinisikan struct body request untuk mengubah peran user
+    Role auth.Role `json:"role" binding:"required,oneof=customer terminal staff admin"`  // Peran baru
+}
+
+func (r *RoleRequest) Validate() error {      // Method untuk validasi struct RoleRequest
+    return validation.Struct(r)
+}
+
+type LoginRequest struct {                    // Mendefinisikan struct body request login
+    Email    string `json:"email" binding:"required,max=255"`  // Email user
+    Password string `json:"password" binding:"required,max=72"`  // Password user
 }
 
 
@@ -37,19 +78,29 @@ File user.go ini mendefinisikan struktur data untuk entitas User. Berikut penjel
 
     - ID : Primary key untuk user
     - Username : Username user dengan batasan panjang 255 karakter
-    - Email : Email user dengan batasan panjang 255 karakter
-    - Password : Password user dengan batasan panjang 255 karakter
+    - Email : Email user dengan batasan panjang 255 karakter, unik (index uniqueIndex) karena login mencari user berdasarkan email
+    - Password : Password user, maksimal 72 byte, disimpan sebagai hash bcrypt melalui hook BeforeSave
+    - Role : Peran user (customer, terminal, staff, admin), default customer; ikut di respons JSON tetapi ditolak sebagai field tidak dikenal di body create/update sehingga user tidak bisa menaikkan perannya sendiri
     - CreatedAt/UpdatedAt : Timestamp untuk audit trail
 3. Tag Struct :
 
     - json : Menentukan nama field dalam respons JSON
-    - gorm : Menentukan konfigurasi ORM (primary key)
+    - gorm : Menentukan konfigurasi ORM (primary key, index unik email)
     - binding : Menentukan aturan validasi
+    - audit : audit:"redact" membuat perubahan password tercatat di audit log tanpa nilai hash-nya
 4. Validasi :
 
-    - Method Validate() menggunakan package validator untuk memastikan data valid sebelum disimpan ke database
-    - Validasi berdasarkan tag binding pada struct
+    - Method Validate() menggunakan validator bersama dari package validation untuk memastikan data valid sebelum disimpan ke database
+    - Validasi berdasarkan tag binding pada struct, tag yang sama dipakai saat binding di handler
+    - Email harus berformat email yang valid, Username tidak boleh hanya berisi spasi
+5. Password dan Login :
+
+    - HashPassword meng-hash password dengan bcrypt tanpa pengecualian, dipanggil service untuk setiap password dari API
+    - Tidak ada hook BeforeSave: hook tidak bisa membedakan hash yang dibaca dari database dengan password yang kebetulan berbentuk hash, sehingga password seperti "$2a$10$..." dari client dulu tersimpan apa adanya
+    - CheckPassword dipakai saat login untuk membandingkan password dengan hash
+    - MarshalJSON menghilangkan password dari semua respons, password hanya dibaca dari request
+    - LoginRequest adalah body untuk POST /api/users/login
 Entitas User ini merupakan bagian dari pola Repository yang digunakan dalam aplikasi, di mana struct Go digunakan untuk mewakili data dari database dan untuk berinteraksi dengan API.
 
-Dalam pengembangan lebih lanjut, Anda mungkin ingin menambahkan validasi yang lebih ketat untuk email (format email) dan password (kekuatan password), serta menambahkan mekanisme untuk mengenkripsi password sebelum disimpan ke database.
+Dalam pengembangan lebih lanjut, Anda mungkin ingin menambahkan validasi yang lebih ketat untuk kekuatan password.
 */
\ No newline at end of file
@@ -4,8 +4,11 @@ import (
     "net/http"                                 // Package untuk konstanta HTTP
     "rest-api-go/internal/module/user/entity"  // Mengimpor entity user
     "rest-api-go/internal/module/user/service" // Mengimpor service user
+    "rest-api-go/pkg/auth"                     // Mengimpor package auth untuk membuat token
+    "rest-api-go/pkg/bulk"                     // Mengimpor package bulk untuk laporan per item
     "rest-api-go/pkg/utils"                    // Mengimpor utilitas aplikasi
     "strconv"                                  // Package untuk konversi string
+    "time"                                     // Package untuk waktu kedaluwarsa token
 
     "github.com/gin-gonic/gin"                 // Framework web Gin
 )
@@ -20,13 +23,13 @@ func NewUserHandler(service *service.UserService) *UserHandler {  // Constructor
 
 func (h *UserHandler) Create(c *gin.Context) {  // Handler untuk membuat user baru
     var user entity.User                       // Variabel untuk menampung data user dari request
-    if err := c.ShouldBindJSON(&user); err != nil {  // Binding JSON request ke struct user
-        c.JSON(http.StatusBadRequest, utils.ErrorResponse(err.Error()))  // Respons error jika binding gagal
+    if err := utils.BindJSON(c, &user); err != nil {  // Binding JSON request ke struct user secara ketat
+        utils.HandleError(c, http.StatusBadRequest, err)  // Respons error jika binding gagal (400, 413, atau 415)
         return
     }
 
-    if err := h.service.Create(&user); err != nil {  // Memanggil service untuk membuat user
-        c.JSON(http.StatusInternalServerError, utils.ErrorResponse(err.Error()))  // Respons error jika gagal
+    if err := h.service.Create(c.Request.Context(), &user); err != nil {  // Memanggil service untuk membuat user
+        utils.HandleError(c, http.StatusInternalServerError, err)  // Respons error jika gagal
         return
     }
 
@@ -36,13 +39,13 @@ func (h *UserHandler) Create(c *gin.Context) {  // Handler untuk membuat user ba
 func (h *UserHandler) GetByID(c *gin.Context) {  // Handler untuk mendapatkan user berdasarkan ID
     id, err := strconv.ParseUint(c.Param("id"), 10, 32)  // Mengambil dan mengkonversi parameter ID
     if err != nil {
-        c.JSON(http.StatusBadRequest, utils.ErrorResponse("Invalid ID"))  // Respons error jika ID tidak valid
+        utils.ErrorJSON(c, http.StatusBadRequest, "Invalid ID")  // Respons error jika ID tidak valid
         return
     }
 
-    user, err := h.service.GetByID(uint(id))  // Memanggil service untuk mendapatkan user
+    user, err := h.service.GetByID(c.Request.Context(), uint(id))  // Memanggil service untuk mendapatkan user
     if err != nil {
-        c.JSON(http.StatusNotFound, utils.ErrorResponse("User not found"))  // Respons error jika tidak ditemukan
+        utils.ErrorJSON(c, http.StatusNotFound, "User not found")  // Respons error jika tidak ditemukan
         return
     }
 
@@ -50,9 +53,9 @@ func (h *UserHandler) GetByID(c *gin.Context) {  // Handler untuk mendapatkan us
 }
 
 func (h *UserHandler) GetAll(c *gin.Context) {  // Handler untuk mendapatkan semua user
-    users, err := h.service.GetAll()  // Memanggil service untuk mendapatkan semua user
+    users, err := h.service.GetAll(c.Request.Context())  // Memanggil service untuk mendapatkan semua user
     if err != nil {
-        c.JSON(http.StatusInternalServerError, utils.ErrorResponse(err.Error()))  // Respons error jika gagal
+        utils.HandleError(c, http.StatusInternalServerError, err)  // Respons error jika gagal
         return
     }
 
@@ -62,42 +65,141 @@ func (h *UserHandler) GetAll(c *gin.Context) {  // Handler untuk mendapatkan sem
 func (h *UserHandler) Update(c *gin.Context) {  // Handler untuk memperbarui user
     id, err := strconv.ParseUint(c.Param("id"), 10, 32)  // Mengambil dan mengkonversi parameter ID
     if err != nil {
-        c.JSON(http.StatusBadRequest, utils.ErrorResponse("Invalid ID"))  // Respons error jika ID tidak valid
+        utils.ErrorJSON(c, http.StatusBadRequest, "Invalid ID")  // Respons error jika ID tidak valid
         return
     }
 
     var user entity.User  // Variabel untuk menampung data user dari request
-    if err := c.ShouldBindJSON(&user); err != nil {  // Binding JSON request ke struct user
-        c.JSON(http.StatusBadRequest, utils.ErrorResponse(err.Error()))  // Respons error jika binding gagal
+    if err := utils.BindJSON(c, &user); err != nil {  // Binding JSON request ke struct user secara ketat
+        utils.HandleError(c, http.StatusBadRequest, err)  // Respons error jika binding gagal (400, 413, atau 415)
         return
     }
 
     // Set ID dari parameter URL
     user.ID = uint(id)  // Mengatur ID user dari parameter URL
 
-    if err := h.service.Update(&user); err != nil {  // Memanggil service untuk memperbarui user
-        c.JSON(http.StatusInternalServerError, utils.ErrorResponse(err.Error()))  // Respons error jika gagal
+    if err := h.service.Update(c.Request.Context(), &user); err != nil {  // Memanggil service untuk memperbarui user
+        utils.HandleError(c, http.StatusInternalServerError, err)  // Respons error jika gagal
         return
     }
 
     c.JSON(http.StatusOK, utils.SuccessResponse(user))  // Respons sukses dengan data user yang diperbarui
 }
 
+type loginResponse struct {                    // Mendefinisikan struct respons login
+    Token     string    `json:"token"`         // Token bearer
+    TokenType string    `json:"token_type"`    // Selalu "Bearer"
+    ExpiresAt time.Time `json:"expires_at"`    // Waktu token kedaluwarsa
+    UserID    uint      `json:"user_id"`       // ID user yang login
+}
+
+func (h *UserHandler) Login(c *gin.Context) {  // Handler untuk login dan mendapatkan token
+    var req entity.LoginRequest                // Variabel untuk menampung email dan password dari request
+    if err := utils.BindJSON(c, &req); err != nil {  // Binding JSON request ke struct secara ketat
+        utils.HandleError(c, http.StatusBadRequest, err)  // Respons error jika binding gagal
+        return
+    }
+
+    user, err := h.service.Authenticate(c.Request.Context(), &req)  // Memanggil service untuk memeriksa email dan password
+    if err != nil {
+        utils.HandleError(c, http.StatusInternalServerError, err)  // Respons error (401 atau 500)
+        return
+    }
+
+    token, expiresAt, err := auth.Default().Issue(user.ID, user.Role)  // Membuat token bearer untuk user beserta perannya
+    if err != nil {
+        utils.HandleError(c, http.StatusInternalServerError, err)  // Respons error jika gagal
+        return
+    }
+
+    c.JSON(http.StatusOK, utils.SuccessResponse(loginResponse{Token: token, TokenType: "Bearer", ExpiresAt: expiresAt, UserID: user.ID}))  // Respons sukses dengan token
+}
+
 func (h *UserHandler) Delete(c *gin.Context) {  // Handler untuk menghapus user
     id, err := strconv.ParseUint(c.Param("id"), 10, 32)  // Mengambil dan mengkonversi parameter ID
     if err != nil {
-        c.JSON(http.StatusBadRequest, utils.ErrorResponse("Invalid ID"))  // Respons error jika ID tidak valid
+        utils.ErrorJSON(c, http.StatusBadRequest, "Invalid ID")  // Respons error jika ID tidak valid
         return
     }
 
-    if err := h.service.Delete(uint(id)); err != nil {  // Memanggil service untuk menghapus user
-        c.JSON(http.StatusInternalServerError, utils.ErrorResponse(err.Error()))  // Respons error jika gagal
+    if err := h.service.Delete(c.Request.Context(), uint(id)); err != nil {  // Memanggil service untuk menghapus user
+        utils.HandleError(c, http.StatusInternalServerError, err)  // Respons error jika gagal
         return
     }
 
     c.JSON(http.StatusOK, utils.SuccessResponse("User deleted successfully"))  // Respons sukses dengan pesan
 }
 
+func (h *UserHandler) SetRole(c *gin.Context) {  // Handler untuk mengubah peran user, route hanya untuk admin
+    id, err := strconv.ParseUint(c.Param("id"), 10, 32)  // Mengambil dan mengkonversi parameter ID
+    if err != nil {
+        utils.ErrorJSON(c, http.StatusBadRequest, "Invalid ID")  // Respons error jika ID tidak valid
+        return
+    }
+
+    var req entity.RoleRequest                 // Variabel untuk menampung peran baru
+    if err := utils.BindJSON(c, &req); err != nil {  // Binding JSON request ke struct secara ketat
+        utils.HandleError(c, http.StatusBadRequest, err)  // Respons error jika binding gagal
+        return
+    }
+
+    user, err := h.service.SetRole(c.Request.Context(), uint(id), req.Role)  // Memanggil service untuk mengubah peran
+    if err != nil {
+        utils.HandleError(c, http.StatusInternalServerError, err)  // Respons error (404 atau 500)
+        return
+    }
+
+    c.JSON(http.StatusOK, utils.SuccessResponse(user))  // Respons sukses dengan data user
+}
+
+func (h *UserHandler) BulkCreate(c *gin.Context) {  // Handler untuk membuat banyak user sekaligus
+    var req bulk.Request                       // Variabel untuk menampung mode dan item dari request
+    if err := utils.BindJSON(c, &req); err != nil {  // Binding JSON request secara ketat
+        utils.HandleError(c, http.StatusBadRequest, err)  // Respons error jika binding gagal (400, 413, atau 415)
+        return
+    }
+
+    report, err := h.service.BulkCreate(c.Request.Context(), &req)  // Memanggil service untuk membuat user
+    if err != nil {
+        utils.HandleError(c, http.StatusInternalServerError, err)  // Respons error jika request ditolak seluruhnya
+        return
+    }
+
+    bulk.Respond(c, http.StatusCreated, report)  // Laporan per item (201, 207, atau 422)
+}
+
+func (h *UserHandler) BulkUpdate(c *gin.Context) {  // Handler untuk memperbarui banyak user sekaligus
+    var req bulk.Request                       // Variabel untuk menampung mode dan item dari request
+    if err := utils.BindJSON(c, &req); err != nil {  // Binding JSON request secara ketat
+        utils.HandleError(c, http.StatusBadRequest, err)  // Respons error jika binding gagal (400, 413, atau 415)
+        return
+    }
+
+    report, err := h.service.BulkUpdate(c.Request.Context(), &req)  // Memanggil service untuk memperbarui user
+    if err != nil {
+        utils.HandleError(c, http.StatusInternalServerError, err)  // Respons error jika request ditolak seluruhnya
+        return
+    }
+
+    bulk.Respond(c, http.StatusOK, report)     // Laporan per item (200, 207, atau 422)
+}
+
+func (h *UserHandler) BulkDelete(c *gin.Context) {  // Handler untuk menghapus banyak user sekaligus
+    var req bulk.DeleteRequest                 // Variabel untuk menampung mode dan ID dari request
+    if err := utils.BindJSON(c, &req); err != nil {  // Binding JSON request secara ketat
+        utils.HandleError(c, http.StatusBadRequest, err)  // Respons error jika binding gagal (400, 413, atau 415)
+        return
+    }
+
+    report, err := h.service.BulkDelete(c.Request.Context(), &req)  // Memanggil service untuk menghapus user
+    if err != nil {
+        utils.HandleError(c, http.StatusInternalServerError, err)  // Respons error jika request ditolak seluruhnya
+        return
+    }
+
+    bulk.Respond(c, http.StatusOK, report)     // Laporan per item (200, 207, atau 422)
+}
+
 
 // {{{ Penjelasan Fungsi RegisterRoutes }}}
 
@@ -117,6 +219,8 @@ File handler.go ini berisi implementasi handler HTTP untuk modul User. Berikut p
     - GetAll : Mendapatkan semua user
     - Update : Memperbarui user berdasarkan ID dan data JSON request
     - Delete : Menghapus user berdasarkan ID
+    - Login : Memeriksa email dan password lalu mengembalikan token bearer dari auth.Default(), token membawa peran user
+    - SetRole : Mengubah peran user, hanya untuk admin (RequireAdmin di route.go); berlaku pada login berikutnya
 4. Alur Request :
 
     - Menerima HTTP request dari router
@@ -125,7 +229,7 @@ File handler.go ini berisi implementasi handler HTTP untuk modul User. Berikut p
     - Mengembalikan respons HTTP yang sesuai
 5. Penanganan Error :
 
-    - Error binding JSON: Status 400 Bad Request
+    - Error binding JSON: Status 400 Bad Request (field tidak dikenal, tipe salah, JSON rusak), 413 jika body terlalu besar, 415 jika Content-Type bukan JSON
     - Error validasi atau tidak ditemukan: Status 404 Not Found
     - Error internal: Status 500 Internal Server Error
 6. Format Respons :
@@ -1,6 +1,9 @@
 package handler                                // Mendefinisikan package handler untuk modul user
 
 import (
+    "rest-api-go/pkg/bulk"                     // Mengimpor package bulk untuk batas body request bulk
+    "rest-api-go/pkg/middleware"               // Mengimpor middleware untuk batas body request bulk
+
     "github.com/gin-gonic/gin"                 // Mengimpor framework web Gin
 )
 
@@ -8,10 +11,15 @@ func RegisterRoutes(router *gin.RouterGroup, handler *UserHandler) {  // Fungsi
     users := router.Group("/users")            // Membuat grup route dengan prefix "/users"
     {
         users.POST("", handler.Create)         // Mendaftarkan endpoint POST untuk membuat user baru
+        users.POST("/login", handler.Login)    // Mendaftarkan endpoint POST untuk login dan mendapatkan token
         users.GET("/:id", handler.GetByID)     // Mendaftarkan endpoint GET dengan parameter id untuk mendapatkan user berdasarkan ID
         users.GET("", handler.GetAll)          // Mendaftarkan endpoint GET untuk mendapatkan semua user
         users.PUT("/:id", handler.Update)      // Mendaftarkan endpoint PUT dengan parameter id untuk memperbarui user
         users.DELETE("/:id", handler.Delete)   // Mendaftarkan endpoint DELETE dengan parameter id untuk menghapus user
+        users.PUT("/:id/role", middleware.RequireAdmin(), handler.SetRole)  // Mendaftarkan endpoint PUT untuk mengubah peran user, hanya untuk admin
+        users.POST("/bulk", middleware.BodyLimit(bulk.MaxBodyBytes), handler.BulkCreate)  // Mendaftarkan endpoint POST untuk membuat banyak user
+        users.PATCH("/bulk", middleware.BodyLimit(bulk.MaxBodyBytes), handler.BulkUpdate)  // Mendaftarkan endpoint PATCH untuk memperbarui banyak user
+        users.DELETE("/bulk", middleware.BodyLimit(bulk.MaxBodyBytes), handler.BulkDelete)  // Mendaftarkan endpoint DELETE untuk menghapus banyak user
     }
 }
 
@@ -34,6 +42,11 @@ File route.go ini berisi konfigurasi routing untuk modul User. Berikut penjelasa
     - GET /users : Mendapatkan semua user
     - PUT /users/:id : Memperbarui user berdasarkan ID
     - DELETE /users/:id : Menghapus user berdasarkan ID
+    - POST /users/bulk : Membuat banyak user sekaligus, laporan per item
+    - PATCH /users/bulk : Memperbarui banyak user sekaligus, hanya field yang dikirim
+    - DELETE /users/bulk : Menghapus banyak user sekaligus berdasarkan daftar ID
+    - POST /users/login : Login dengan email dan password, mengembalikan token bearer
+    - PUT /users/:id/role : Mengubah peran user (customer, terminal, staff, admin), hanya untuk admin
 4. Parameter URL :
 
     - :id : Parameter dinamis untuk ID user
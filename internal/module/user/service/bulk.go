@@ -0,0 +1,169 @@
+package service                                // Mendefinisikan package service untuk modul user
+
+import (
+    "context"                                 // Package untuk context request
+    "errors"                                  // Package untuk pengecekan error
+    "net/http"                                // Package untuk konstanta HTTP
+    "rest-api-go/internal/module/user/entity"  // Mengimpor entity user
+    "rest-api-go/pkg/auth"                    // Package auth untuk peran user
+    "rest-api-go/pkg/bulk"                    // Package bulk untuk laporan per item
+    "rest-api-go/pkg/tracing"                 // Mengimpor package tracing untuk span service
+    "rest-api-go/pkg/utils"                   // Mengimpor utilitas aplikasi untuk decode JSON
+    "strings"                                 // Package untuk membandingkan email tanpa membedakan huruf besar/kecil
+
+    "gorm.io/gorm"                            // Mengimpor ORM GORM
+)
+
+func (s *UserService) BulkCreate(ctx context.Context, req *bulk.Request) (*bulk.Report, error) {  // Method untuk membuat banyak user sekaligus
+    ctx, span := tracing.Start(ctx, "UserService.BulkCreate")  // Membuat child span untuk method service
+    defer span.End()                          // Menutup span saat method selesai
+
+    if err := req.Validate(); err != nil {    // Validasi mode dan jumlah item
+        return nil, err
+    }
+
+    report := bulk.NewReport(req.Mode, len(req.Items))
+    err := bulk.Run(s.db.WithContext(ctx), report, func(tx *gorm.DB) error {
+        batch := bulk.NewBatch(tx, report, func(u *entity.User) uint { return u.ID }).AfterCreate(func(tx *gorm.DB, u *entity.User) error {
+            return record(tx, EventUserCreated, u)  // Satu event per user yang tersimpan
+        })
+        seen := make(map[string]bool, len(req.Items))  // Email dalam request yang sama, collation MySQL tidak membedakan huruf besar/kecil
+        for i, raw := range req.Items {
+            var user entity.User
+            if err := utils.DecodeJSON(raw, &user); err != nil {  // Field yang tidak dikenal ditolak seperti BindJSON
+                report.Fail(i, err)
+                continue
+            }
+            if err := user.Validate(); err != nil {
+                report.Fail(i, err)
+                continue
+            }
+            email := strings.ToLower(user.Email)
+            if seen[email] {
+                report.Fail(i, ErrEmailTaken)
+                continue
+            }
+            if err := checkEmail(tx, 0, user.Email); err != nil {
+                report.Fail(i, err)
+                continue
+            }
+            if err := user.HashPassword(); err != nil {
+                report.Fail(i, err)
+                continue
+            }
+            seen[email] = true
+            user.ID = 0                       // ID selalu dibuat database
+            user.Role = auth.RoleCustomer     // Sama dengan Create
+            batch.Add(i, &user)
+        }
+        batch.Flush()
+        return nil
+    })
+    return report, err
+}
+
+func (s *UserService) BulkUpdate(ctx context.Context, req *bulk.Request) (*bulk.Report, error) {  // Method untuk memperbarui banyak user sekaligus, hanya field yang dikirim yang berubah
+    ctx, span := tracing.Start(ctx, "UserService.BulkUpdate")  // Membuat child span untuk method service
+    defer span.End()                          // Menutup span saat method selesai
+
+    if err := req.Validate(); err != nil {    // Validasi mode dan jumlah item
+        return nil, err
+    }
+
+    report := bulk.NewReport(req.Mode, len(req.Items))
+    err := bulk.Run(s.db.WithContext(ctx), report, func(tx *gorm.DB) error {
+        for i, raw := range req.Items {
+            bulk.Item(tx, report, i, http.StatusOK, func(tx *gorm.DB) (uint, error) {
+                id, err := bulk.ID(raw)
+                if err != nil {
+                    return 0, err
+                }
+                var user entity.User
+                if err := tx.First(&user, id).Error; err != nil {
+                    if errors.Is(err, gorm.ErrRecordNotFound) {
+                        return 0, ErrUserNotFound
+                    }
+                    return 0, err
+                }
+                sent, err := bulk.Patch(raw, &user)  // Password lama (hash) tetap jika password tidak dikirim
+                if err != nil {
+                    return 0, err
+                }
+                user.ID = id
+                if err := user.Validate(); err != nil {
+                    return 0, err
+                }
+                if sent["email"] {
+                    if err := checkEmail(tx, id, user.Email); err != nil {
+                        return 0, err
+                    }
+                }
+                if sent["password"] {
+                    if err := user.HashPassword(); err != nil {  // Hanya password yang dikirim yang di-hash
+                        return 0, err
+                    }
+                }
+                if err := tx.Save(&user).Error; err != nil {
+                    return 0, err
+                }
+                return id, record(tx, EventUserUpdated, &user)
+            })
+        }
+        return nil
+    })
+    return report, err
+}
+
+func (s *UserService) BulkDelete(ctx context.Context, req *bulk.DeleteRequest) (*bulk.Report, error) {  // Method untuk menghapus banyak user sekaligus
+    ctx, span := tracing.Start(ctx, "UserService.BulkDelete")  // Membuat child span untuk method service
+    defer span.End()                          // Menutup span saat method selesai
+
+    if err := req.Validate(); err != nil {    // Validasi mode dan daftar ID
+        return nil, err
+    }
+
+    report := bulk.NewReport(req.Mode, len(req.IDs))
+    err := bulk.Run(s.db.WithContext(ctx), report, func(tx *gorm.DB) error {
+        for i, id := range req.IDs {
+            bulk.Item(tx, report, i, http.StatusOK, func(tx *gorm.DB) (uint, error) {
+                result := tx.Delete(&entity.User{}, id)
+                if result.Error != nil {
+                    return 0, result.Error
+                }
+                if result.RowsAffected == 0 {
+                    return 0, ErrUserNotFound
+                }
+                return id, recordDeleted(tx, id)
+            })
+        }
+        return nil
+    })
+    return report, err
+}
+
+
+// {{{ Penjelasan Bulk User }}}
+
+/*
+## Penjelasan Detail
+File bulk.go ini berisi operasi bulk untuk user. Berikut penjelasan detailnya:
+
+1. BulkCreate :
+
+    - Setiap item didecode dan divalidasi sendiri, item yang rusak hanya menggagalkan item itu
+    - Item yang lolos disimpan dengan INSERT multi-baris per 500 user
+    - Setiap password di-hash dengan HashPassword sebelum masuk batch
+    - Email yang sudah terdaftar atau muncul dua kali dalam request ditolak dengan 409 per item, sehingga satu email ganda tidak menggagalkan INSERT satu batch
+2. BulkUpdate :
+
+    - Setiap item wajib berisi id dan hanya field yang dikirim yang berubah
+    - Password hanya di-hash jika dikirim, jika tidak hash lama tetap dipakai
+    - Email yang dikirim diperiksa terhadap user lain
+3. BulkDelete :
+
+    - User yang tidak ada dilaporkan 404 per item
+4. Domain Event :
+
+    - Setiap user yang tersimpan, diperbarui, atau dihapus mencatat user.created, user.updated, atau user.deleted di transaksi item tersebut
+5. Mode atomic dan best_effort ditangani oleh bulk.Run, lihat pkg/bulk.
+*/
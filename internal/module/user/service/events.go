@@ -0,0 +1,57 @@
+package service                                // Mendefinisikan package service untuk modul user
+
+import (
+    "rest-api-go/internal/module/user/entity"  // Mengimpor entity user
+    "rest-api-go/pkg/events"                  // Mengimpor outbox domain event
+    "time"                                    // Package time untuk tipe data waktu
+
+    "gorm.io/gorm"                            // Mengimpor ORM GORM
+)
+
+const (
+    EventUserCreated = "user.created"         // User baru disimpan
+    EventUserUpdated = "user.updated"         // User diubah lewat update atau bulk update
+    EventUserDeleted = "user.deleted"         // User dihapus
+
+    eventEntity = "user"                      // Nama entitas di event
+)
+
+type userData struct {                        // Mendefinisikan struct data event user, tanpa email dan password
+    ID        uint      `json:"id"`           // ID user
+    Username  string    `json:"username"`     // Username user
+    CreatedAt time.Time `json:"created_at"`   // Waktu pembuatan record
+    UpdatedAt time.Time `json:"updated_at"`   // Waktu pembaruan record
+}
+
+type deletedData struct {                     // Mendefinisikan struct data event user.deleted
+    ID uint `json:"id"`                       // ID user yang dihapus
+}
+
+func record(tx *gorm.DB, typ string, user *entity.User) error {  // Fungsi untuk mencatat event user di transaksi tx
+    data := userData{ID: user.ID, Username: user.Username, CreatedAt: user.CreatedAt, UpdatedAt: user.UpdatedAt}
+    return events.Record(tx, typ, eventEntity, user.ID, data)
+}
+
+func recordDeleted(tx *gorm.DB, id uint) error {  // Fungsi untuk mencatat user.deleted di transaksi tx
+    return events.Record(tx, EventUserDeleted, eventEntity, id, deletedData{ID: id})
+}
+
+
+// {{{ Penjelasan Event User }}}
+
+/*
+## Penjelasan Detail
+File events.go ini berisi domain event yang dicatat service user. Berikut penjelasan detailnya:
+
+1. Tipe Event :
+
+    - user.created : Create dan bulk create
+    - user.updated : Update dan bulk update; data berisi user setelah perubahan
+    - user.deleted : Delete dan bulk delete, data berisi ID user
+2. Data :
+
+    - Hanya id, username, created_at, dan updated_at; email dan hash password tidak pernah masuk outbox karena event dapat dikirim ke sink dan partner
+3. Transaksi :
+
+    - Event dicatat dengan events.Record di transaksi yang sama dengan perubahan, sehingga perubahan yang di-rollback tidak pernah menghasilkan event
+*/
@@ -1,11 +1,23 @@
 package service                                // Mendefinisikan package service untuk modul user
 
 import (
+    "context"                                 // Package untuk context request
+    "errors"                                  // Package untuk pengecekan error
+    "net/http"                                // Package untuk konstanta HTTP
+    "rest-api-go/pkg/auth"                    // Package auth untuk peran user
+    "rest-api-go/pkg/tracing"                 // Mengimpor package tracing untuk span service
+    "rest-api-go/pkg/utils"                   // Mengimpor utilitas aplikasi untuk HTTPError
     "rest-api-go/internal/module/user/entity"  // Mengimpor entity user
                                               
     "gorm.io/gorm"                            // Mengimpor ORM GORM
 )
 
+var (
+    ErrInvalidCredentials = utils.NewHTTPError(http.StatusUnauthorized, "invalid email or password")  // Email tidak terdaftar atau password salah
+    ErrUserNotFound       = utils.NewHTTPError(http.StatusNotFound, "User not found")  // User tidak ada
+    ErrEmailTaken         = utils.NewHTTPError(http.StatusConflict, "email is already in use")  // Email sudah dipakai user lain
+)
+
 type UserService struct {                      // Mendefinisikan struct service
     db *gorm.DB                               // Dependency database
 }
@@ -14,41 +26,138 @@ func NewUserService(db *gorm.DB) *UserService {  // Constructor untuk service
     return &UserService{db}                   // Mengembalikan instance service dengan database yang diinjeksi
 }
 
-func (s *UserService) Create(user *entity.User) error {  // Method untuk membuat user baru
+func (s *UserService) Create(ctx context.Context, user *entity.User) error {  // Method untuk membuat user baru
+    ctx, span := tracing.Start(ctx, "UserService.Create")  // Membuat child span untuk method service
+    defer span.End()                          // Menutup span saat method selesai
+
     if err := user.Validate(); err != nil {   // Validasi data user
         return err                            // Mengembalikan error jika validasi gagal
     }
-    return s.db.Create(user).Error            // Menyimpan user ke database dan mengembalikan error jika ada
+    return s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+        if err := checkEmail(tx, 0, user.Email); err != nil {
+            return err
+        }
+        if err := user.HashPassword(); err != nil {  // Password dari request selalu di-hash
+            return err
+        }
+        user.Role = auth.RoleCustomer         // User dari API selalu customer, peran lain diberikan admin
+        if err := tx.Create(user).Error; err != nil {  // Menyimpan user ke database dan mengembalikan error jika ada
+            return err
+        }
+        return record(tx, EventUserCreated, user)  // Event user.created hanya ada jika user ikut di-commit
+    })
 }
 
-func (s *UserService) GetByID(id uint) (*entity.User, error) {  // Method untuk mendapatkan user berdasarkan ID
+func (s *UserService) GetByID(ctx context.Context, id uint) (*entity.User, error) {  // Method untuk mendapatkan user berdasarkan ID
+    ctx, span := tracing.Start(ctx, "UserService.GetByID")  // Membuat child span untuk method service
+    defer span.End()                          // Menutup span saat method selesai
+
     var user entity.User                      // Variabel untuk menampung hasil query
-    err := s.db.First(&user, id).Error        // Query user berdasarkan ID
+    err := s.db.WithContext(ctx).First(&user, id).Error        // Query user berdasarkan ID
     return &user, err                         // Mengembalikan user dan error jika ada
 }
 
-func (s *UserService) GetAll() ([]entity.User, error) {  // Method untuk mendapatkan semua user
+func (s *UserService) GetAll(ctx context.Context) ([]entity.User, error) {  // Method untuk mendapatkan semua user
+    ctx, span := tracing.Start(ctx, "UserService.GetAll")  // Membuat child span untuk method service
+    defer span.End()                          // Menutup span saat method selesai
+
     var users []entity.User                   // Variabel untuk menampung hasil query
-    err := s.db.Find(&users).Error            // Query semua user
+    err := s.db.WithContext(ctx).Find(&users).Error            // Query semua user
     return users, err                         // Mengembalikan users dan error jika ada
 }
 
-func (s *UserService) Update(user *entity.User) error {  // Method untuk memperbarui user
+func (s *UserService) Update(ctx context.Context, user *entity.User) error {  // Method untuk memperbarui user
+    ctx, span := tracing.Start(ctx, "UserService.Update")  // Membuat child span untuk method service
+    defer span.End()                          // Menutup span saat method selesai
+
     if err := user.Validate(); err != nil {   // Validasi data user
         return err                            // Mengembalikan error jika validasi gagal
     }
 
     // Cek apakah user ada
     var existingUser entity.User              // Variabel untuk menampung hasil query
-    if err := s.db.First(&existingUser, user.ID).Error; err != nil {  // Query user berdasarkan ID
+    if err := s.db.WithContext(ctx).First(&existingUser, user.ID).Error; err != nil {  // Query user berdasarkan ID
         return err                            // Mengembalikan error jika user tidak ditemukan
     }
 
-    return s.db.Save(user).Error              // Menyimpan perubahan user ke database dan mengembalikan error jika ada
+    return s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+        if err := checkEmail(tx, user.ID, user.Email); err != nil {
+            return err
+        }
+        if err := user.HashPassword(); err != nil {  // PUT selalu mengirim password baru
+            return err
+        }
+        user.Role = existingUser.Role         // Peran hanya diubah lewat SetRole
+        if err := tx.Save(user).Error; err != nil {  // Menyimpan perubahan user ke database dan mengembalikan error jika ada
+            return err
+        }
+        return record(tx, EventUserUpdated, user)
+    })
+}
+
+func (s *UserService) Authenticate(ctx context.Context, req *entity.LoginRequest) (*entity.User, error) {  // Method untuk memeriksa email dan password
+    ctx, span := tracing.Start(ctx, "UserService.Authenticate")  // Membuat child span untuk method service
+    defer span.End()                          // Menutup span saat method selesai
+
+    var user entity.User                      // Variabel untuk menampung hasil query
+    err := s.db.WithContext(ctx).Where("email = ?", req.Email).First(&user).Error  // Query user berdasarkan email
+    if errors.Is(err, gorm.ErrRecordNotFound) {
+        return nil, ErrInvalidCredentials     // Pesan sama dengan password salah agar email terdaftar tidak bisa ditebak
+    }
+    if err != nil {
+        return nil, err
+    }
+    if !user.CheckPassword(req.Password) {
+        return nil, ErrInvalidCredentials
+    }
+    return &user, nil
+}
+
+func (s *UserService) Delete(ctx context.Context, id uint) error {  // Method untuk menghapus user
+    ctx, span := tracing.Start(ctx, "UserService.Delete")  // Membuat child span untuk method service
+    defer span.End()                          // Menutup span saat method selesai
+
+    return s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+        result := tx.Delete(&entity.User{}, id)  // Menghapus user dari database dan mengembalikan error jika ada
+        if result.Error != nil || result.RowsAffected == 0 {
+            return result.Error               // User yang tidak ada tidak menghasilkan event
+        }
+        return recordDeleted(tx, id)
+    })
 }
 
-func (s *UserService) Delete(id uint) error {  // Method untuk menghapus user
-    return s.db.Delete(&entity.User{}, id).Error  // Menghapus user dari database dan mengembalikan error jika ada
+func (s *UserService) SetRole(ctx context.Context, id uint, role auth.Role) (*entity.User, error) {  // Method untuk mengubah peran user
+    ctx, span := tracing.Start(ctx, "UserService.SetRole")  // Membuat child span untuk method service
+    defer span.End()                          // Menutup span saat method selesai
+
+    var user entity.User
+    err := s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+        if err := tx.First(&user, id).Error; err != nil {
+            if errors.Is(err, gorm.ErrRecordNotFound) {
+                return ErrUserNotFound
+            }
+            return err
+        }
+        if err := tx.Model(&user).Update("role", role).Error; err != nil {
+            return err
+        }
+        return record(tx, EventUserUpdated, &user)
+    })
+    if err != nil {
+        return nil, err
+    }
+    return &user, nil
+}
+
+func checkEmail(tx *gorm.DB, id uint, email string) error {  // Fungsi untuk memastikan email belum dipakai user lain, index unik tetap menjadi pengaman terakhir
+    var count int64
+    if err := tx.Model(&entity.User{}).Where("email = ? AND id <> ?", email, id).Count(&count).Error; err != nil {
+        return err
+    }
+    if count > 0 {
+        return ErrEmailTaken
+    }
+    return nil
 }
 
 
@@ -66,11 +175,16 @@ File service.go ini berisi implementasi service untuk modul User. Berikut penjel
     - Repository Pattern : Service bertindak sebagai abstraksi untuk akses data
 3. Operasi CRUD :
 
-    - Create : Membuat user baru setelah validasi
+    - Create : Membuat user baru setelah validasi, email yang sudah dipakai ditolak dengan ErrEmailTaken (409)
     - GetByID : Mendapatkan user berdasarkan ID
     - GetAll : Mendapatkan semua user
-    - Update : Memperbarui user setelah validasi dan pengecekan keberadaan
+    - Update : Memperbarui user setelah validasi dan pengecekan keberadaan, email tidak boleh sama dengan user lain
     - Delete : Menghapus user berdasarkan ID
+    - SetRole : Mengubah peran user; Create selalu membuat customer dan Update mempertahankan peran lama
+    - BulkCreate, BulkUpdate, BulkDelete : Operasi bulk dengan laporan per item, lihat bulk.go
+    - Domain Event : Create, Update, dan Delete mencatat user.created, user.updated, dan user.deleted di outbox dalam transaksi yang sama (events.go)
+    - Authenticate : Mencari user berdasarkan email lalu membandingkan password dengan hash bcrypt, gagal dengan ErrInvalidCredentials (401); email unik sehingga satu email selalu menunjuk satu user
+    - Password : Create dan Update selalu memanggil HashPassword, tidak ada input yang disimpan apa adanya
 4. Fitur GORM :
 
     - First : Mengambil record pertama yang cocok dengan kondisi
@@ -85,5 +199,7 @@ File service.go ini berisi implementasi service untuk modul User. Berikut penjel
 
     - Mengembalikan error dari validasi atau operasi database ke handler
     - Memeriksa keberadaan record sebelum update untuk mencegah error
+    - Setiap method menerima context.Context dari handler dan meneruskannya ke GORM dengan db.WithContext(ctx), sehingga log query membawa request ID yang sama dengan access log
+    - Setiap method membuat span OpenTelemetry bernama <Service>.<Method> sehingga waktu di service terlihat terpisah dari query database
 Service ini mengimplementasikan prinsip "fat model, thin controller" di mana logika bisnis berada di service, sementara handler hanya bertanggung jawab untuk menangani HTTP request/response.
 */
\ No newline at end of file
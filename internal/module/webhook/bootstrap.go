@@ -0,0 +1,51 @@
+package webhook                                // Mendefinisikan package webhook
+
+import (
+	"rest-api-go/internal/module/webhook/handler"  // Mengimpor package handler dari modul webhook
+	"rest-api-go/internal/module/webhook/service"  // Mengimpor package service dari modul webhook
+	"rest-api-go/pkg/events"                       // Mengimpor dispatcher domain event
+	"rest-api-go/pkg/jobs"                         // Mengimpor runner job latar belakang
+
+	"github.com/gin-gonic/gin"                     // Mengimpor framework web Gin
+	"gorm.io/gorm"                                 // Mengimpor ORM GORM
+)
+
+// Initialize - Fungsi untuk menginisialisasi modul webhook
+func Initialize(db *gorm.DB, router *gin.RouterGroup, runner *jobs.Runner, dispatcher *events.Dispatcher, opts service.Options) {  // Fungsi untuk inisialisasi modul dengan parameter database, router, runner job, dispatcher event, dan pengaturan pengiriman
+	// Initialize service
+	webhookService := service.NewWebhookService(db, runner, opts)  // Membuat instance service webhook dengan menyuntikkan database, runner job, dan pengaturan
+	deliverer := service.NewDeliverer(db, opts)    // Membuat pengirim webhook
+
+	// Initialize handler
+	webhookHandler := handler.NewWebhookHandler(webhookService)  // Membuat instance handler dengan menyuntikkan service
+
+	// Register routes
+	handler.RegisterRoutes(router, webhookHandler)     // Mendaftarkan route untuk modul webhook
+
+	// Register jobs and event sink
+	runner.Register(service.DeliverJob, deliverer.Deliver, jobs.TypeOptions{MaxAttempts: opts.MaxAttempts})  // Satu job per event per subscription, retry dengan backoff eksponensial
+	if opts.Retention > 0 {
+		runner.Register(service.CleanupJob, deliverer.Cleanup, jobs.TypeOptions{})
+		runner.Schedule(service.CleanupJob, "@hourly", service.CleanupJob, nil)  // Log pengiriman lama dihapus setiap jam
+	}
+	dispatcher.AddSink(service.NewSink(db, runner))  // Event dari outbox diteruskan ke subscription yang cocok
+}
+
+
+// {{{ Penjelasan Fungsi Initialize }}}
+
+/*
+## Penjelasan Detail
+File bootstrap.go ini berfungsi sebagai titik masuk (entry point) untuk modul webhook. Berikut penjelasan detailnya:
+
+1. Tujuan : Menghubungkan service, handler, route, job, dan sink event modul webhook dengan pola Dependency Injection.
+2. Alur Kerja :
+
+	- Mendaftarkan endpoint subscription dan log pengiriman di bawah /webhooks
+	- Mendaftarkan job webhook.deliver dengan MaxAttempts dari WEBHOOK_MAX_ATTEMPTS, dan webhook.cleanup jika WEBHOOK_RETENTION diisi
+	- Menambahkan sink "webhooks" ke dispatcher event, sehingga domain event sampai ke partner; event user dan order hanya ke subscription milik staff
+	- opts.AllowPrivate (WEBHOOK_ALLOW_PRIVATE) dipakai service saat validasi URL dan deliverer saat membuka koneksi
+3. Hubungan dengan Aplikasi Utama :
+
+	- Fungsi Initialize dipanggil dari main.go sebelum runner.Start dan dispatcher.Start
+*/
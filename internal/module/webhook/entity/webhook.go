@@ -0,0 +1,103 @@
+package entity                                // Mendefinisikan package entity untuk modul webhook
+
+import (
+    "rest-api-go/pkg/validation"              // Package validation dengan validator bersama
+    "time"                                    // Package time untuk tipe data waktu
+)
+
+const (
+    DefaultListLimit = 50                     // Jumlah delivery per halaman jika ?limit tidak diisi
+    MaxListLimit     = 500                    // Jumlah delivery maksimum per halaman
+)
+
+type Subscription struct {                    // Mendefinisikan struct Subscription (endpoint partner yang menerima event)
+    ID             uint       `json:"id" gorm:"primaryKey"`  // ID subscription sebagai primary key
+    OwnerID        uint       `json:"owner_id" gorm:"not null;default:0;index"`  // User yang membuat subscription, 0 untuk subscription lama yang hanya terlihat oleh admin
+    URL            string     `json:"url" gorm:"size:2048;not null"`  // Endpoint partner yang menerima POST
+    Description    string     `json:"description" gorm:"size:255"`  // Keterangan bebas, misal nama partner
+    Events         []string   `json:"events" gorm:"serializer:json;type:text;not null"`  // Pola tipe event, misal ["product.*", "category.deleted"]
+    Secret         string     `json:"-" gorm:"size:128;not null"`  // Kunci HMAC, hanya ditampilkan saat dibuat atau diganti
+    Active         bool       `json:"active" gorm:"not null;default:true"`  // Subscription nonaktif tidak menerima event
+    FailureCount   int        `json:"failure_count" gorm:"not null;default:0"`  // Jumlah percobaan gagal berturut-turut
+    DisabledAt     *time.Time `json:"disabled_at,omitempty"`  // Waktu dinonaktifkan otomatis
+    DisabledReason string     `json:"disabled_reason,omitempty" gorm:"size:255"`  // Alasan dinonaktifkan otomatis
+    LastDeliveryAt *time.Time `json:"last_delivery_at,omitempty"`  // Waktu percobaan pengiriman terakhir
+    CreatedAt      time.Time  `json:"created_at"`  // Waktu pembuatan record
+    UpdatedAt      time.Time  `json:"updated_at"`  // Waktu pembaruan record
+}
+
+func (Subscription) TableName() string {      // Nama tabel subscription
+    return "webhook_subscriptions"
+}
+
+type SubscriptionWithSecret struct {          // Mendefinisikan struct respons yang menyertakan secret
+    Subscription
+    Secret string `json:"secret"`             // Secret untuk memverifikasi tanda tangan, hanya dikirim sekali
+}
+
+type SubscriptionRequest struct {             // Mendefinisikan struct body request untuk membuat atau mengganti subscription
+    URL         string   `json:"url" binding:"required,http_url,max=2048"`  // Endpoint partner
+    Description string   `json:"description" binding:"max=255"`  // Keterangan bebas
+    Events      []string `json:"events" binding:"required,min=1,max=50,unique,dive,required,eventpattern"`  // Pola tipe event
+    Active      *bool    `json:"active"`      // Kosong berarti aktif saat dibuat dan tidak berubah saat diganti
+}
+
+func (r *SubscriptionRequest) Validate() error {  // Method untuk validasi struct SubscriptionRequest
+    return validation.Struct(r)               // Memvalidasi struct berdasarkan tag binding dengan validator bersama
+}
+
+type Delivery struct {                        // Mendefinisikan struct Delivery (satu percobaan pengiriman)
+    ID             uint      `json:"id" gorm:"primaryKey"`  // ID delivery sebagai primary key
+    SubscriptionID uint      `json:"subscription_id" gorm:"not null;index"`  // Subscription tujuan
+    EventID        uint      `json:"event_id" gorm:"not null;index"`  // Event di outbox
+    EventType      string    `json:"event_type" gorm:"size:128;not null"`  // Tipe event
+    Attempt        int       `json:"attempt" gorm:"not null"`  // Percobaan ke berapa, dimulai dari 1
+    Redelivery     bool      `json:"redelivery" gorm:"not null;default:false"`  // Dikirim ulang secara manual
+    Success        bool      `json:"success" gorm:"not null"`  // Respons 2xx
+    StatusCode     int       `json:"status_code,omitempty"`  // Status HTTP, kosong jika tidak ada respons
+    ResponseBody   string    `json:"response_body,omitempty" gorm:"type:text"`  // Awal body respons, hanya ditampilkan ke pemilik subscription
+    Error          string    `json:"error,omitempty" gorm:"type:text"`  // Error jaringan atau timeout
+    DurationMS     int64     `json:"duration_ms" gorm:"not null"`  // Lama request dalam milidetik
+    CreatedAt      time.Time `json:"created_at" gorm:"index"`  // Waktu percobaan
+}
+
+func (Delivery) TableName() string {          // Nama tabel log pengiriman
+    return "webhook_deliveries"
+}
+
+type DeliveryFilter struct {                  // Mendefinisikan struct filter log pengiriman
+    SubscriptionID uint                       // Dari URL /webhooks/:id/deliveries
+    EventID        uint                       // ?event_id=
+    Success        *bool                      // ?success=true atau false
+    BeforeID       uint                       // ?before_id=, halaman berikutnya dimulai dari ID terkecil halaman sebelumnya
+    Limit          int                        // ?limit=, 1 sampai MaxListLimit
+}
+
+
+//  {{{ Penjelasan Struktur Webhook }}}
+
+/*
+## Penjelasan Detail
+File webhook.go ini mendefinisikan struktur data untuk webhook keluar. Berikut penjelasan detailnya:
+
+1. Subscription :
+
+    - Endpoint partner beserta pola event yang diminati, memakai format yang sama dengan events.Match
+    - OwnerID adalah user yang membuatnya; user lain tidak dapat melihat atau mengubahnya, kecuali admin
+    - Secret dibuat server, tidak pernah ikut di JSON Subscription, dan hanya dikirim lewat SubscriptionWithSecret
+    - FailureCount direset setiap pengiriman berhasil; jika mencapai batas, Active menjadi false dan DisabledAt serta DisabledReason diisi
+2. SubscriptionRequest :
+
+    - Body untuk membuat (POST) dan mengganti (PUT) subscription
+    - URL harus http atau https, Events berisi 1 sampai 50 pola yang berbeda
+    - Host URL juga harus alamat publik, dicek di service (lihat address.go)
+    - Active kosong berarti aktif saat dibuat dan tidak berubah saat diganti; active true juga mengaktifkan kembali subscription yang dinonaktifkan otomatis
+3. Delivery :
+
+    - Satu baris per percobaan, termasuk retry dan pengiriman ulang manual
+    - StatusCode 0 berarti tidak ada respons (jaringan atau timeout), lihat Error
+    - ResponseBody dipotong agar log tidak membengkak, dan dikosongkan di respons API untuk selain pemilik subscription
+4. DeliveryFilter :
+
+    - Filter log pengiriman satu subscription dengan halaman berbasis before_id
+*/
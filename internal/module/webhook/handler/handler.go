@@ -0,0 +1,223 @@
+package handler                                // Mendefinisikan package handler untuk modul webhook
+
+import (
+    "fmt"                                      // Package untuk formatting pesan error
+    "net/http"                                 // Package untuk konstanta HTTP
+    "rest-api-go/internal/module/webhook/entity"   // Mengimpor entity webhook
+    "rest-api-go/internal/module/webhook/service"  // Mengimpor service webhook
+    "rest-api-go/pkg/middleware"               // Mengimpor middleware untuk user dan peran yang login
+    "rest-api-go/pkg/utils"                    // Mengimpor utilitas aplikasi
+    "strconv"                                  // Package untuk konversi string
+
+    "github.com/gin-gonic/gin"                 // Framework web Gin
+)
+
+type WebhookHandler struct {                   // Mendefinisikan struct handler
+    service *service.WebhookService            // Dependency service
+}
+
+func NewWebhookHandler(service *service.WebhookService) *WebhookHandler {  // Constructor untuk handler
+    return &WebhookHandler{service}            // Mengembalikan instance handler dengan service yang diinjeksi
+}
+
+func (h *WebhookHandler) Create(c *gin.Context) {  // Handler untuk membuat subscription baru
+    var req entity.SubscriptionRequest         // Variabel untuk menampung data subscription dari request
+    if err := utils.BindJSON(c, &req); err != nil {  // Binding JSON request ke struct secara ketat
+        utils.HandleError(c, http.StatusBadRequest, err)  // Respons error jika binding gagal (400, 413, atau 415)
+        return
+    }
+
+    sub, err := h.service.Create(c.Request.Context(), &req, caller(c))  // Memanggil service untuk membuat subscription
+    if err != nil {
+        utils.HandleError(c, http.StatusInternalServerError, err)  // Respons error (403, 422, atau 500)
+        return
+    }
+
+    c.JSON(http.StatusCreated, utils.SuccessResponse(sub))  // Respons sukses dengan subscription dan secret
+}
+
+func (h *WebhookHandler) GetAll(c *gin.Context) {  // Handler untuk mendapatkan semua subscription
+    subs, err := h.service.GetAll(c.Request.Context(), caller(c))  // Memanggil service untuk mendapatkan subscription
+    if err != nil {
+        utils.HandleError(c, http.StatusInternalServerError, err)  // Respons error jika gagal
+        return
+    }
+
+    c.JSON(http.StatusOK, utils.SuccessResponse(subs))  // Respons sukses dengan daftar subscription
+}
+
+func (h *WebhookHandler) GetByID(c *gin.Context) {  // Handler untuk mendapatkan subscription berdasarkan ID
+    id, ok := parseID(c, "id")
+    if !ok {
+        return
+    }
+
+    sub, err := h.service.GetByID(c.Request.Context(), id, caller(c))  // Memanggil service untuk mendapatkan subscription
+    if err != nil {
+        utils.HandleError(c, http.StatusInternalServerError, err)  // Respons error (404 atau 500)
+        return
+    }
+
+    c.JSON(http.StatusOK, utils.SuccessResponse(sub))  // Respons sukses dengan data subscription
+}
+
+func (h *WebhookHandler) Update(c *gin.Context) {  // Handler untuk mengganti subscription
+    id, ok := parseID(c, "id")
+    if !ok {
+        return
+    }
+
+    var req entity.SubscriptionRequest         // Variabel untuk menampung data subscription dari request
+    if err := utils.BindJSON(c, &req); err != nil {  // Binding JSON request ke struct secara ketat
+        utils.HandleError(c, http.StatusBadRequest, err)  // Respons error jika binding gagal (400, 413, atau 415)
+        return
+    }
+
+    sub, err := h.service.Update(c.Request.Context(), id, &req, caller(c))  // Memanggil service untuk mengganti subscription
+    if err != nil {
+        utils.HandleError(c, http.StatusInternalServerError, err)  // Respons error (403, 404, 422, atau 500)
+        return
+    }
+
+    c.JSON(http.StatusOK, utils.SuccessResponse(sub))  // Respons sukses dengan data subscription yang diperbarui
+}
+
+func (h *WebhookHandler) Delete(c *gin.Context) {  // Handler untuk menghapus subscription
+    id, ok := parseID(c, "id")
+    if !ok {
+        return
+    }
+
+    if err := h.service.Delete(c.Request.Context(), id, caller(c)); err != nil {  // Memanggil service untuk menghapus subscription
+        utils.HandleError(c, http.StatusInternalServerError, err)  // Respons error jika gagal
+        return
+    }
+
+    c.JSON(http.StatusOK, utils.SuccessResponse("Webhook subscription deleted successfully"))  // Respons sukses dengan pesan
+}
+
+func (h *WebhookHandler) RotateSecret(c *gin.Context) {  // Handler untuk mengganti secret subscription
+    id, ok := parseID(c, "id")
+    if !ok {
+        return
+    }
+
+    sub, err := h.service.RotateSecret(c.Request.Context(), id, caller(c))  // Memanggil service untuk membuat secret baru
+    if err != nil {
+        utils.HandleError(c, http.StatusInternalServerError, err)  // Respons error (403, 404, atau 500)
+        return
+    }
+
+    c.JSON(http.StatusOK, utils.SuccessResponse(sub))  // Respons sukses dengan subscription dan secret baru
+}
+
+func (h *WebhookHandler) Deliveries(c *gin.Context) {  // Handler untuk mendapatkan log pengiriman subscription
+    id, ok := parseID(c, "id")
+    if !ok {
+        return
+    }
+
+    filter := entity.DeliveryFilter{SubscriptionID: id, Limit: entity.DefaultListLimit}
+    for _, param := range []struct {
+        name   string
+        target *uint
+    }{{"event_id", &filter.EventID}, {"before_id", &filter.BeforeID}} {
+        raw := c.Query(param.name)
+        if raw == "" {
+            continue
+        }
+        value, err := strconv.ParseUint(raw, 10, 32)
+        if err != nil {
+            utils.ErrorJSON(c, http.StatusBadRequest, fmt.Sprintf("%s must be a positive integer", param.name))
+            return
+        }
+        *param.target = uint(value)
+    }
+    if raw := c.Query("success"); raw != "" {
+        success, err := strconv.ParseBool(raw)
+        if err != nil {
+            utils.ErrorJSON(c, http.StatusBadRequest, "success must be true or false")
+            return
+        }
+        filter.Success = &success
+    }
+    if raw := c.Query("limit"); raw != "" {
+        limit, err := strconv.Atoi(raw)
+        if err != nil || limit < 1 || limit > entity.MaxListLimit {
+            utils.ErrorJSON(c, http.StatusBadRequest, fmt.Sprintf("limit must be between 1 and %d", entity.MaxListLimit))
+            return
+        }
+        filter.Limit = limit
+    }
+
+    list, err := h.service.Deliveries(c.Request.Context(), filter, caller(c))  // Memanggil service untuk mendapatkan log pengiriman
+    if err != nil {
+        utils.HandleError(c, http.StatusInternalServerError, err)  // Respons error (404 atau 500)
+        return
+    }
+
+    c.JSON(http.StatusOK, utils.SuccessResponse(list))  // Respons sukses dengan daftar percobaan
+}
+
+func (h *WebhookHandler) Redeliver(c *gin.Context) {  // Handler untuk mengirim ulang event dari sebuah delivery
+    id, ok := parseID(c, "id")
+    if !ok {
+        return
+    }
+    deliveryID, ok := parseID(c, "delivery_id")
+    if !ok {
+        return
+    }
+
+    job, err := h.service.Redeliver(c.Request.Context(), id, deliveryID, caller(c))  // Memanggil service untuk membuat job pengiriman ulang
+    if err != nil {
+        utils.HandleError(c, http.StatusInternalServerError, err)  // Respons error (404, 409, 410, atau 500)
+        return
+    }
+
+    c.JSON(http.StatusAccepted, utils.SuccessResponse(job))  // Respons 202 dengan job yang dapat dipantau di /api/jobs/:id
+}
+
+func caller(c *gin.Context) service.Caller {   // Fungsi untuk membaca user dan peran yang login
+    userID, _ := middleware.CurrentUserID(c)   // Semua route webhook memakai RequireAuth
+    return service.Caller{UserID: userID, Role: middleware.CurrentRole(c)}
+}
+
+func parseID(c *gin.Context, name string) (uint, bool) {  // Fungsi untuk membaca parameter ID dari URL
+    id, err := strconv.ParseUint(c.Param(name), 10, 32)
+    if err != nil {
+        utils.ErrorJSON(c, http.StatusBadRequest, "Invalid ID")  // Respons error jika ID tidak valid
+        return 0, false
+    }
+    return uint(id), true
+}
+
+
+// {{{ Penjelasan Fungsi Handler }}}
+
+/*
+## Penjelasan Detail
+File handler.go ini berisi implementasi handler HTTP untuk modul Webhook. Berikut penjelasan detailnya:
+
+1. Subscription :
+
+    - Semua handler meneruskan user dan peran yang login ke service (caller); non-admin hanya melihat subscription miliknya
+    - Create : Membuat subscription milik user yang login, respons 201 berisi secret yang hanya ditampilkan sekali
+    - GetAll, GetByID : Membaca subscription tanpa secret
+    - Update : Mengganti url, description, events, dan active
+    - Delete : Menghapus subscription beserta log pengirimannya
+    - RotateSecret : Membuat secret baru, respons berisi secret tersebut
+2. Log Pengiriman :
+
+    - Deliveries : Percobaan terbaru dengan ?event_id, ?success, ?limit (1-500, default 50), dan ?before_id; response_body hanya untuk pemilik
+    - Redeliver : Mengirim ulang event, respons 202 berisi job webhook.deliver yang dibuat
+3. Penanganan Error :
+
+    - Body, query string, atau ID tidak valid: Status 400 Bad Request
+    - Pola event user atau order dari non-staff, atau mengganti secret milik user lain: Status 403 Forbidden
+    - Subscription atau delivery tidak ditemukan, termasuk milik user lain: Status 404 Not Found
+    - Pengiriman ulang ke subscription nonaktif: Status 409 Conflict
+    - Event sudah dihapus dari outbox: Status 410 Gone
+    - URL ke alamat yang tidak publik atau tidak dapat di-resolve: Status 422 Unprocessable Entity
+    - Error internal: Status 500 Internal Server Error
+*/
@@ -0,0 +1,48 @@
+package handler                                // Mendefinisikan package handler untuk modul webhook
+
+import (
+    "rest-api-go/pkg/middleware"               // Mengimpor middleware RequireAuth
+
+    "github.com/gin-gonic/gin"                 // Mengimpor framework web Gin
+)
+
+func RegisterRoutes(router *gin.RouterGroup, handler *WebhookHandler) {  // Fungsi untuk mendaftarkan route
+    webhooks := router.Group("/webhooks", middleware.RequireAuth())  // Membuat grup route dengan prefix "/webhooks", wajib login karena mengatur ke mana data dikirim
+    {
+        webhooks.POST("", handler.Create)      // Mendaftarkan endpoint POST untuk membuat subscription
+        webhooks.GET("", handler.GetAll)       // Mendaftarkan endpoint GET untuk mendapatkan semua subscription
+        webhooks.GET("/:id", handler.GetByID)  // Mendaftarkan endpoint GET untuk mendapatkan subscription berdasarkan ID
+        webhooks.PUT("/:id", handler.Update)   // Mendaftarkan endpoint PUT untuk mengganti subscription
+        webhooks.DELETE("/:id", handler.Delete)  // Mendaftarkan endpoint DELETE untuk menghapus subscription
+        webhooks.POST("/:id/rotate-secret", handler.RotateSecret)  // Mendaftarkan endpoint POST untuk mengganti secret
+        webhooks.GET("/:id/deliveries", handler.Deliveries)  // Mendaftarkan endpoint GET untuk log pengiriman
+        webhooks.POST("/:id/deliveries/:delivery_id/redeliver", handler.Redeliver)  // Mendaftarkan endpoint POST untuk mengirim ulang event
+    }
+}
+
+
+// {{{ Penjelasan Fungsi RegisterRoutes }}}
+
+/*
+## Penjelasan Detail
+File route.go ini berisi konfigurasi routing untuk modul Webhook. Berikut penjelasan detailnya:
+
+1. Endpoint API :
+
+    - POST /webhooks : Membuat subscription, secret dikembalikan sekali
+    - GET /webhooks : Mendapatkan subscription milik user yang login (semua untuk admin)
+    - GET /webhooks/:id : Mendapatkan satu subscription
+    - PUT /webhooks/:id : Mengganti url, description, events, dan active
+    - DELETE /webhooks/:id : Menghapus subscription
+    - POST /webhooks/:id/rotate-secret : Membuat secret baru
+    - GET /webhooks/:id/deliveries : Log pengiriman terbaru
+    - POST /webhooks/:id/deliveries/:delivery_id/redeliver : Mengirim ulang event dari sebuah delivery
+2. Autentikasi :
+
+    - Semua endpoint memakai middleware.RequireAuth, request tanpa token bearer yang valid dijawab 401
+    - Setiap subscription punya pemilik; subscription milik user lain dijawab 404, kecuali untuk admin
+3. Parameter URL :
+
+    - :id : Parameter dinamis untuk ID subscription
+    - :delivery_id : Parameter dinamis untuk ID delivery milik subscription tersebut
+*/
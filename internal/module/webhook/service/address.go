@@ -0,0 +1,92 @@
+package service                                // Mendefinisikan package service untuk modul webhook
+
+import (
+    "context"                                 // Package untuk context resolusi DNS
+    "fmt"                                     // Package untuk formatting pesan error
+    "net"                                     // Package untuk resolver DNS
+    "net/http"                                // Package untuk konstanta HTTP
+    "net/netip"                               // Package untuk alamat IP
+    "net/url"                                 // Package untuk membaca host URL
+    "rest-api-go/pkg/utils"                   // Mengimpor utilitas aplikasi untuk HTTPError
+    "syscall"                                 // Package untuk tipe RawConn di Dialer.Control
+)
+
+var (
+    ErrPrivateURL      = utils.NewHTTPError(http.StatusUnprocessableEntity, "webhook url must point to a public address")  // Host mengarah ke loopback, jaringan privat, atau link-local
+    ErrUnresolvableURL = utils.NewHTTPError(http.StatusUnprocessableEntity, "webhook url host cannot be resolved")  // Host tidak ditemukan di DNS
+)
+
+var reservedPrefixes = []netip.Prefix{        // Rentang khusus yang tidak tercakup method netip.Addr
+    netip.MustParsePrefix("0.0.0.0/8"),       // "Jaringan ini"
+    netip.MustParsePrefix("100.64.0.0/10"),   // Carrier-grade NAT
+    netip.MustParsePrefix("192.0.0.0/24"),    // Penugasan protokol IETF
+    netip.MustParsePrefix("198.18.0.0/15"),   // Benchmark jaringan
+    netip.MustParsePrefix("240.0.0.0/4"),     // Dicadangkan, termasuk broadcast
+}
+
+// publicAddr - true jika ip boleh menjadi tujuan webhook (bukan loopback, privat, link-local, multicast, atau rentang khusus)
+func publicAddr(ip netip.Addr) bool {
+    ip = ip.Unmap()                           // ::ffff:127.0.0.1 diperlakukan sama dengan 127.0.0.1
+    if !ip.IsValid() || ip.IsLoopback() || ip.IsPrivate() || ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast() ||
+        ip.IsInterfaceLocalMulticast() || ip.IsMulticast() || ip.IsUnspecified() {
+        return false
+    }
+    for _, prefix := range reservedPrefixes {
+        if prefix.Contains(ip) {
+            return false
+        }
+    }
+    return true
+}
+
+func checkURL(ctx context.Context, raw string) error {  // Fungsi untuk memastikan semua alamat host URL publik
+    u, err := url.Parse(raw)
+    if err != nil {
+        return ErrPrivateURL                  // Sudah divalidasi http_url, seharusnya tidak terjadi
+    }
+    addrs, err := net.DefaultResolver.LookupNetIP(ctx, "ip", u.Hostname())  // IP literal dikembalikan apa adanya
+    if err != nil || len(addrs) == 0 {
+        return ErrUnresolvableURL
+    }
+    for _, addr := range addrs {
+        if !publicAddr(addr) {
+            return ErrPrivateURL
+        }
+    }
+    return nil
+}
+
+func dialControl(_, address string, _ syscall.RawConn) error {  // Dialer.Control yang menolak koneksi ke alamat non-publik setelah DNS di-resolve
+    addrPort, err := netip.ParseAddrPort(address)
+    if err != nil {
+        return err
+    }
+    if !publicAddr(addrPort.Addr()) {
+        return fmt.Errorf("webhooks: %s is not a public address", addrPort.Addr())
+    }
+    return nil
+}
+
+
+// {{{ Penjelasan Alamat Tujuan }}}
+
+/*
+## Penjelasan Detail
+File address.go ini membatasi tujuan webhook ke alamat publik agar API tidak dapat dipakai untuk menjangkau jaringan internal (SSRF). Berikut penjelasan detailnya:
+
+1. publicAddr :
+
+    - Menolak loopback (127.0.0.0/8, ::1), jaringan privat (10/8, 172.16/12, 192.168/16, fc00::/7), link-local (169.254/16 termasuk metadata cloud 169.254.169.254, fe80::/10), multicast, unspecified, dan rentang khusus di reservedPrefixes
+    - Alamat IPv4 yang dibungkus IPv6 (::ffff:a.b.c.d) dicek sebagai IPv4
+2. checkURL :
+
+    - Dipanggil saat subscription dibuat atau diganti; host di-resolve dan semua alamatnya harus publik (422)
+    - Host yang tidak dapat di-resolve juga ditolak (422)
+3. dialControl :
+
+    - Dipasang di net.Dialer milik Deliverer, sehingga alamat dicek lagi setiap kali koneksi dibuka
+    - Menutup celah DNS rebinding, yaitu host yang saat divalidasi mengarah ke IP publik lalu diganti ke IP internal
+4. WEBHOOK_ALLOW_PRIVATE :
+
+    - true mematikan kedua pengecekan, hanya untuk pengembangan lokal dengan penerima di localhost
+*/
@@ -0,0 +1,79 @@
+package service                                // Mendefinisikan package service untuk modul webhook
+
+import (
+    "context"                                 // Package untuk context resolusi DNS
+    "errors"                                  // Package untuk pengecekan error
+    "net/netip"                               // Package untuk alamat IP
+    "testing"                                 // Package testing bawaan Go
+)
+
+func TestPublicAddr(t *testing.T) {
+    tests := []struct {
+        ip   string
+        want bool
+    }{
+        {"93.184.216.34", true},
+        {"2606:2800:220:1:248:1893:25c8:1946", true},
+        {"127.0.0.1", false},
+        {"::1", false},
+        {"10.1.2.3", false},
+        {"172.16.0.1", false},
+        {"192.168.1.10", false},
+        {"169.254.169.254", false},
+        {"fe80::1", false},
+        {"fd00::1", false},
+        {"0.0.0.0", false},
+        {"100.64.0.1", false},
+        {"224.0.0.1", false},
+        {"255.255.255.255", false},
+        {"::ffff:127.0.0.1", false},
+        {"::ffff:10.0.0.1", false},
+    }
+    for _, tt := range tests {
+        if got := publicAddr(netip.MustParseAddr(tt.ip)); got != tt.want {
+            t.Errorf("publicAddr(%s) = %v, want %v", tt.ip, got, tt.want)
+        }
+    }
+}
+
+func TestCheckURL(t *testing.T) {
+    tests := []struct {
+        url  string
+        want error
+    }{
+        {"https://93.184.216.34/hooks", nil},
+        {"http://127.0.0.1:8080/hooks", ErrPrivateURL},
+        {"http://[::1]/hooks", ErrPrivateURL},
+        {"http://169.254.169.254/latest/meta-data", ErrPrivateURL},
+        {"https://192.168.0.5/hooks", ErrPrivateURL},
+        {"http://localhost/hooks", ErrPrivateURL},  // Resolver lokal, tanpa jaringan
+    }
+    for _, tt := range tests {
+        if err := checkURL(context.Background(), tt.url); !errors.Is(err, tt.want) {
+            t.Errorf("checkURL(%s) = %v, want %v", tt.url, err, tt.want)
+        }
+    }
+}
+
+func TestDialControl(t *testing.T) {
+    if err := dialControl("tcp", "93.184.216.34:443", nil); err != nil {
+        t.Errorf("public address rejected: %v", err)
+    }
+    for _, address := range []string{"127.0.0.1:80", "[::1]:443", "169.254.169.254:80", "10.0.0.8:8443"} {
+        if err := dialControl("tcp", address, nil); err == nil {
+            t.Errorf("dialControl(%s) allowed a non-public address", address)
+        }
+    }
+}
+
+
+// {{{ Penjelasan Test Alamat Tujuan }}}
+
+/*
+## Penjelasan Detail
+File address_test.go ini menguji pembatasan tujuan webhook di address.go. Berikut penjelasan detailnya:
+
+1. TestPublicAddr : Alamat publik IPv4 dan IPv6 diterima; loopback, privat, link-local, multicast, broadcast, dan IPv4 yang dibungkus IPv6 ditolak
+2. TestCheckURL : Hanya memakai IP literal dan localhost sehingga tidak butuh DNS jaringan
+3. TestDialControl : Alamat yang sudah di-resolve ditolak saat koneksi dibuka
+*/
@@ -0,0 +1,294 @@
+package service                                // Mendefinisikan package service untuk modul webhook
+
+import (
+    "bytes"                                   // Package untuk body request HTTP
+    "context"                                 // Package untuk context job
+    "crypto/hmac"                             // Package untuk tanda tangan HMAC
+    "crypto/sha256"                           // Fungsi hash untuk HMAC-SHA256
+    "encoding/hex"                            // Package untuk encoding tanda tangan
+    "encoding/json"                           // Package untuk encoding event
+    "errors"                                  // Package untuk pengecekan error
+    "fmt"                                     // Package untuk formatting pesan error
+    "io"                                      // Package untuk membaca body respons
+    "log/slog"                                // Package structured logging bawaan Go
+    "net"                                     // Package untuk dialer dengan pengecekan alamat
+    "net/http"                                // Package client HTTP
+    userEntity "rest-api-go/internal/module/user/entity"  // Mengimpor entity user untuk peran pemilik subscription
+    "rest-api-go/internal/module/webhook/entity"  // Mengimpor entity webhook
+    "rest-api-go/pkg/auth"                    // Mengimpor peran user
+    "rest-api-go/pkg/events"                  // Mengimpor outbox event
+    "rest-api-go/pkg/jobs"                    // Mengimpor runner job
+    "slices"                                  // Package untuk mencocokkan pola event
+    "strconv"                                 // Package untuk header timestamp
+    "strings"                                 // Package untuk membersihkan body respons
+    "time"                                    // Package untuk timestamp dan durasi
+
+    "gorm.io/gorm"                            // Mengimpor ORM GORM
+    "gorm.io/gorm/clause"                     // Klausa SELECT ... FOR UPDATE
+)
+
+const (
+    DeliverJob = "webhook.deliver"            // Tipe job untuk mengirim satu event ke satu subscription
+    CleanupJob = "webhook.cleanup"            // Tipe job untuk menghapus log pengiriman lama
+
+    maxResponseBody = 1 << 10                 // Body respons yang disimpan di log pengiriman
+)
+
+// Options - pengaturan pengiriman webhook
+type Options struct {
+    Timeout      time.Duration                // Batas waktu satu request ke partner
+    MaxAttempts  int                          // Jumlah percobaan per event sebelum job menjadi dead
+    DisableAfter int                          // Jumlah percobaan gagal berturut-turut sebelum subscription dinonaktifkan, 0 berarti tidak pernah
+    Retention    time.Duration                // Lama log pengiriman disimpan, 0 berarti tidak dihapus
+    AllowPrivate bool                         // Mengizinkan URL ke alamat privat dan loopback, hanya untuk pengembangan lokal
+}
+
+type deliveryPayload struct {                 // Mendefinisikan struct payload job DeliverJob
+    SubscriptionID uint `json:"subscription_id"`  // Subscription tujuan
+    EventID        uint `json:"event_id"`     // Event di outbox
+    Redelivery     bool `json:"redelivery,omitempty"`  // Dibuat lewat endpoint redeliver
+}
+
+// Sink - sink domain event yang membuat satu job pengiriman per subscription yang cocok
+type Sink struct {
+    db     *gorm.DB                           // Dependency database
+    runner *jobs.Runner                       // Runner job pengiriman
+}
+
+func NewSink(db *gorm.DB, runner *jobs.Runner) *Sink {  // Constructor untuk sink
+    return &Sink{db, runner}
+}
+
+func (s *Sink) Name() string {                // Nama sink di job events.deliver
+    return "webhooks"
+}
+
+func (s *Sink) Publish(ctx context.Context, event *events.Event) error {  // Membuat job pengiriman untuk setiap subscription aktif yang cocok, dalam satu transaksi
+    return s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+        query := tx.Where("active = ?", true)
+        if staffOnlyEntity(event.EntityType) {
+            query = query.Where("owner_id IN (?)", staffUsers(tx))  // Data user dan order hanya dikirim ke subscription milik staff
+        }
+        var subs []entity.Subscription
+        if err := query.Find(&subs).Error; err != nil {
+            return err
+        }
+        for _, sub := range subs {
+            if !slices.ContainsFunc(sub.Events, func(pattern string) bool { return events.Match(pattern, event.Type) }) {
+                continue
+            }
+            if _, err := s.runner.EnqueueTx(tx, DeliverJob, deliveryPayload{SubscriptionID: sub.ID, EventID: event.ID}); err != nil {
+                return err
+            }
+        }
+        return nil
+    })
+}
+
+// Deliverer - handler job yang mengirim event ke partner dan mencatat hasilnya
+type Deliverer struct {
+    db     *gorm.DB                           // Dependency database
+    client *http.Client                       // Client dengan timeout, tanpa mengikuti redirect
+    opts   Options                            // Pengaturan pengiriman
+}
+
+func NewDeliverer(db *gorm.DB, opts Options) *Deliverer {  // Constructor untuk deliverer
+    dialer := &net.Dialer{Timeout: 30 * time.Second, KeepAlive: 30 * time.Second}
+    if !opts.AllowPrivate {
+        dialer.Control = dialControl          // Alamat dicek setelah DNS di-resolve, termasuk saat DNS rebinding
+    }
+    transport := http.DefaultTransport.(*http.Transport).Clone()
+    transport.Proxy = nil                     // Koneksi selalu langsung ke partner agar pengecekan alamat tidak dilewati lewat proxy
+    transport.DialContext = dialer.DialContext
+    client := &http.Client{
+        Timeout:   opts.Timeout,
+        Transport: transport,
+        CheckRedirect: func(*http.Request, []*http.Request) error {
+            return http.ErrUseLastResponse    // Redirect dianggap gagal agar POST tidak berubah menjadi GET ke URL lain
+        },
+    }
+    return &Deliverer{db: db, client: client, opts: opts}
+}
+
+func (d *Deliverer) Deliver(ctx context.Context, job *jobs.Job) error {  // Handler job DeliverJob
+    var payload deliveryPayload
+    if err := job.Decode(&payload); err != nil {
+        return jobs.Permanent(err)            // Payload rusak tidak akan berhasil walau dicoba lagi
+    }
+
+    db := d.db.WithContext(ctx)
+    var sub entity.Subscription
+    if err := db.First(&sub, payload.SubscriptionID).Error; err != nil {
+        if errors.Is(err, gorm.ErrRecordNotFound) {
+            return jobs.Permanent(fmt.Errorf("webhooks: subscription %d no longer exists", payload.SubscriptionID))
+        }
+        return err
+    }
+    if !sub.Active {
+        return jobs.Permanent(fmt.Errorf("webhooks: subscription %d is disabled", sub.ID))
+    }
+    var event events.Event
+    if err := db.First(&event, payload.EventID).Error; err != nil {
+        if errors.Is(err, gorm.ErrRecordNotFound) {
+            return jobs.Permanent(fmt.Errorf("webhooks: event %d no longer exists", payload.EventID))
+        }
+        return err
+    }
+    if staffOnlyEntity(event.EntityType) {        // Dicek lagi karena peran pemilik bisa turun setelah job dibuat atau saat pengiriman ulang
+        var count int64
+        if err := staffUsers(db).Where("id = ?", sub.OwnerID).Count(&count).Error; err != nil {
+            return err
+        }
+        if count == 0 {
+            return jobs.Permanent(fmt.Errorf("webhooks: owner of subscription %d may not receive %s events", sub.ID, event.EntityType))
+        }
+    }
+
+    delivery := d.send(ctx, &sub, &event)
+    delivery.Attempt = job.Attempts
+    delivery.Redelivery = payload.Redelivery
+    disabled, err := d.record(ctx, &delivery)
+    if err != nil {
+        return err
+    }
+    if delivery.Success {
+        return nil
+    }
+
+    failure := fmt.Errorf("webhooks: %s: %s", sub.URL, delivery.Error)
+    if delivery.StatusCode != 0 {
+        failure = fmt.Errorf("webhooks: %s responded %d", sub.URL, delivery.StatusCode)
+    }
+    if disabled {
+        return jobs.Permanent(failure)        // Subscription baru saja dinonaktifkan, tidak perlu dicoba lagi
+    }
+    return failure                            // Dicoba lagi dengan backoff eksponensial oleh runner
+}
+
+func (d *Deliverer) send(ctx context.Context, sub *entity.Subscription, event *events.Event) entity.Delivery {  // Fungsi untuk mengirim satu request bertanda tangan
+    delivery := entity.Delivery{SubscriptionID: sub.ID, EventID: event.ID, EventType: event.Type}
+    body, err := json.Marshal(event)
+    if err != nil {
+        delivery.Error = err.Error()
+        return delivery
+    }
+    req, err := http.NewRequestWithContext(ctx, http.MethodPost, sub.URL, bytes.NewReader(body))
+    if err != nil {
+        delivery.Error = err.Error()
+        return delivery
+    }
+    timestamp := time.Now().Unix()
+    req.Header.Set("Content-Type", "application/json")
+    req.Header.Set("User-Agent", "rest-api-go-webhooks")
+    req.Header.Set("X-Webhook-Event-ID", strconv.FormatUint(uint64(event.ID), 10))  // Sama untuk retry dan pengiriman ulang, dipakai partner untuk mengabaikan duplikat
+    req.Header.Set("X-Webhook-Event", event.Type)
+    req.Header.Set("X-Webhook-Timestamp", strconv.FormatInt(timestamp, 10))
+    req.Header.Set("X-Webhook-Signature", "sha256="+Sign(sub.Secret, timestamp, body))
+
+    start := time.Now()
+    resp, err := d.client.Do(req)
+    delivery.DurationMS = time.Since(start).Milliseconds()
+    if err != nil {
+        delivery.Error = err.Error()          // Jaringan, TLS, atau timeout
+        return delivery
+    }
+    defer resp.Body.Close()
+    respBody, _ := io.ReadAll(io.LimitReader(resp.Body, maxResponseBody))
+    io.Copy(io.Discard, io.LimitReader(resp.Body, 64<<10))  // Koneksi dapat dipakai ulang
+
+    delivery.StatusCode = resp.StatusCode
+    delivery.ResponseBody = strings.ToValidUTF8(string(respBody), "")  // Body dipotong bisa berakhir di tengah karakter
+    delivery.Success = resp.StatusCode >= 200 && resp.StatusCode < 300
+    return delivery
+}
+
+func (d *Deliverer) record(ctx context.Context, delivery *entity.Delivery) (bool, error) {  // Fungsi untuk menyimpan log dan memperbarui hitungan gagal; true jika subscription dinonaktifkan
+    disabled := false
+    err := d.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+        if err := tx.Create(delivery).Error; err != nil {
+            return err
+        }
+        var sub entity.Subscription
+        if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&sub, delivery.SubscriptionID).Error; err != nil {
+            return err
+        }
+
+        now := time.Now()
+        updates := map[string]any{"last_delivery_at": now, "failure_count": 0}
+        if !delivery.Success {
+            updates["failure_count"] = sub.FailureCount + 1
+            reason := ""
+            switch {
+            case delivery.StatusCode == http.StatusGone:
+                reason = "endpoint responded 410 Gone"  // Partner menyatakan endpoint sudah tidak dipakai
+            case d.opts.DisableAfter > 0 && sub.FailureCount+1 >= d.opts.DisableAfter:
+                reason = fmt.Sprintf("%d consecutive failed deliveries", sub.FailureCount+1)
+            }
+            if reason != "" && sub.Active {
+                updates["active"] = false
+                updates["disabled_at"] = now
+                updates["disabled_reason"] = reason
+                disabled = true
+                slog.WarnContext(ctx, "webhook subscription disabled", "subscription_id", sub.ID, "url", sub.URL, "reason", reason)
+            }
+        }
+        return tx.Model(&sub).UpdateColumns(updates).Error  // updated_at hanya berubah saat subscription diubah lewat API
+    })
+    return disabled, err
+}
+
+func (d *Deliverer) Cleanup(ctx context.Context, _ *jobs.Job) error {  // Handler job CleanupJob
+    cutoff := time.Now().Add(-d.opts.Retention)
+    result := d.db.WithContext(ctx).Where("created_at < ?", cutoff).Delete(&entity.Delivery{})
+    if result.Error == nil && result.RowsAffected > 0 {
+        slog.InfoContext(ctx, "old webhook deliveries deleted", "count", result.RowsAffected)
+    }
+    return result.Error
+}
+
+func staffOnlyEntity(name string) bool {       // Fungsi untuk entity event yang berisi data pribadi pelanggan
+    return name == "user" || name == "order"
+}
+
+func staffUsers(tx *gorm.DB) *gorm.DB {        // Fungsi untuk subquery ID user yang saat ini staff atau admin
+    return tx.Model(&userEntity.User{}).Select("id").Where("role IN ?", auth.StaffRoles)
+}
+
+// Sign - tanda tangan HMAC-SHA256 (hex) atas "<timestamp>.<body>" dengan secret subscription
+func Sign(secret string, timestamp int64, body []byte) string {
+    mac := hmac.New(sha256.New, []byte(secret))
+    mac.Write([]byte(strconv.FormatInt(timestamp, 10)))
+    mac.Write([]byte("."))
+    mac.Write(body)
+    return hex.EncodeToString(mac.Sum(nil))
+}
+
+
+// {{{ Penjelasan Pengiriman Webhook }}}
+
+/*
+## Penjelasan Detail
+File deliver.go ini berisi pengiriman webhook ke partner. Berikut penjelasan detailnya:
+
+1. Sink :
+
+    - Didaftarkan ke events.Dispatcher dengan nama "webhooks"
+    - Untuk setiap event, membuat satu job webhook.deliver per subscription aktif yang polanya cocok, semuanya dalam satu transaksi
+    - Setiap subscription punya job sendiri, sehingga partner yang gagal tidak menunda atau menggandakan pengiriman ke partner lain
+    - Event entity user dan order (email, alamat, isi order) hanya untuk subscription yang pemiliknya saat ini staff atau admin menurut tabel users
+2. Deliverer :
+
+    - Mengirim JSON event (sama dengan body sink http) sebagai POST dengan header X-Webhook-Event-ID, X-Webhook-Event, X-Webhook-Timestamp, dan X-Webhook-Signature
+    - Respons 2xx berarti berhasil; status lain, redirect, timeout, dan error jaringan dicoba lagi dengan backoff eksponensial runner job sampai MaxAttempts
+    - Setiap percobaan dicatat di webhook_deliveries beserta status, awal body respons, error, dan durasi
+    - Koneksi dibuka lewat net.Dialer dengan dialControl (lihat address.go) dan tanpa proxy, sehingga alamat privat, loopback, dan link-local ditolak walau DNS berubah setelah validasi
+    - Peran pemilik dicek lagi sebelum mengirim event user dan order; jika bukan staff lagi, job berhenti dengan jobs.Permanent tanpa mengirim
+3. Penonaktifan Otomatis :
+
+    - FailureCount bertambah setiap percobaan gagal dan kembali 0 setelah satu pengiriman berhasil
+    - Subscription dinonaktifkan jika FailureCount mencapai DisableAfter atau partner menjawab 410 Gone
+    - Job yang masih antre untuk subscription nonaktif atau yang sudah dihapus berhenti dengan jobs.Permanent
+4. Sign :
+
+    - sha256=hex(HMAC-SHA256(secret, timestamp + "." + body)); timestamp ikut ditandatangani agar partner dapat menolak request lama yang diputar ulang
+*/
@@ -0,0 +1,130 @@
+package service                                // Mendefinisikan package service untuk modul webhook
+
+import (
+    "context"                                 // Package untuk context request
+    "crypto/hmac"                             // Package untuk memverifikasi tanda tangan seperti partner
+    "crypto/sha256"                           // Fungsi hash untuk HMAC-SHA256
+    "encoding/hex"                            // Package untuk encoding tanda tangan
+    "io"                                      // Package untuk membaca body request
+    "net/http"                                // Package untuk konstanta HTTP
+    "net/http/httptest"                       // Server HTTP lokal sebagai partner
+    "rest-api-go/internal/module/webhook/entity"  // Mengimpor entity webhook
+    "rest-api-go/pkg/events"                  // Mengimpor outbox event
+    "strings"                                 // Package untuk body respons panjang
+    "testing"                                 // Package testing bawaan Go
+    "time"                                    // Package untuk timeout client
+)
+
+func TestSign(t *testing.T) {
+    tests := []struct {
+        secret    string
+        timestamp int64
+        body      string
+        want      string
+    }{
+        {"whsec_test", 1760864400, `{"id":1}`, "d8388eff5a13dd55d653c94bb17ca78279aecc8a6678243fceff7f27d5d9e8e9"},
+        {"whsec_test", 1760864400, ``, "7e15dca6dcbd02fa8162868602c95621a470ee3c0ceb1121155175a4b4b5ed72"},
+    }
+    for _, tt := range tests {
+        if got := Sign(tt.secret, tt.timestamp, []byte(tt.body)); got != tt.want {
+            t.Errorf("Sign(%q, %d, %q) = %s, want %s", tt.secret, tt.timestamp, tt.body, got, tt.want)
+        }
+    }
+
+    base := Sign("whsec_test", 1760864400, []byte(`{"id":1}`))
+    for name, other := range map[string]string{
+        "secret":    Sign("whsec_other", 1760864400, []byte(`{"id":1}`)),
+        "timestamp": Sign("whsec_test", 1760864401, []byte(`{"id":1}`)),  // Request lama yang diputar ulang dengan timestamp baru tidak cocok
+        "body":      Sign("whsec_test", 1760864400, []byte(`{"id":2}`)),
+    } {
+        if other == base {
+            t.Errorf("changing the %s did not change the signature", name)
+        }
+    }
+}
+
+func TestDeliverySend(t *testing.T) {
+    tests := []struct {
+        name    string
+        status  int
+        body    string
+        success bool
+    }{
+        {"ok", http.StatusOK, "received", true},
+        {"no content", http.StatusNoContent, "", true},
+        {"server error", http.StatusServiceUnavailable, "upstream unavailable", false},
+        {"gone", http.StatusGone, "", false},
+        {"redirect", http.StatusFound, "", false},  // Redirect tidak diikuti dan dianggap gagal
+        {"long body", http.StatusOK, strings.Repeat("x", 4*maxResponseBody), true},
+    }
+    for _, tt := range tests {
+        t.Run(tt.name, func(t *testing.T) {
+            var header http.Header
+            var body []byte
+            server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+                header, body = r.Header.Clone(), must(io.ReadAll(r.Body))
+                if tt.status == http.StatusFound {
+                    w.Header().Set("Location", "/elsewhere")
+                }
+                w.WriteHeader(tt.status)
+                io.WriteString(w, tt.body)
+            }))
+            defer server.Close()
+
+            d := NewDeliverer(nil, Options{Timeout: 5 * time.Second, AllowPrivate: true})  // httptest mendengarkan di 127.0.0.1
+            sub := &entity.Subscription{ID: 3, URL: server.URL, Secret: "whsec_test"}
+            event := &events.Event{ID: 5012, Type: "product.price_changed", EntityType: "product", EntityID: 42, Data: []byte(`{}`)}
+            delivery := d.send(context.Background(), sub, event)
+
+            if delivery.Success != tt.success || delivery.StatusCode != tt.status || delivery.Error != "" {
+                t.Fatalf("send = success %v status %d error %q, want success %v status %d", delivery.Success, delivery.StatusCode, delivery.Error, tt.success, tt.status)
+            }
+            if len(delivery.ResponseBody) > maxResponseBody || !strings.HasPrefix(tt.body, delivery.ResponseBody) {
+                t.Errorf("response body %d bytes, want the first %d bytes of the response", len(delivery.ResponseBody), maxResponseBody)
+            }
+            if header.Get("X-Webhook-Event-ID") != "5012" || header.Get("X-Webhook-Event") != "product.price_changed" {
+                t.Errorf("event headers = %q, %q", header.Get("X-Webhook-Event-ID"), header.Get("X-Webhook-Event"))
+            }
+
+            mac := hmac.New(sha256.New, []byte(sub.Secret))  // Verifikasi seperti contoh penerima di README
+            mac.Write([]byte(header.Get("X-Webhook-Timestamp") + "."))
+            mac.Write(body)
+            expected := "sha256=" + hex.EncodeToString(mac.Sum(nil))
+            if !hmac.Equal([]byte(expected), []byte(header.Get("X-Webhook-Signature"))) {
+                t.Errorf("signature %q does not verify, want %q", header.Get("X-Webhook-Signature"), expected)
+            }
+        })
+    }
+}
+
+func TestDeliverySendBlocksPrivate(t *testing.T) {
+    called := false
+    server := httptest.NewServer(http.HandlerFunc(func(http.ResponseWriter, *http.Request) { called = true }))
+    defer server.Close()
+
+    d := NewDeliverer(nil, Options{Timeout: 5 * time.Second})  // Tanpa AllowPrivate, alamat loopback ditolak saat dial
+    delivery := d.send(context.Background(), &entity.Subscription{ID: 3, URL: server.URL, Secret: "whsec_test"}, &events.Event{ID: 1, Type: "product.created"})
+    if called || delivery.Success || !strings.Contains(delivery.Error, "not a public address") {
+        t.Errorf("send to %s = called %v, error %q; want blocked before connecting", server.URL, called, delivery.Error)
+    }
+}
+
+func must[T any](value T, err error) T {      // Fungsi helper untuk nilai yang tidak boleh error di test
+    if err != nil {
+        panic(err)
+    }
+    return value
+}
+
+
+// {{{ Penjelasan Test Pengiriman Webhook }}}
+
+/*
+## Penjelasan Detail
+File deliver_test.go ini menguji tanda tangan dan pengiriman webhook tanpa database. Berikut penjelasan detailnya:
+
+1. TestSign : Vektor HMAC-SHA256 yang dihitung terpisah, dan tanda tangan berubah jika secret, timestamp, atau body berubah
+2. TestDeliverySend : Partner palsu dari httptest; 2xx berhasil, status lain dan redirect gagal, body respons dipotong maxResponseBody, dan header tanda tangan dapat diverifikasi seperti contoh di README
+3. TestDeliverySendBlocksPrivate : Tanpa AllowPrivate, dialControl menolak 127.0.0.1 sebelum request terkirim
+Jadwal retry berasal dari runner job, lihat TestBackoff di pkg/jobs.
+*/
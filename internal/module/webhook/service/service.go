@@ -0,0 +1,312 @@
+package service                                // Mendefinisikan package service untuk modul webhook
+
+import (
+    "context"                                 // Package untuk context request
+    "crypto/rand"                             // Package untuk secret acak
+    "encoding/hex"                            // Package untuk encoding secret
+    "errors"                                  // Package untuk pengecekan error
+    "net/http"                                // Package untuk konstanta HTTP
+    "strings"                                 // Package untuk membaca segmen pola event
+    "rest-api-go/internal/module/webhook/entity"  // Mengimpor entity webhook
+    "rest-api-go/pkg/auth"                    // Mengimpor peran user
+    "rest-api-go/pkg/events"                  // Mengimpor outbox event untuk pengiriman ulang
+    "rest-api-go/pkg/jobs"                    // Mengimpor runner job untuk pengiriman ulang
+    "rest-api-go/pkg/tracing"                 // Mengimpor package tracing untuk span service
+    "rest-api-go/pkg/utils"                   // Mengimpor utilitas aplikasi untuk HTTPError
+
+    "gorm.io/gorm"                            // Mengimpor ORM GORM
+    "gorm.io/gorm/clause"                     // Klausa SELECT ... FOR UPDATE
+)
+
+const secretPrefix = "whsec_"                 // Awalan secret agar mudah dikenali di konfigurasi partner
+
+var (
+    ErrSubscriptionNotFound = utils.NewHTTPError(http.StatusNotFound, "Webhook subscription not found")  // Subscription tidak ada
+    ErrDeliveryNotFound     = utils.NewHTTPError(http.StatusNotFound, "Webhook delivery not found")  // Delivery tidak ada
+    ErrSubscriptionInactive = utils.NewHTTPError(http.StatusConflict, "webhook subscription is disabled, set active to true first")  // Pengiriman ulang ke subscription nonaktif
+    ErrEventExpired         = utils.NewHTTPError(http.StatusGone, "event is no longer available for redelivery")  // Event sudah dihapus dari outbox
+    ErrStaffOnlyEvents      = utils.NewHTTPError(http.StatusForbidden, "user and order events are only available to staff")  // Pola event user.* atau order.* dari non-staff
+    ErrNotOwner             = utils.NewHTTPError(http.StatusForbidden, "only the owner can rotate the webhook secret")  // Admin mengganti secret subscription milik user lain
+)
+
+// Caller - user yang memanggil API webhook
+type Caller struct {
+    UserID uint                               // Pemilik subscription yang dibuat
+    Role   auth.Role                          // Admin melihat dan mengatur semua subscription
+}
+
+func (c Caller) scope(db *gorm.DB) *gorm.DB {  // Method untuk membatasi query ke subscription milik caller, kecuali admin
+    if c.Role.IsAdmin() {
+        return db
+    }
+    return db.Where("owner_id = ?", c.UserID)  // Subscription milik user lain dianggap tidak ada
+}
+
+func (c Caller) owns(sub *entity.Subscription) bool {  // Method untuk mengecek apakah caller pemilik subscription
+    return sub.OwnerID == c.UserID
+}
+
+type WebhookService struct {                   // Mendefinisikan struct service
+    db     *gorm.DB                           // Dependency database
+    runner *jobs.Runner                       // Runner untuk job pengiriman ulang
+    opts   Options                            // Pengaturan pengiriman, dipakai untuk AllowPrivate
+}
+
+func NewWebhookService(db *gorm.DB, runner *jobs.Runner, opts Options) *WebhookService {  // Constructor untuk service
+    return &WebhookService{db, runner, opts}  // Mengembalikan instance service dengan database, runner, dan pengaturan yang diinjeksi
+}
+
+func (s *WebhookService) validate(ctx context.Context, req *entity.SubscriptionRequest, caller Caller) error {  // Method untuk validasi body, pola event sesuai peran, dan alamat URL
+    if err := req.Validate(); err != nil {    // Validasi data subscription
+        return err
+    }
+    if !caller.Role.IsStaff() {
+        for _, pattern := range req.Events {
+            if staffOnlyEntity(strings.SplitN(pattern, ".", 2)[0]) {
+                return ErrStaffOnlyEvents
+            }
+        }
+    }
+    if s.opts.AllowPrivate {
+        return nil
+    }
+    return checkURL(ctx, req.URL)
+}
+
+func (s *WebhookService) Create(ctx context.Context, req *entity.SubscriptionRequest, caller Caller) (*entity.SubscriptionWithSecret, error) {  // Method untuk membuat subscription baru milik caller beserta secret
+    ctx, span := tracing.Start(ctx, "WebhookService.Create")  // Membuat child span untuk method service
+    defer span.End()                          // Menutup span saat method selesai
+
+    if err := s.validate(ctx, req, caller); err != nil {
+        return nil, err
+    }
+
+    sub := entity.Subscription{
+        OwnerID:     caller.UserID,
+        URL:         req.URL,
+        Description: req.Description,
+        Events:      req.Events,
+        Secret:      newSecret(),
+        Active:      req.Active == nil || *req.Active,
+    }
+    err := s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+        if err := tx.Create(&sub).Error; err != nil {
+            return err
+        }
+        if !sub.Active {                      // Nilai false dilewati GORM saat Create karena kolom punya default true
+            return tx.Model(&sub).Update("active", false).Error
+        }
+        return nil
+    })
+    if err != nil {
+        return nil, err
+    }
+    return &entity.SubscriptionWithSecret{Subscription: sub, Secret: sub.Secret}, nil
+}
+
+func (s *WebhookService) GetByID(ctx context.Context, id uint, caller Caller) (*entity.Subscription, error) {  // Method untuk mendapatkan subscription berdasarkan ID
+    ctx, span := tracing.Start(ctx, "WebhookService.GetByID")  // Membuat child span untuk method service
+    defer span.End()                          // Menutup span saat method selesai
+
+    var sub entity.Subscription
+    err := caller.scope(s.db.WithContext(ctx)).First(&sub, id).Error
+    if errors.Is(err, gorm.ErrRecordNotFound) {
+        return nil, ErrSubscriptionNotFound
+    }
+    if err != nil {
+        return nil, err
+    }
+    return &sub, nil
+}
+
+func (s *WebhookService) GetAll(ctx context.Context, caller Caller) ([]entity.Subscription, error) {  // Method untuk mendapatkan subscription milik caller, atau semua untuk admin
+    ctx, span := tracing.Start(ctx, "WebhookService.GetAll")  // Membuat child span untuk method service
+    defer span.End()                          // Menutup span saat method selesai
+
+    subs := []entity.Subscription{}
+    err := caller.scope(s.db.WithContext(ctx)).Order("id").Find(&subs).Error
+    return subs, err
+}
+
+func (s *WebhookService) Update(ctx context.Context, id uint, req *entity.SubscriptionRequest, caller Caller) (*entity.Subscription, error) {  // Method untuk mengganti URL, event, dan status subscription
+    ctx, span := tracing.Start(ctx, "WebhookService.Update")  // Membuat child span untuk method service
+    defer span.End()                          // Menutup span saat method selesai
+
+    if err := s.validate(ctx, req, caller); err != nil {
+        return nil, err
+    }
+
+    var sub entity.Subscription
+    err := s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+        if err := lockSubscription(caller.scope(tx), id, &sub); err != nil {
+            return err
+        }
+        sub.URL = req.URL
+        sub.Description = req.Description
+        sub.Events = req.Events
+        if req.Active != nil {
+            if *req.Active && !sub.Active {   // Mengaktifkan kembali, termasuk subscription yang dinonaktifkan otomatis
+                sub.FailureCount = 0
+                sub.DisabledAt = nil
+                sub.DisabledReason = ""
+            }
+            sub.Active = *req.Active
+        }
+        return tx.Save(&sub).Error
+    })
+    if err != nil {
+        return nil, err
+    }
+    return &sub, nil
+}
+
+func (s *WebhookService) Delete(ctx context.Context, id uint, caller Caller) error {  // Method untuk menghapus subscription beserta log pengirimannya
+    ctx, span := tracing.Start(ctx, "WebhookService.Delete")  // Membuat child span untuk method service
+    defer span.End()                          // Menutup span saat method selesai
+
+    return s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+        var sub entity.Subscription
+        if err := lockSubscription(caller.scope(tx), id, &sub); err != nil {
+            return err
+        }
+        if err := tx.Where("subscription_id = ?", id).Delete(&entity.Delivery{}).Error; err != nil {
+            return err
+        }
+        return tx.Delete(&sub).Error          // Job pengiriman yang masih antre berhenti dengan error permanen
+    })
+}
+
+func (s *WebhookService) RotateSecret(ctx context.Context, id uint, caller Caller) (*entity.SubscriptionWithSecret, error) {  // Method untuk mengganti secret subscription, hanya oleh pemiliknya
+    ctx, span := tracing.Start(ctx, "WebhookService.RotateSecret")  // Membuat child span untuk method service
+    defer span.End()                          // Menutup span saat method selesai
+
+    var sub entity.Subscription
+    err := s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+        if err := lockSubscription(caller.scope(tx), id, &sub); err != nil {
+            return err
+        }
+        if !caller.owns(&sub) {               // Admin boleh melihat, tetapi secret hanya untuk pemilik
+            return ErrNotOwner
+        }
+        sub.Secret = newSecret()
+        return tx.Model(&sub).Update("secret", sub.Secret).Error
+    })
+    if err != nil {
+        return nil, err
+    }
+    return &entity.SubscriptionWithSecret{Subscription: sub, Secret: sub.Secret}, nil
+}
+
+func (s *WebhookService) Deliveries(ctx context.Context, filter entity.DeliveryFilter, caller Caller) ([]entity.Delivery, error) {  // Method untuk mendapatkan log pengiriman terbaru satu subscription
+    ctx, span := tracing.Start(ctx, "WebhookService.Deliveries")  // Membuat child span untuk method service
+    defer span.End()                          // Menutup span saat method selesai
+
+    sub, err := s.GetByID(ctx, filter.SubscriptionID, caller)
+    if err != nil {
+        return nil, err
+    }
+
+    query := s.db.WithContext(ctx).Where("subscription_id = ?", filter.SubscriptionID).Order("id DESC").Limit(filter.Limit)
+    if filter.EventID > 0 {
+        query = query.Where("event_id = ?", filter.EventID)
+    }
+    if filter.Success != nil {
+        query = query.Where("success = ?", *filter.Success)
+    }
+    if filter.BeforeID > 0 {
+        query = query.Where("id < ?", filter.BeforeID)
+    }
+    list := []entity.Delivery{}               // Slice kosong agar JSON berisi [] bukan null
+    if err := query.Find(&list).Error; err != nil {
+        return nil, err
+    }
+    if !caller.owns(sub) {
+        for i := range list {
+            list[i].ResponseBody = ""         // Body respons partner hanya untuk pemilik subscription
+        }
+    }
+    return list, nil
+}
+
+func (s *WebhookService) Redeliver(ctx context.Context, subscriptionID, deliveryID uint, caller Caller) (*jobs.Job, error) {  // Method untuk mengirim ulang event dari sebuah delivery
+    ctx, span := tracing.Start(ctx, "WebhookService.Redeliver")  // Membuat child span untuk method service
+    defer span.End()                          // Menutup span saat method selesai
+
+    var job *jobs.Job
+    err := s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+        var sub entity.Subscription
+        if err := lockSubscription(caller.scope(tx), subscriptionID, &sub); err != nil {
+            return err
+        }
+        var delivery entity.Delivery
+        if err := tx.Where("subscription_id = ?", subscriptionID).First(&delivery, deliveryID).Error; err != nil {
+            if errors.Is(err, gorm.ErrRecordNotFound) {
+                return ErrDeliveryNotFound
+            }
+            return err
+        }
+        if !sub.Active {
+            return ErrSubscriptionInactive
+        }
+        var count int64
+        if err := tx.Model(&events.Event{}).Where("id = ?", delivery.EventID).Count(&count).Error; err != nil {
+            return err
+        }
+        if count == 0 {
+            return ErrEventExpired
+        }
+
+        var err error
+        job, err = s.runner.EnqueueTx(tx, DeliverJob, deliveryPayload{SubscriptionID: sub.ID, EventID: delivery.EventID, Redelivery: true})
+        return err
+    })
+    return job, err
+}
+
+func lockSubscription(tx *gorm.DB, id uint, sub *entity.Subscription) error {  // Fungsi untuk membaca dan mengunci subscription di transaksi tx
+    err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(sub, id).Error
+    if errors.Is(err, gorm.ErrRecordNotFound) {
+        return ErrSubscriptionNotFound
+    }
+    return err
+}
+
+func newSecret() string {                     // Fungsi untuk membuat secret HMAC acak 256 bit
+    b := make([]byte, 32)
+    _, _ = rand.Read(b)
+    return secretPrefix + hex.EncodeToString(b)
+}
+
+
+// {{{ Penjelasan Fungsi Service }}}
+
+/*
+## Penjelasan Detail
+File service.go ini berisi implementasi service untuk modul Webhook. Berikut penjelasan detailnya:
+
+1. Pemilik :
+
+    - Setiap method menerima Caller (user dan peran dari token); subscription dibuat dengan OwnerID caller
+    - Non-admin hanya melihat dan mengatur subscription miliknya; milik user lain dijawab 404 seperti tidak ada
+    - Admin melihat dan mengatur semua subscription, tetapi tidak bisa mengganti secret milik user lain dan tidak melihat body respons partner mereka
+2. Subscription :
+
+    - Create : Membuat subscription dengan secret acak (awalan whsec_), secret dikembalikan sekali di respons
+    - GetByID, GetAll : Membaca subscription tanpa secret
+    - Update : Mengganti URL, deskripsi, dan pola event; active true mengaktifkan kembali dan mereset FailureCount
+    - Delete : Menghapus subscription beserta log pengirimannya
+    - RotateSecret : Membuat secret baru; secret lama langsung tidak berlaku
+    - Create dan Update menolak pola event dengan segmen pertama user atau order dari non-staff (403), dan URL yang host-nya tidak publik (422, lihat address.go)
+3. Log Pengiriman :
+
+    - Deliveries : Percobaan terbaru satu subscription dengan filter event_id, success, dan halaman berbasis before_id; response_body dikosongkan jika caller bukan pemilik
+    - Redeliver : Membuat job webhook.deliver baru untuk event dari sebuah delivery, dengan jumlah percobaan sendiri
+4. Penanganan Error :
+
+    - Pola event user atau order dari non-staff, atau admin mengganti secret milik user lain: 403
+    - URL ke alamat privat, loopback, link-local, atau host yang tidak dapat di-resolve: 422
+    - Subscription atau delivery tidak ditemukan: 404
+    - Pengiriman ulang ke subscription nonaktif: 409
+    - Event sudah dihapus dari outbox (lihat EVENT_RETENTION): 410
+Pengiriman itu sendiri ada di deliver.go dan berjalan sebagai job latar belakang.
+*/
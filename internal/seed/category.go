@@ -6,6 +6,7 @@ import (
     "log"                                     // Package untuk logging
     "os"                                      // Package untuk operasi sistem
     "rest-api-go/internal/module/category/entity"  // Mengimpor entity category
+    "rest-api-go/pkg/slug"                    // Mengimpor tabel riwayat slug
 
     "gorm.io/gorm"                            // Mengimpor ORM GORM
 )
@@ -13,14 +14,14 @@ import (
 // Categories - fungsi untuk seed data category
 func Categories(db *gorm.DB) {                // Fungsi untuk seed data category dengan parameter database
     // Drop table if exists
-    err := db.Migrator().DropTable(&entity.Category{})  // Menghapus tabel category jika ada
+    err := db.Migrator().DropTable(&slug.Redirect{}, &entity.Category{})  // Menghapus tabel category dan riwayat slug jika ada
     if err != nil {
         log.Fatal("Error dropping table:", err)  // Log error dan hentikan program jika gagal
     }
     fmt.Println("🗑️  Old category tables dropped successfully")  // Pesan sukses menghapus tabel
 
     // Auto migrate
-    err = db.AutoMigrate(&entity.Category{})  // Membuat tabel category berdasarkan struct entity
+    err = db.AutoMigrate(&slug.Redirect{}, &entity.Category{})  // Membuat tabel category dan riwayat slug berdasarkan struct entity
     if err != nil {
         log.Fatal("Error migrating category table:", err)  // Log error dan hentikan program jika gagal
     }
@@ -60,11 +61,11 @@ File category.go ini berisi fungsi untuk melakukan seed data category ke dalam d
 1. Tujuan : File ini digunakan untuk mengisi database dengan data awal (seed data) untuk entitas Category.
 2. Alur Kerja :
 
-    - Menghapus tabel Category yang sudah ada (jika ada)
-    - Membuat tabel baru berdasarkan struktur entity Category
+    - Menghapus tabel Category dan slug_redirects yang sudah ada (jika ada)
+    - Membuat tabel baru berdasarkan struktur entity Category, beserta slug_redirects karena hook BeforeCreate memeriksa slug lama
     - Membaca data dari file JSON
     - Mengkonversi data JSON ke slice struct Category
-    - Menyimpan data Category ke database
+    - Menyimpan data Category ke database satu per satu sehingga nama yang sama mendapat slug berbeda (amet, amet-2, ...)
 3. Fitur Database :
 
     - DropTable : Menghapus tabel yang sudah ada
@@ -5,6 +5,7 @@ import (
     "fmt"                                     // Package untuk formatting dan output
     "log"                                     // Package untuk logging
     "os"                                      // Package untuk operasi sistem
+    inventoryEntity "rest-api-go/internal/module/inventory/entity"  // Mengimpor entity inventory untuk ledger stok
     "rest-api-go/internal/module/product/entity"  // Mengimpor entity product
 
     "gorm.io/gorm"                            // Mengimpor ORM GORM
@@ -13,14 +14,14 @@ import (
 // Products - fungsi untuk seed data product
 func Products(db *gorm.DB) {                  // Fungsi untuk seed data product dengan parameter database
     // Drop table if exists
-    err := db.Migrator().DropTable(&entity.Product{})  // Menghapus tabel product jika ada
+    err := db.Migrator().DropTable(&inventoryEntity.StockMovement{}, &entity.VariantValue{}, &entity.ProductVariant{}, &entity.ProductImage{}, &entity.Product{})  // Menghapus tabel product beserta ledger stok, varian, dan gambarnya jika ada
     if err != nil {
         log.Fatal("Error dropping table:", err)  // Log error dan hentikan program jika gagal
     }
     fmt.Println("🗑️  Old product tables dropped successfully")  // Pesan sukses menghapus tabel
 
     // Auto migrate
-    err = db.AutoMigrate(&entity.Product{})  // Membuat tabel product berdasarkan struct entity
+    err = db.AutoMigrate(&entity.Product{}, &entity.ProductImage{}, &entity.ProductVariant{}, &entity.VariantValue{})  // Membuat tabel product, gambar, dan varian berdasarkan struct entity
     if err != nil {
         log.Fatal("Error migrating product table:", err)  // Log error dan hentikan program jika gagal
     }
@@ -60,7 +61,8 @@ File product.go ini berisi fungsi untuk melakukan seed data product ke dalam dat
 1. Tujuan : File ini digunakan untuk mengisi database dengan data awal (seed data) untuk entitas Product.
 2. Alur Kerja :
 
-    - Menghapus tabel Product yang sudah ada (jika ada)
+    - Menghapus tabel Product dan ledger StockMovement yang sudah ada (jika ada), karena movement lama merujuk ke ID product lama
+    - Tabel gambar dan varian (product_variants, variant_values) ikut dihapus karena merujuk ke ID product
     - Membuat tabel baru berdasarkan struktur entity Product
     - Membaca data dari file JSON
     - Mengkonversi data JSON ke slice struct Product
@@ -6,6 +6,7 @@ import (
     "log"                                     // Package untuk logging
     "os"                                      // Package untuk operasi sistem
     "rest-api-go/internal/module/user/entity"  // Mengimpor entity user
+    "rest-api-go/pkg/auth"                    // Mengimpor tipe peran user
 
     "gorm.io/gorm"                            // Mengimpor ORM GORM
 )
@@ -33,14 +34,25 @@ func Users(db *gorm.DB) {                     // Fungsi untuk seed data user den
     }
 
     // Parse JSON data
-    var users []entity.User                   // Variabel untuk menampung data user dari JSON
+    var users []struct {                      // Variabel untuk menampung data user dari JSON
+        entity.User
+        Role auth.Role `json:"role"`          // Field role entity tidak dibaca dari JSON, sehingga dibaca di sini
+    }
     err = json.Unmarshal(data, &users)        // Mengkonversi JSON ke slice struct User
     if err != nil {
         log.Fatal("Error parsing users.json:", err)  // Log error dan hentikan program jika gagal
     }
 
     // Seed data
-    for _, user := range users {              // Iterasi setiap user dari data JSON
+    for _, seeded := range users {            // Iterasi setiap user dari data JSON
+        user := seeded.User
+        user.Role = seeded.Role
+        if !user.Role.Valid() {
+            log.Fatalf("Error seeding user %s: unknown role %q", user.Email, user.Role)
+        }
+        if err := user.HashPassword(); err != nil {  // Password di data JSON berupa teks biasa
+            log.Fatal("Error hashing user password:", err)
+        }
         if err := db.Create(&user).Error; err != nil {  // Menyimpan user ke database
             log.Fatal("Error seeding user:", err)  // Log error dan hentikan program jika gagal
         }
@@ -63,8 +75,8 @@ File user.go ini berisi fungsi untuk melakukan seed data user ke dalam database.
     - Menghapus tabel User yang sudah ada (jika ada)
     - Membuat tabel baru berdasarkan struktur entity User
     - Membaca data dari file JSON
-    - Mengkonversi data JSON ke slice struct User
-    - Menyimpan data User ke database
+    - Mengkonversi data JSON ke slice struct User beserta role (framework1@example.com admin, sit2@example.com staff, sisanya customer)
+    - Meng-hash password setiap user dengan HashPassword lalu menyimpan data User ke database (email di data/users.json unik)
 3. Fitur Database :
 
     - DropTable : Menghapus tabel yang sudah ada
@@ -0,0 +1,84 @@
+package audit                                 // Mendefinisikan package audit
+
+import (
+    "context"                                 // Package untuk membawa actor per request
+    "encoding/json"                           // Package untuk menyimpan perubahan sebagai JSON
+    "time"                                    // Package untuk waktu pencatatan
+)
+
+// Action - jenis perubahan yang dicatat
+type Action string
+
+const (
+    ActionCreate Action = "create"            // Baris baru dibuat
+    ActionUpdate Action = "update"            // Satu atau lebih kolom berubah
+    ActionDelete Action = "delete"            // Baris dihapus
+)
+
+// Actions - semua action yang valid, dipakai untuk filter daftar audit
+var Actions = []Action{ActionCreate, ActionUpdate, ActionDelete}
+
+// Entry - satu baris jejak audit untuk satu entitas
+type Entry struct {
+    ID         uint            `json:"id" gorm:"primaryKey"`  // ID entri sebagai primary key
+    ActorID    *uint           `json:"actor_id" gorm:"index"`  // User yang melakukan perubahan, null untuk request anonim dan job latar belakang
+    Action     Action          `json:"action" gorm:"size:16;not null"`  // create, update, atau delete
+    EntityType string          `json:"entity" gorm:"size:64;not null;index:idx_audit_entity,priority:1"`  // Nama entitas, misal "product"
+    EntityID   uint            `json:"entity_id" gorm:"not null;index:idx_audit_entity,priority:2"`  // Primary key entitas yang berubah
+    Changes    json.RawMessage `json:"changes" gorm:"type:text;not null"`  // Perubahan per kolom: {"price":{"before":"10.0000","after":"12.5000"}}
+    RequestID  string          `json:"request_id,omitempty" gorm:"size:128;index"`  // Request ID yang sama dengan access log
+    IP         string          `json:"ip,omitempty" gorm:"size:64"`  // Alamat IP client
+    CreatedAt  time.Time       `json:"created_at" gorm:"index"`  // Waktu perubahan
+}
+
+func (Entry) TableName() string {             // Nama tabel dipakai bersama oleh semua modul
+    return "audit_logs"
+}
+
+// Change - nilai kolom sebelum dan sesudah perubahan
+type Change struct {
+    Before json.RawMessage `json:"before,omitempty"`  // Kosong untuk create
+    After  json.RawMessage `json:"after,omitempty"`  // Kosong untuk delete
+}
+
+// Actor - siapa yang melakukan perubahan, dibawa lewat context request
+type Actor struct {
+    UserID *uint                              // ID user yang login, nil untuk request anonim
+    IP     string                             // Alamat IP client
+}
+
+type contextKey struct{}                      // Tipe khusus untuk key context agar tidak bentrok dengan package lain
+
+// WithActor - menyimpan actor ke dalam context
+func WithActor(ctx context.Context, actor Actor) context.Context {
+    return context.WithValue(ctx, contextKey{}, actor)
+}
+
+// ActorFromContext - mengambil actor dari context, kosong untuk job latar belakang dan seeder
+func ActorFromContext(ctx context.Context) Actor {
+    if ctx == nil {
+        return Actor{}
+    }
+    actor, _ := ctx.Value(contextKey{}).(Actor)
+    return actor
+}
+
+
+// {{{ Penjelasan Struktur Audit }}}
+
+/*
+## Penjelasan Detail
+File audit.go ini mendefinisikan data jejak audit. Berikut penjelasan detailnya:
+
+1. Entry :
+
+    - Disimpan di tabel audit_logs, satu baris per entitas yang dibuat, diubah, atau dihapus
+    - Index (entity_type, entity_id) dipakai untuk GET /api/audit?entity=product&id=42
+    - Changes berisi nama kolom database beserta nilai before dan after dalam JSON
+    - create hanya berisi after, delete hanya berisi before, update hanya berisi kolom yang berubah
+2. Actor :
+
+    - Diisi oleh middleware.AuditActor dari user yang login dan IP client
+    - Request ID dibaca dari context yang sama (logger.RequestIDFromContext)
+    - Perubahan dari job latar belakang atau seeder dicatat tanpa actor
+*/
@@ -0,0 +1,321 @@
+package audit                                 // Mendefinisikan package audit
+
+import (
+    "bytes"                                   // Package untuk membandingkan nilai kolom
+    "encoding/json"                           // Package untuk encoding nilai kolom
+    "errors"                                  // Package untuk menggabungkan error pendaftaran callback
+    "fmt"                                     // Package untuk membungkus error
+    "reflect"                                 // Package untuk membaca struct dan slice model
+    "rest-api-go/pkg/logger"                  // Mengimpor package logger untuk request ID
+
+    "gorm.io/gorm"                            // Mengimpor ORM GORM
+    "gorm.io/gorm/clause"                     // Klausa SQL GORM
+    "gorm.io/gorm/schema"                     // Skema model GORM
+)
+
+const (
+    snapshotKey = "audit:snapshot"            // Key instance GORM untuk menyimpan baris sebelum update atau delete
+    redactTag   = "redact"                    // Tag audit:"redact" menyembunyikan nilai kolom, misal hash password
+)
+
+var redacted = json.RawMessage(`"[redacted]"`)  // Pengganti nilai kolom yang disensor
+
+// GormPlugin - plugin GORM yang mencatat setiap create, update, dan delete pada tabel yang dipantau
+type GormPlugin struct {                      // Mendefinisikan struct plugin
+    entities map[string]string                // Nama tabel -> nama entitas di audit log
+}
+
+// NewGormPlugin - membuat plugin audit untuk tabel yang dipantau, misal {"products": "product"}
+func NewGormPlugin(entities map[string]string) *GormPlugin {  // Constructor untuk plugin
+    return &GormPlugin{entities: entities}    // Mengembalikan instance plugin
+}
+
+func (p *GormPlugin) Name() string {          // Nama plugin (wajib untuk interface gorm.Plugin)
+    return "audit"
+}
+
+func (p *GormPlugin) Initialize(db *gorm.DB) error {  // Method yang dipanggil saat db.Use(plugin)
+    cb := db.Callback()                       // Mengambil registry callback GORM
+    errs := []error{                          // Callback berjalan di dalam transaksi yang sama dengan perubahan
+        cb.Create().Before("gorm:after_create").Register("audit:after_create", p.afterCreate),
+        cb.Update().Before("gorm:update").Register("audit:before_update", p.snapshot),
+        cb.Update().Before("gorm:after_update").Register("audit:after_update", p.afterUpdate),
+        cb.Delete().Before("gorm:delete").Register("audit:before_delete", p.snapshot),
+        cb.Delete().Before("gorm:after_delete").Register("audit:after_delete", p.afterDelete),
+    }
+    return errors.Join(errs...)               // Gabungkan error pendaftaran callback (nil jika semua berhasil)
+}
+
+type record struct {                          // Mendefinisikan struct nilai kolom satu baris
+    id     uint                               // Primary key baris
+    values map[string]json.RawMessage         // Nama kolom -> nilai dalam JSON
+}
+
+func (p *GormPlugin) entity(db *gorm.DB) (string, bool) {  // Fungsi untuk memeriksa apakah statement menyentuh tabel yang dipantau
+    if db.Error != nil || db.DryRun || db.Statement.Schema == nil || db.Statement.Schema.PrioritizedPrimaryField == nil {
+        return "", false
+    }
+    name, ok := p.entities[db.Statement.Table]  // Tabel audit_logs sendiri tidak pernah dipantau
+    return name, ok
+}
+
+func (p *GormPlugin) afterCreate(db *gorm.DB) {  // Callback setelah INSERT: catat semua kolom sebagai after
+    name, ok := p.entity(db)
+    if !ok || db.Statement.RowsAffected == 0 {
+        return
+    }
+    rows, err := records(db, db.Statement.ReflectValue)  // Primary key sudah diisi oleh INSERT
+    if err != nil {
+        db.AddError(fmt.Errorf("audit: %w", err))
+        return
+    }
+    entries := make([]Entry, 0, len(rows))
+    for _, row := range rows {
+        entries = append(entries, newEntry(db, ActionCreate, name, row.id, diff(db.Statement.Schema, nil, row.values)))
+    }
+    write(db, entries)
+}
+
+func (p *GormPlugin) snapshot(db *gorm.DB) {  // Callback sebelum UPDATE dan DELETE: baca baris yang akan berubah
+    if _, ok := p.entity(db); !ok {
+        return
+    }
+    conds := conditions(db)
+    if len(conds) == 0 {                      // Tanpa WHERE, GORM menolak statement dengan ErrMissingWhereClause
+        return
+    }
+    rows, err := load(db, conds, true)        // FOR UPDATE agar nilai before tidak berubah sebelum statement selesai
+    if err != nil {
+        db.AddError(fmt.Errorf("audit: %w", err))
+        return
+    }
+    db.InstanceSet(snapshotKey, rows)         // Simpan untuk callback after
+}
+
+func (p *GormPlugin) afterUpdate(db *gorm.DB) {  // Callback setelah UPDATE: bandingkan baris sebelum dan sesudah
+    name, ok := p.entity(db)
+    before := snapshotOf(db)
+    if !ok || len(before) == 0 {
+        return
+    }
+    ids := make([]any, 0, len(before))
+    for _, row := range before {
+        ids = append(ids, row.id)
+    }
+    pk := db.Statement.Schema.PrioritizedPrimaryField
+    after, err := load(db, []clause.Expression{clause.IN{Column: clause.Column{Table: clause.CurrentTable, Name: pk.DBName}, Values: ids}}, false)
+    if err != nil {
+        db.AddError(fmt.Errorf("audit: %w", err))
+        return
+    }
+
+    current := make(map[uint]map[string]json.RawMessage, len(after))
+    for _, row := range after {
+        current[row.id] = row.values
+    }
+    entries := make([]Entry, 0, len(before))
+    for _, row := range before {
+        values, ok := current[row.id]
+        if !ok {                              // Primary key ikut berubah, tidak dapat dipasangkan
+            continue
+        }
+        if changes := diff(db.Statement.Schema, row.values, values); len(changes) > 0 {  // Update tanpa perubahan nilai tidak dicatat
+            entries = append(entries, newEntry(db, ActionUpdate, name, row.id, changes))
+        }
+    }
+    write(db, entries)
+}
+
+func (p *GormPlugin) afterDelete(db *gorm.DB) {  // Callback setelah DELETE: catat semua kolom sebagai before
+    name, ok := p.entity(db)
+    before := snapshotOf(db)
+    if !ok || len(before) == 0 || db.Statement.RowsAffected == 0 {
+        return
+    }
+    entries := make([]Entry, 0, len(before))
+    for _, row := range before {
+        entries = append(entries, newEntry(db, ActionDelete, name, row.id, diff(db.Statement.Schema, row.values, nil)))
+    }
+    write(db, entries)
+}
+
+func conditions(db *gorm.DB) []clause.Expression {  // Fungsi untuk menyalin kondisi WHERE statement, termasuk primary key dari model
+    var exprs []clause.Expression
+    if c, ok := db.Statement.Clauses["WHERE"]; ok {
+        if where, ok := c.Expression.(clause.Where); ok {
+            exprs = append(exprs, where.Exprs...)
+        }
+    }
+
+    pk := db.Statement.Schema.PrioritizedPrimaryField
+    column := clause.Column{Table: clause.CurrentTable, Name: pk.DBName}
+    switch rv := db.Statement.ReflectValue; rv.Kind() {  // GORM menambahkan kondisi ini sendiri di gorm:update dan gorm:delete
+    case reflect.Struct:                      // Save(&product) atau Model(&category).Update(...)
+        if value, zero := pk.ValueOf(db.Statement.Context, rv); !zero {
+            exprs = append(exprs, clause.Eq{Column: column, Value: value})
+        }
+    case reflect.Slice, reflect.Array:        // Delete(&[]Product{...})
+        var values []any
+        for i := 0; i < rv.Len(); i++ {
+            if value, zero := pk.ValueOf(db.Statement.Context, reflect.Indirect(rv.Index(i))); !zero {
+                values = append(values, value)
+            }
+        }
+        if len(values) > 0 {
+            exprs = append(exprs, clause.IN{Column: column, Values: values})
+        }
+    }
+    return exprs
+}
+
+func load(db *gorm.DB, conds []clause.Expression, lock bool) ([]record, error) {  // Fungsi untuk membaca baris di koneksi (dan transaksi) yang sama
+    rows := reflect.New(reflect.SliceOf(db.Statement.Schema.ModelType))
+    tx := db.Session(&gorm.Session{NewDB: true, SkipDefaultTransaction: true}).Table(db.Statement.Table).Clauses(clause.Where{Exprs: conds})
+    if lock {
+        tx = tx.Clauses(clause.Locking{Strength: "UPDATE"})
+    }
+    if err := tx.Find(rows.Interface()).Error; err != nil {
+        return nil, err
+    }
+    return records(db, rows.Elem())
+}
+
+func records(db *gorm.DB, rv reflect.Value) ([]record, error) {  // Fungsi untuk mengubah struct atau slice model menjadi nilai kolom
+    rv = reflect.Indirect(rv)
+    var rows []reflect.Value
+    switch rv.Kind() {
+    case reflect.Struct:
+        rows = append(rows, rv)
+    case reflect.Slice, reflect.Array:
+        for i := 0; i < rv.Len(); i++ {
+            rows = append(rows, reflect.Indirect(rv.Index(i)))
+        }
+    }
+
+    ctx := db.Statement.Context
+    s := db.Statement.Schema
+    list := make([]record, 0, len(rows))
+    for _, row := range rows {
+        value, _ := s.PrioritizedPrimaryField.ValueOf(ctx, row)
+        id, ok := toID(value)
+        if !ok {
+            continue
+        }
+        values := map[string]json.RawMessage{}
+        for _, field := range s.Fields {
+            if field.DBName == "" || field.PrimaryKey || field.AutoCreateTime > 0 || field.AutoUpdateTime > 0 {
+                continue                      // Relasi, ID, dan created_at/updated_at tidak dicatat
+            }
+            value, _ := field.ValueOf(ctx, row)
+            raw, err := json.Marshal(value)
+            if err != nil {
+                return nil, fmt.Errorf("encode %s.%s: %w", s.Table, field.DBName, err)
+            }
+            values[field.DBName] = raw
+        }
+        list = append(list, record{id: id, values: values})
+    }
+    return list, nil
+}
+
+func diff(s *schema.Schema, before, after map[string]json.RawMessage) map[string]Change {  // Fungsi untuk mendapatkan kolom yang berbeda
+    changes := map[string]Change{}
+    for column, value := range after {
+        if old, ok := before[column]; !ok || !bytes.Equal(old, value) {
+            changes[column] = Change{Before: before[column], After: value}
+        }
+    }
+    for column, old := range before {
+        if _, ok := after[column]; !ok {
+            changes[column] = Change{Before: old}
+        }
+    }
+    for column, change := range changes {     // Kolom yang disensor tetap terlihat berubah, tanpa nilainya
+        if field := s.LookUpField(column); field != nil && field.Tag.Get("audit") == redactTag {
+            if change.Before != nil {
+                change.Before = redacted
+            }
+            if change.After != nil {
+                change.After = redacted
+            }
+            changes[column] = change
+        }
+    }
+    return changes
+}
+
+func newEntry(db *gorm.DB, action Action, name string, id uint, changes map[string]Change) Entry {  // Fungsi untuk membuat entri dengan actor dari context
+    ctx := db.Statement.Context
+    actor := ActorFromContext(ctx)
+    raw, _ := json.Marshal(changes)           // Hanya berisi json.RawMessage, tidak dapat gagal
+    return Entry{
+        ActorID:    actor.UserID,
+        Action:     action,
+        EntityType: name,
+        EntityID:   id,
+        Changes:    raw,
+        RequestID:  logger.RequestIDFromContext(ctx),
+        IP:         actor.IP,
+    }
+}
+
+func write(db *gorm.DB, entries []Entry) {    // Fungsi untuk menyimpan entri di transaksi yang sama dengan perubahan
+    if len(entries) == 0 {
+        return
+    }
+    if err := db.Session(&gorm.Session{NewDB: true, SkipDefaultTransaction: true}).Create(&entries).Error; err != nil {
+        db.AddError(fmt.Errorf("audit: %w", err))  // Perubahan ikut dibatalkan jika audit gagal disimpan
+    }
+}
+
+func snapshotOf(db *gorm.DB) []record {       // Fungsi untuk mengambil baris yang dibaca callback before
+    value, ok := db.InstanceGet(snapshotKey)
+    if !ok {
+        return nil
+    }
+    rows, _ := value.([]record)
+    return rows
+}
+
+func toID(value any) (uint, bool) {           // Fungsi untuk mengubah primary key menjadi uint
+    rv := reflect.ValueOf(value)
+    switch rv.Kind() {
+    case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+        return uint(rv.Uint()), rv.Uint() > 0
+    case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+        return uint(rv.Int()), rv.Int() > 0
+    }
+    return 0, false
+}
+
+
+// {{{ Penjelasan Plugin Audit GORM }}}
+
+/*
+## Penjelasan Detail
+File gorm.go ini berisi plugin GORM yang mencatat jejak audit untuk setiap perubahan. Berikut penjelasan detailnya:
+
+1. Tujuan : Mencatat create, update, dan delete pada tabel yang dipantau di satu tempat, tanpa kode tambahan di setiap service.
+2. Cara Kerja :
+
+    - Create : Setelah INSERT, semua kolom baris baru dicatat sebagai after
+    - Update : Sebelum UPDATE, baris yang cocok dengan WHERE dibaca dengan FOR UPDATE; setelahnya dibaca ulang berdasarkan primary key dan hanya kolom yang berbeda yang dicatat
+    - Delete : Sebelum DELETE, baris yang cocok dibaca; setelah berhasil semua kolom dicatat sebagai before
+    - Update yang tidak mengubah nilai apa pun tidak dicatat
+3. Transaksi :
+
+    - Pembacaan dan penyimpanan entri memakai koneksi statement, sehingga berada di transaksi yang sama (transaksi default GORM atau db.Transaction di service)
+    - Jika entri audit gagal disimpan, statement mendapat error dan perubahan ikut di-rollback
+4. Kolom :
+
+    - Nama kolom database yang dicatat (misal price dan currency untuk harga product)
+    - ID, created_at, updated_at, dan relasi tidak dicatat
+    - Field dengan tag audit:"redact" (misal password) dicatat sebagai "[redacted]"
+5. Batasan :
+
+    - Hanya perubahan lewat Create, Save, Update, Updates, dan Delete GORM; db.Exec dengan SQL mentah tidak tercatat
+    - Setiap update dan delete pada tabel yang dipantau membutuhkan satu SELECT tambahan (update dua)
+6. Penggunaan di main.go :
+
+    - db.Use(audit.NewGormPlugin(map[string]string{"products": "product", "categories": "category", "users": "user"}))
+*/
@@ -0,0 +1,162 @@
+package auth                                  // Mendefinisikan package auth
+
+import (
+    "crypto/hmac"                             // Package untuk tanda tangan HMAC
+    "crypto/rand"                             // Package untuk secret acak
+    "crypto/sha256"                           // Fungsi hash untuk HMAC-SHA256
+    "encoding/base64"                         // Package untuk encoding token
+    "encoding/json"                           // Package untuk payload token
+    "errors"                                  // Package untuk membuat error
+    "strings"                                 // Package untuk memisahkan bagian token
+    "time"                                    // Package untuk masa berlaku token
+)
+
+var (
+    ErrInvalidToken = errors.New("invalid token")  // Format atau tanda tangan token salah
+    ErrExpiredToken = errors.New("token expired")  // Token sudah melewati masa berlaku
+)
+
+var encoding = base64.RawURLEncoding          // Base64 URL-safe tanpa padding agar aman di header
+
+const ScopeEventStream = "events.stream"      // Scope token untuk GET /api/events/stream
+
+type Manager struct {                         // Mendefinisikan struct Manager untuk membuat dan memeriksa token
+    secret []byte                             // Kunci HMAC
+    ttl    time.Duration                      // Masa berlaku token
+}
+
+// Claims - isi token
+type Claims struct {
+    UserID    uint   `json:"sub"`             // ID user pemilik token
+    Role      Role   `json:"role,omitempty"`  // Peran user saat login, kosong pada token lama
+    Scope     string `json:"scope,omitempty"` // Endpoint yang boleh memakai token, kosong untuk token login
+    ExpiresAt int64  `json:"exp"`             // Waktu kedaluwarsa (Unix detik)
+}
+
+// NewManager - membuat Manager, secret kosong diganti secret acak
+func NewManager(secret string, ttl time.Duration) *Manager {  // Constructor untuk Manager
+    key := []byte(secret)
+    if len(key) == 0 {                        // Tanpa AUTH_SECRET token hanya berlaku sampai proses berhenti
+        key = make([]byte, 32)
+        _, _ = rand.Read(key)
+    }
+    return &Manager{secret: key, ttl: ttl}
+}
+
+var defaultManager = NewManager("", 24*time.Hour)  // Manager default, diganti main.go melalui SetDefault
+
+// SetDefault - menjadikan Manager sebagai default untuk seluruh aplikasi
+func SetDefault(m *Manager) {
+    defaultManager = m
+}
+
+// Default - mengembalikan Manager default
+func Default() *Manager {
+    return defaultManager
+}
+
+// Issue - membuat token untuk user beserta perannya
+func (m *Manager) Issue(userID uint, role Role) (string, time.Time, error) {  // Mengembalikan token dan waktu kedaluwarsanya
+    return m.issue(Claims{UserID: userID, Role: role}, m.ttl)
+}
+
+// IssueScoped - membuat token berumur pendek yang hanya diterima VerifyScoped dengan scope yang sama
+func (m *Manager) IssueScoped(userID uint, role Role, scope string, ttl time.Duration) (string, time.Time, error) {
+    return m.issue(Claims{UserID: userID, Role: role, Scope: scope}, ttl)
+}
+
+func (m *Manager) issue(c Claims, ttl time.Duration) (string, time.Time, error) {  // Fungsi untuk menandatangani klaim dengan masa berlaku ttl
+    expiresAt := time.Now().Add(ttl)
+    c.ExpiresAt = expiresAt.Unix()
+    payload, err := json.Marshal(c)
+    if err != nil {
+        return "", time.Time{}, err
+    }
+    body := encoding.EncodeToString(payload)
+    return body + "." + encoding.EncodeToString(m.sign(body)), expiresAt, nil  // Format: payload.signature
+}
+
+// Verify - memeriksa token login dan mengembalikan isinya; token ber-scope ditolak
+func (m *Manager) Verify(token string) (Claims, error) {
+    c, err := m.verify(token)
+    if err == nil && c.Scope != "" {
+        return Claims{}, ErrInvalidToken      // Token stream tidak boleh dipakai sebagai token bearer biasa
+    }
+    return c, err
+}
+
+// VerifyScoped - memeriksa token yang dibuat IssueScoped untuk scope tersebut
+func (m *Manager) VerifyScoped(token, scope string) (Claims, error) {
+    c, err := m.verify(token)
+    if err == nil && c.Scope != scope {
+        return Claims{}, ErrInvalidToken
+    }
+    return c, err
+}
+
+func (m *Manager) verify(token string) (Claims, error) {  // Fungsi untuk memeriksa tanda tangan dan masa berlaku token
+    body, signature, ok := strings.Cut(token, ".")
+    if !ok {
+        return Claims{}, ErrInvalidToken
+    }
+    sig, err := encoding.DecodeString(signature)
+    if err != nil || !hmac.Equal(sig, m.sign(body)) {  // Perbandingan constant-time
+        return Claims{}, ErrInvalidToken
+    }
+
+    payload, err := encoding.DecodeString(body)
+    if err != nil {
+        return Claims{}, ErrInvalidToken
+    }
+    var c Claims
+    if err := json.Unmarshal(payload, &c); err != nil || c.UserID == 0 {
+        return Claims{}, ErrInvalidToken
+    }
+    if time.Now().Unix() >= c.ExpiresAt {
+        return Claims{}, ErrExpiredToken
+    }
+    if c.Role == "" {
+        c.Role = RoleCustomer                 // Token yang dibuat sebelum ada peran
+    }
+    return c, nil
+}
+
+func (m *Manager) sign(body string) []byte {  // Fungsi untuk menghitung HMAC-SHA256 dari payload
+    mac := hmac.New(sha256.New, m.secret)
+    mac.Write([]byte(body))
+    return mac.Sum(nil)
+}
+
+
+
+// {{{ Penjelasan Package Auth }}}
+
+/*
+## Penjelasan Detail
+File auth.go ini berisi pembuatan dan pemeriksaan token bearer. Berikut penjelasan detailnya:
+
+1. Format Token :
+
+    - base64url(payload) + "." + base64url(HMAC-SHA256(payload))
+    - Payload berisi sub (ID user), role (peran user, lihat role.go), scope (hanya token ber-scope), dan exp (waktu kedaluwarsa)
+    - Token tidak disimpan di database, keasliannya dibuktikan oleh tanda tangan HMAC
+2. Manager :
+
+    - NewManager(secret, ttl) dengan secret dari AUTH_SECRET dan ttl dari AUTH_TOKEN_TTL
+    - Jika AUTH_SECRET kosong, secret acak dibuat saat start sehingga token tidak berlaku lagi setelah restart
+    - SetDefault/Default mengikuti pola slog.SetDefault agar modul dapat memakai Manager tanpa mengubah signature Initialize
+3. Verify :
+
+    - Tanda tangan dibandingkan dengan hmac.Equal (constant-time)
+    - ErrInvalidToken untuk token rusak atau dipalsukan, ErrExpiredToken untuk token kedaluwarsa
+    - Verify menolak token ber-scope, VerifyScoped hanya menerima token dengan scope yang diminta
+4. Token Ber-scope :
+
+    - IssueScoped membuat token berumur pendek untuk satu endpoint, misal ScopeEventStream untuk EventSource yang tidak dapat mengirim header Authorization
+    - Token ini dikirim lewat query string atau cookie, sehingga lebih mudah bocor ke log; karena itu umurnya pendek dan tidak berlaku di endpoint lain
+5. Penggunaan :
+
+    - POST /api/users/login memanggil Issue setelah password cocok
+    - Middleware Authenticate memanggil Verify untuk header Authorization: Bearer <token>
+Jika beberapa instance API berjalan bersamaan, semua instance harus memakai AUTH_SECRET yang sama.
+*/
@@ -0,0 +1,62 @@
+package auth                                  // Mendefinisikan package auth
+
+type Role string                              // Mendefinisikan tipe peran user
+
+const (
+    RoleCustomer Role = "customer"            // Pembeli, default untuk user baru
+    RoleTerminal Role = "terminal"            // Akun perangkat (kasir, layar dapur) yang hanya membaca aliran order
+    RoleStaff    Role = "staff"               // Karyawan yang mengelola order semua user
+    RoleAdmin    Role = "admin"               // Staff yang juga mengatur peran user dan semua webhook
+)
+
+// Roles - semua peran yang valid, dipakai tag binding oneof
+var Roles = []Role{RoleCustomer, RoleTerminal, RoleStaff, RoleAdmin}
+
+// StaffRoles - peran yang IsStaff, dipakai untuk query WHERE role IN
+var StaffRoles = []Role{RoleStaff, RoleAdmin}
+
+// Valid - true jika peran dikenal
+func (r Role) Valid() bool {
+    for _, role := range Roles {
+        if r == role {
+            return true
+        }
+    }
+    return false
+}
+
+// IsStaff - true untuk staff dan admin
+func (r Role) IsStaff() bool {
+    return r == RoleStaff || r == RoleAdmin
+}
+
+// IsAdmin - true hanya untuk admin
+func (r Role) IsAdmin() bool {
+    return r == RoleAdmin
+}
+
+// ReadsOrders - true jika boleh menerima order semua user secara live (staff, admin, dan terminal)
+func (r Role) ReadsOrders() bool {
+    return r.IsStaff() || r == RoleTerminal
+}
+
+
+
+// {{{ Penjelasan Role }}}
+
+/*
+## Penjelasan Detail
+File role.go ini berisi peran user yang dibawa di dalam token. Berikut penjelasan detailnya:
+
+1. Peran :
+
+    - customer : Default, hanya melihat dan membatalkan order miliknya sendiri
+    - terminal : Akun perangkat, boleh berlangganan aliran order semua user (topic WebSocket orders, event order.* di SSE) tanpa bisa mengubahnya
+    - staff : Melihat semua order dan mengubah statusnya
+    - admin : Semua hak staff, ditambah mengatur peran user dan melihat semua webhook
+2. Token :
+
+    - Peran disimpan di klaim role saat login, sehingga middleware tidak perlu membaca database per request
+    - Perubahan peran berlaku pada token berikutnya; token lama tetap membawa peran lama sampai kedaluwarsa (AUTH_TOKEN_TTL)
+    - Token lama tanpa klaim role diperlakukan sebagai customer
+*/
@@ -0,0 +1,214 @@
+package bulk                                  // Mendefinisikan package bulk
+
+import (
+    "encoding/json"                           // Package untuk item JSON mentah
+    "errors"                                  // Package untuk pengecekan error
+    "fmt"                                     // Package untuk formatting pesan error
+    "net/http"                                // Package untuk konstanta HTTP
+    "rest-api-go/pkg/logger"                  // Mengimpor package logger untuk membaca request ID
+    "rest-api-go/pkg/utils"                   // Mengimpor utilitas aplikasi untuk HTTPError dan respons
+    "rest-api-go/pkg/validation"              // Package validation dengan validator bersama
+
+    "github.com/gin-gonic/gin"                // Mengimpor framework web Gin
+)
+
+type Mode string                              // Cara menangani item yang gagal
+
+const (
+    Atomic     Mode = "atomic"                // Semua item tersimpan atau tidak ada sama sekali (default)
+    BestEffort Mode = "best_effort"           // Item yang berhasil tetap tersimpan walaupun item lain gagal
+)
+
+const (
+    MaxItems     = 5000                       // Jumlah item maksimum per request, sama dengan tag binding max=5000
+    BatchSize    = 500                        // Jumlah baris per INSERT multi-baris
+    MaxBodyBytes = 16 << 20                   // Batas body request bulk (16 MiB), menggantikan batas global pada route bulk
+)
+
+var ErrNotSaved = utils.NewHTTPError(http.StatusFailedDependency, "not saved because another item failed")  // Item valid yang ikut dibatalkan pada mode atomic
+
+type Request struct {                         // Mendefinisikan struct body request bulk create dan update
+    Mode  Mode              `json:"mode" binding:"omitempty,oneof=atomic best_effort"`  // Kosong berarti atomic
+    Items []json.RawMessage `json:"items" binding:"required,min=1,max=5000"`  // Item mentah, didecode satu per satu agar error dapat ditunjuk per index
+}
+
+type DeleteRequest struct {                   // Mendefinisikan struct body request bulk delete
+    Mode Mode   `json:"mode" binding:"omitempty,oneof=atomic best_effort"`  // Kosong berarti atomic
+    IDs  []uint `json:"ids" binding:"required,min=1,max=5000,unique,dive,required"`  // ID record yang dihapus
+}
+
+func (r *Request) Validate() error {          // Method untuk validasi struct Request
+    return validation.Struct(r)               // Memvalidasi struct berdasarkan tag binding dengan validator bersama
+}
+
+func (r *DeleteRequest) Validate() error {    // Method untuk validasi struct DeleteRequest
+    return validation.Struct(r)               // Memvalidasi struct berdasarkan tag binding dengan validator bersama
+}
+
+type ItemResult struct {                      // Mendefinisikan struct hasil satu item
+    Index  int               `json:"index"`   // Posisi item dalam request, dimulai dari 0
+    Status int               `json:"status"`  // Status HTTP item, misal 201, 400, 404, 409
+    ID     uint              `json:"id,omitempty"`  // ID record yang dibuat, diubah, atau dihapus
+    Error  string            `json:"error,omitempty"`  // Pesan error item
+    Errors map[string]string `json:"errors,omitempty"`  // Pesan error per field untuk error validasi
+    err    error                                // Error asli, diterjemahkan saat respons dikirim sesuai Accept-Language
+}
+
+type Report struct {                          // Mendefinisikan struct laporan request bulk
+    Mode      Mode         `json:"mode"`      // Mode yang dipakai
+    Total     int          `json:"total"`     // Jumlah item dalam request
+    Succeeded int          `json:"succeeded"` // Jumlah item yang tersimpan
+    Failed    int          `json:"failed"`    // Jumlah item yang tidak tersimpan
+    Items     []ItemResult `json:"items"`     // Hasil setiap item sesuai urutan request
+}
+
+// NewReport - laporan kosong untuk total item; mode kosong berarti atomic
+func NewReport(mode Mode, total int) *Report {
+    if mode == "" {
+        mode = Atomic
+    }
+    report := &Report{Mode: mode, Total: total, Items: make([]ItemResult, total)}
+    for i := range report.Items {
+        report.Items[i].Index = i
+    }
+    return report
+}
+
+// OK - mencatat item yang berhasil
+func (r *Report) OK(index, status int, id uint) {
+    r.Items[index].Status, r.Items[index].ID = status, id
+    r.Succeeded++
+}
+
+// Fail - mencatat item yang gagal; status dan pesan ditentukan dari err seperti utils.HandleError
+func (r *Report) Fail(index int, err error) {
+    r.Items[index].err = err
+    r.Failed++
+}
+
+// Saved - memeriksa apakah item tersimpan
+func (r *Report) Saved(index int) bool {
+    return r.Items[index].err == nil && r.Items[index].Status != 0
+}
+
+func (r *Report) rollback() {                 // Method untuk menandai item yang berhasil sebagai tidak tersimpan setelah rollback
+    for i := range r.Items {
+        if r.Items[i].err == nil {
+            r.Items[i].ID, r.Items[i].err = 0, ErrNotSaved
+        }
+    }
+    r.Succeeded, r.Failed = 0, r.Total
+}
+
+// Claims - nilai unik (SKU, slug) yang sudah dipakai item sebelumnya dalam request yang sama tetapi belum tersimpan
+type Claims map[string]bool
+
+// Has - memeriksa apakah value untuk kind sudah dipakai item lain
+func (c Claims) Has(kind, value string) bool {
+    return c[kind+"\x00"+value]
+}
+
+// Claim - menandai value untuk kind sebagai sudah dipakai
+func (c Claims) Claim(kind, value string) {
+    c[kind+"\x00"+value] = true
+}
+
+// ID - membaca field id dari item bulk update
+func ID(raw json.RawMessage) (uint, error) {
+    var item struct {
+        ID uint `json:"id"`
+    }
+    if err := json.Unmarshal(raw, &item); err != nil {  // Field lain diperiksa oleh Patch
+        return 0, utils.NewHTTPError(http.StatusBadRequest, "item must be a JSON object with a numeric id")
+    }
+    if item.ID == 0 {
+        return 0, utils.NewHTTPError(http.StatusBadRequest, "id is required")
+    }
+    return item.ID, nil
+}
+
+// Patch - menimpa field obj dengan field yang dikirim di raw, mengembalikan nama field yang dikirim
+func Patch(raw json.RawMessage, obj interface{}) (map[string]bool, error) {
+    var fields map[string]json.RawMessage
+    if err := json.Unmarshal(raw, &fields); err != nil {
+        return nil, utils.NewHTTPError(http.StatusBadRequest, "item must be a JSON object")
+    }
+    if err := utils.DecodeJSON(raw, obj); err != nil {  // Field yang tidak dikenal ditolak seperti BindJSON
+        return nil, err
+    }
+    sent := make(map[string]bool, len(fields))
+    for name := range fields {
+        sent[name] = true
+    }
+    return sent, nil
+}
+
+// Respond - mengirim laporan; successStatus jika semua item berhasil, 207 jika sebagian, 422 jika tidak ada yang tersimpan
+func Respond(c *gin.Context, successStatus int, report *Report) {
+    locale := validation.LocaleFromHeader(c.GetHeader("Accept-Language"))  // Bahasa pesan validasi per item
+    rejected := 0                             // Item yang benar-benar gagal, tanpa item yang ikut dibatalkan
+    for i := range report.Items {
+        item := &report.Items[i]
+        if item.err == nil {
+            continue
+        }
+        item.Status, item.Error, item.Errors = utils.Describe(item.err, http.StatusInternalServerError, locale)
+        if !errors.Is(item.err, ErrNotSaved) {
+            rejected++
+        }
+    }
+
+    response := utils.SuccessResponse(report)
+    if report.Failed == 0 {
+        c.JSON(successStatus, response)
+        return
+    }
+
+    status := http.StatusMultiStatus          // Sebagian item tersimpan
+    response.Error = fmt.Sprintf("%d of %d items failed", rejected, report.Total)
+    if report.Succeeded == 0 {
+        status = http.StatusUnprocessableEntity  // Tidak ada item yang tersimpan
+    }
+    if report.Mode == Atomic {
+        response.Error += "; no changes were saved"
+    }
+    response.Success = false
+    response.RequestID = logger.RequestIDFromContext(c.Request.Context())
+    c.JSON(status, response)
+}
+
+
+// {{{ Penjelasan Package Bulk }}}
+
+/*
+## Penjelasan Detail
+File bulk.go ini berisi tipe request dan laporan yang dipakai bersama oleh endpoint bulk (product, category, user). Berikut penjelasan detailnya:
+
+1. Mode :
+
+    - atomic (default) : Jika satu item gagal, seluruh transaksi di-rollback dan item yang valid dilaporkan dengan status 424
+    - best_effort : Item yang berhasil tetap di-commit, item yang gagal dilaporkan satu per satu
+2. Request :
+
+    - Request : {"mode": "...", "items": [...]} untuk create dan update, maksimal 5000 item
+    - Items disimpan sebagai json.RawMessage sehingga item yang rusak atau berisi field tidak dikenal hanya menggagalkan item itu
+    - DeleteRequest : {"mode": "...", "ids": [...]} untuk delete
+3. Report :
+
+    - Satu ItemResult per item dengan index, status, id, error, dan errors (pesan validasi per field)
+    - Error disimpan apa adanya lalu diterjemahkan oleh Respond dengan utils.Describe sesuai Accept-Language
+4. Claims :
+
+    - Menyimpan SKU atau slug yang dipakai item sebelumnya dalam request yang sama
+    - Diperlukan karena item baru disimpan per batch, sehingga database belum melihat item lain dalam batch yang sama
+5. ID dan Patch :
+
+    - Item bulk update wajib berisi id
+    - Patch hanya menimpa field yang dikirim, field lain tetap bernilai lama
+6. Respond :
+
+    - Semua berhasil : 200 (update, delete) atau 201 (create)
+    - Sebagian berhasil (best_effort) : 207 Multi-Status
+    - Tidak ada yang tersimpan : 422 Unprocessable Entity
+    - Laporan selalu dikirim di field data, termasuk saat gagal
+*/
@@ -0,0 +1,138 @@
+package bulk                                  // Mendefinisikan package bulk
+
+import (
+    "errors"                                  // Package untuk membuat dan memeriksa error
+    "net/http"                                // Package untuk konstanta HTTP
+
+    "gorm.io/gorm"                            // Mengimpor ORM GORM
+)
+
+var errRollback = errors.New("bulk: rollback")  // Penanda rollback mode atomic, tidak pernah dikirim ke client
+
+// Run - menjalankan fn di dalam satu transaksi; mode atomic me-rollback semuanya jika ada item yang gagal
+func Run(db *gorm.DB, report *Report, fn func(tx *gorm.DB) error) error {
+    err := db.Transaction(func(tx *gorm.DB) error {
+        if err := fn(tx); err != nil {
+            return err                        // Error di luar item (misal koneksi database), seluruh request gagal
+        }
+        if report.Mode == Atomic && report.Failed > 0 {
+            return errRollback
+        }
+        return nil
+    })
+    if errors.Is(err, errRollback) {
+        report.rollback()                     // Item yang valid ikut dibatalkan
+        return nil
+    }
+    return err
+}
+
+// Item - menjalankan satu item di dalam savepoint sehingga item yang gagal tidak membatalkan item lain
+func Item(tx *gorm.DB, report *Report, index, status int, fn func(tx *gorm.DB) (uint, error)) {
+    var id uint
+    err := tx.Transaction(func(tx *gorm.DB) (err error) {  // Transaksi bersarang memakai SAVEPOINT
+        id, err = fn(tx)
+        return err
+    })
+    if err != nil {
+        report.Fail(index, err)
+        return
+    }
+    report.OK(index, status, id)
+}
+
+// Batch - mengumpulkan record baru yang sudah diperiksa lalu menyimpannya dengan INSERT multi-baris
+type Batch[T any] struct {
+    tx      *gorm.DB                          // Transaksi dari Run
+    report  *Report                           // Laporan yang diisi setelah INSERT
+    id      func(*T) uint                     // Membaca ID record setelah disimpan
+    indexes []int                             // Index item untuk setiap record
+    rows    []*T                              // Record yang menunggu disimpan
+    after   func(*gorm.DB, *T) error          // Dijalankan untuk setiap record setelah INSERT, opsional
+}
+
+// NewBatch - batch baru untuk transaksi tx
+func NewBatch[T any](tx *gorm.DB, report *Report, id func(*T) uint) *Batch[T] {
+    return &Batch[T]{tx: tx, report: report, id: id}
+}
+
+// AfterCreate - fn dijalankan di transaksi yang sama untuk setiap record yang tersimpan, misal untuk mencatat domain event
+func (b *Batch[T]) AfterCreate(fn func(tx *gorm.DB, row *T) error) *Batch[T] {
+    b.after = fn
+    return b
+}
+
+// Add - menambahkan record item index, disimpan otomatis setiap BatchSize record
+func (b *Batch[T]) Add(index int, row *T) {
+    b.indexes, b.rows = append(b.indexes, index), append(b.rows, row)
+    if len(b.rows) >= BatchSize {
+        b.Flush()
+    }
+}
+
+// Flush - menyimpan record yang tersisa
+func (b *Batch[T]) Flush() {
+    if len(b.rows) == 0 {
+        return
+    }
+    err := b.tx.Transaction(func(tx *gorm.DB) error {  // Satu INSERT untuk seluruh batch
+        if err := tx.Create(b.rows).Error; err != nil {
+            return err
+        }
+        return b.created(tx, b.rows...)
+    })
+    if err == nil {
+        for i, row := range b.rows {
+            b.report.OK(b.indexes[i], http.StatusCreated, b.id(row))
+        }
+    } else {
+        for i, row := range b.rows {          // Simpan satu per satu agar record yang ditolak database dapat ditunjuk
+            Item(b.tx, b.report, b.indexes[i], http.StatusCreated, func(tx *gorm.DB) (uint, error) {
+                err := tx.Create(row).Error
+                if err == nil {
+                    err = b.created(tx, row)
+                }
+                return b.id(row), err
+            })
+        }
+    }
+    b.indexes, b.rows = b.indexes[:0], b.rows[:0]
+}
+
+func (b *Batch[T]) created(tx *gorm.DB, rows ...*T) error {  // Fungsi untuk menjalankan AfterCreate pada record yang baru disimpan
+    if b.after == nil {
+        return nil
+    }
+    for _, row := range rows {
+        if err := b.after(tx, row); err != nil {
+            return err
+        }
+    }
+    return nil
+}
+
+
+// {{{ Penjelasan Fungsi Run }}}
+
+/*
+## Penjelasan Detail
+File run.go ini berisi cara menjalankan request bulk di database. Berikut penjelasan detailnya:
+
+1. Run :
+
+    - Seluruh request berjalan dalam satu transaksi, termasuk mode best_effort
+    - Mode atomic: jika ada item yang gagal, transaksi di-rollback dan item yang valid dilaporkan sebagai 424 (ErrNotSaved)
+    - Mode best_effort: item yang berhasil di-commit bersama di akhir request
+2. Item :
+
+    - Setiap item dijalankan dalam transaksi bersarang (SAVEPOINT), sehingga error database pada satu item hanya membatalkan item itu
+    - Semua item tetap diperiksa walaupun ada yang gagal, sehingga laporan berisi semua error sekaligus
+    - Dipakai untuk update dan delete
+3. Batch :
+
+    - Dipakai untuk create: item yang lolos pemeriksaan dikumpulkan lalu disimpan dengan satu INSERT per BatchSize (500) baris
+    - Jika INSERT batch ditolak database (misal duplikat karena request lain yang bersamaan), record disimpan ulang satu per satu agar error dapat ditunjuk per index
+    - Hook GORM (BeforeCreate, BeforeSave) tetap berjalan untuk setiap record
+    - AfterCreate menjalankan fungsi service (misal mencatat event product.created) di savepoint yang sama dengan INSERT, sehingga ikut batal jika INSERT diulang satu per satu
+Service memakai Run, Item, dan Batch sehingga aturan bisnis create, update, dan delete satu record tetap sama dengan endpoint bulk.
+*/
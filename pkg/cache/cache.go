@@ -0,0 +1,171 @@
+package cache                                 // Mendefinisikan package cache
+
+import (
+    "context"                                 // Package untuk context perintah
+    "encoding/json"                           // Package untuk menyimpan nilai sebagai JSON
+    "log/slog"                                // Package structured logging bawaan Go
+    "rest-api-go/pkg/events"                  // Mengimpor stream domain event untuk invalidasi antar instance
+    "slices"                                  // Package untuk mengumpulkan namespace
+    "strconv"                                 // Package untuk nilai generasi
+    "time"                                    // Package untuk masa berlaku entri
+
+    "golang.org/x/sync/singleflight"          // Menggabungkan load yang sama saat cache miss
+)
+
+// Options - pengaturan Cache, nilai kosong memakai default
+type Options struct {
+    TTL time.Duration                         // Masa berlaku satu entri, default 1 menit
+}
+
+// Cache - cache hasil baca service per namespace (misal "products") dengan invalidasi per namespace dan perlindungan stampede.
+// Cache nil valid dan selalu membaca langsung dari load, sehingga service tidak perlu memeriksa apakah cache aktif.
+type Cache struct {
+    store Store                               // Penyimpanan entri dan generasi
+    ttl   time.Duration                       // Masa berlaku entri
+    group singleflight.Group                  // Load yang sedang berjalan per key
+}
+
+// New - membuat cache di atas store
+func New(store Store, opts Options) *Cache {
+    if opts.TTL <= 0 {
+        opts.TTL = time.Minute
+    }
+    return &Cache{store: store, ttl: opts.TTL}
+}
+
+// Load - membaca key dari namespace; saat miss, load dijalankan sekali untuk semua request bersamaan lalu hasilnya disimpan.
+// Error dari load tidak disimpan. Setiap pemanggil menerima salinan sendiri, sehingga hasil boleh diubah.
+func Load[T any](ctx context.Context, c *Cache, namespace, key string, load func(ctx context.Context) (T, error)) (T, error) {
+    var value T
+    if c == nil {
+        return load(ctx)
+    }
+    generation, err := c.generation(ctx, namespace)
+    if err != nil {                           // Store tidak dapat dihubungi, baca langsung dari database
+        slog.WarnContext(ctx, "cache unavailable", "namespace", namespace, "error", err)
+        return load(ctx)
+    }
+    fullKey := namespace + ":" + generation + ":" + key  // Generasi baru membuat semua key lama tidak terbaca
+
+    raw, found, err := c.store.Get(ctx, fullKey)
+    if err == nil && found && json.Unmarshal(raw, &value) == nil {
+        return value, nil
+    }
+
+    shared, err, _ := c.group.Do(fullKey, func() (any, error) {
+        loaded, err := load(context.WithoutCancel(ctx))  // Request pertama yang dibatalkan tidak menggagalkan request lain yang menunggu
+        if err != nil {
+            return nil, err
+        }
+        raw, err := json.Marshal(loaded)
+        if err != nil {
+            return nil, err
+        }
+        if err := c.store.Set(ctx, fullKey, raw, c.ttl); err != nil {
+            slog.WarnContext(ctx, "failed to write cache entry", "key", fullKey, "error", err)
+        }
+        return raw, nil
+    })
+    if err != nil {
+        return value, err
+    }
+    err = json.Unmarshal(shared.([]byte), &value)
+    return value, err
+}
+
+// Invalidate - membuat semua entri namespace tidak terbaca lagi dengan mengganti generasinya
+func (c *Cache) Invalidate(ctx context.Context, namespaces ...string) {
+    if c == nil {
+        return
+    }
+    for _, namespace := range namespaces {
+        if err := c.store.Set(ctx, generationKey(namespace), newGeneration(), 0); err != nil {  // Entri lama dibuang oleh TTL atau batas LRU
+            slog.ErrorContext(ctx, "failed to invalidate cache", "namespace", namespace, "error", err)
+        }
+    }
+}
+
+// Follow - mengganti generasi namespace setiap kali event yang cocok muncul di stream, misal {"product.*": {"products", "categories"}}.
+// Setiap instance membaca outbox sendiri, sehingga perubahan dari instance lain dan dari transaksi yang baru di-commit ikut membersihkan cache. Berhenti saat stream berhenti.
+func (c *Cache) Follow(stream *events.Stream, namespaces map[string][]string) {
+    if c == nil {
+        return
+    }
+    patterns := make([]string, 0, len(namespaces))
+    for pattern := range namespaces {
+        patterns = append(patterns, pattern)
+    }
+
+    var lastID uint                           // Event terakhir yang diproses, untuk subscribe ulang
+    for {
+        client, replay, _ := stream.Subscribe(patterns, lastID)
+        for i := range replay {
+            c.invalidateFor(&replay[i], namespaces)
+            lastID = replay[i].ID
+        }
+        for event := range client.Events() {
+            c.invalidateFor(&event, namespaces)
+            lastID = event.ID
+        }
+        if !client.Dropped {                  // Stream berhenti saat shutdown
+            return
+        }
+        slog.Warn("cache invalidation fell behind the event stream, resubscribing", "after_id", lastID)
+    }
+}
+
+func (c *Cache) invalidateFor(event *events.Event, namespaces map[string][]string) {  // Fungsi untuk mengganti generasi namespace yang terkait satu event
+    var matched []string
+    for pattern, names := range namespaces {
+        if events.Match(pattern, event.Type) {
+            matched = append(matched, names...)
+        }
+    }
+    slices.Sort(matched)
+    c.Invalidate(context.Background(), slices.Compact(matched)...)
+}
+
+func (c *Cache) generation(ctx context.Context, namespace string) (string, error) {  // Fungsi untuk membaca generasi namespace, membuat generasi baru jika belum ada
+    raw, found, err := c.store.Get(ctx, generationKey(namespace))
+    if err != nil {
+        return "", err
+    }
+    if found {
+        return string(raw), nil
+    }
+    generation := newGeneration()             // Generasi yang terbuang dari LRU diganti nilai baru, bukan diulang dari awal
+    return string(generation), c.store.Set(ctx, generationKey(namespace), generation, 0)
+}
+
+func generationKey(namespace string) string {  // Fungsi untuk membuat key generasi namespace
+    return "gen:" + namespace
+}
+
+func newGeneration() []byte {                 // Fungsi untuk membuat nilai generasi yang belum pernah dipakai
+    return []byte(strconv.FormatInt(time.Now().UnixNano(), 36))
+}
+
+
+// {{{ Penjelasan Cache }}}
+
+/*
+## Penjelasan Detail
+File cache.go ini berisi Cache yang dipakai service untuk menyimpan hasil GetByID dan GetAll. Berikut penjelasan detailnya:
+
+1. Namespace dan Generasi :
+
+    - Setiap namespace (products, categories) punya nilai generasi di key gen:<namespace>
+    - Key entri berbentuk <namespace>:<generasi>:<key>, sehingga Invalidate cukup mengganti generasi tanpa mencari key satu per satu
+    - Cara ini juga bekerja di Redis tanpa SCAN atau KEYS, karena entri lama hanya menunggu TTL
+    - Generasi dibuat dari waktu dalam nanodetik, sehingga generasi yang terbuang dari LRU tidak pernah menghasilkan nilai lama kembali
+2. Load :
+
+    - Hit: nilai JSON didecode menjadi salinan baru untuk setiap pemanggil
+    - Field dengan tag json:"-" (misal kolom bayangan harga) kosong pada hasil dari cache, sehingga Load hanya cocok untuk hasil yang langsung dikirim sebagai respons
+    - Miss: singleflight memastikan hanya satu query ke database untuk key yang sama, request lain menunggu hasilnya (perlindungan stampede)
+    - Store yang error tidak menggagalkan request, data dibaca langsung dari database
+3. Invalidasi :
+
+    - GormPlugin (gorm.go) mengganti generasi langsung saat tabel yang dipantau berubah, sehingga request berikutnya di instance yang sama membaca data baru
+    - Follow mengganti generasi lagi saat event dari outbox terlihat, sesudah commit dan di setiap instance; ini menutup celah request yang membaca data lama di tengah transaksi
+*/
@@ -0,0 +1,62 @@
+package cache                                 // Mendefinisikan package cache
+
+import (
+    "errors"                                  // Package untuk menggabungkan error pendaftaran callback
+
+    "gorm.io/gorm"                            // Mengimpor ORM GORM
+)
+
+// GormPlugin - plugin GORM yang menginvalidasi namespace cache setiap kali tabel yang dipantau berubah
+type GormPlugin struct {                      // Mendefinisikan struct plugin
+    cache      *Cache                         // Cache yang diinvalidasi
+    namespaces map[string][]string            // Nama tabel -> namespace yang diinvalidasi
+}
+
+// NewGormPlugin - membuat plugin untuk tabel yang dipantau, misal {"products": {"products", "categories"}}
+func NewGormPlugin(cache *Cache, namespaces map[string][]string) *GormPlugin {  // Constructor untuk plugin
+    return &GormPlugin{cache: cache, namespaces: namespaces}  // Mengembalikan instance plugin
+}
+
+func (p *GormPlugin) Name() string {          // Nama plugin (wajib untuk interface gorm.Plugin)
+    return "cache"
+}
+
+func (p *GormPlugin) Initialize(db *gorm.DB) error {  // Method yang dipanggil saat db.Use(plugin)
+    cb := db.Callback()                       // Mengambil registry callback GORM
+    errs := []error{
+        cb.Create().After("gorm:after_create").Register("cache:after_create", p.invalidate),
+        cb.Update().After("gorm:after_update").Register("cache:after_update", p.invalidate),
+        cb.Delete().After("gorm:after_delete").Register("cache:after_delete", p.invalidate),
+    }
+    return errors.Join(errs...)               // Gabungkan error pendaftaran callback (nil jika semua berhasil)
+}
+
+func (p *GormPlugin) invalidate(db *gorm.DB) {  // Callback setelah INSERT, UPDATE, dan DELETE
+    if db.Error != nil || db.DryRun || db.Statement.RowsAffected == 0 {
+        return
+    }
+    if namespaces, ok := p.namespaces[db.Statement.Table]; ok {
+        p.cache.Invalidate(db.Statement.Context, namespaces...)
+    }
+}
+
+
+// {{{ Penjelasan Plugin Cache }}}
+
+/*
+## Penjelasan Detail
+File gorm.go ini berisi plugin GORM untuk invalidasi cache. Berikut penjelasan detailnya:
+
+1. Tabel yang Dipantau :
+
+    - Didaftarkan di main.go, misal perubahan products, product_images, dan product_variants menginvalidasi products dan categories (kategori memuat product)
+    - Perubahan lewat service mana pun ikut terdeteksi, termasuk stok dari inventory dan checkout order
+2. Waktu Invalidasi :
+
+    - Callback berjalan di dalam transaksi, sebelum commit; request lain yang membaca di antara callback dan commit dapat menyimpan data lama
+    - Celah tersebut ditutup oleh Cache.Follow yang menginvalidasi lagi saat event outbox terlihat setelah commit
+3. Statement yang Dilewati :
+
+    - Statement yang gagal, DryRun, atau tidak mengubah baris apa pun
+    - Raw SQL (db.Exec) tidak melewati callback create, update, dan delete
+*/
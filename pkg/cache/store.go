@@ -0,0 +1,120 @@
+package cache                                 // Mendefinisikan package cache
+
+import (
+    "container/list"                          // Package untuk urutan LRU
+    "context"                                 // Package untuk context perintah
+    "sync"                                    // Package untuk mutex
+    "time"                                    // Package untuk masa berlaku entri
+)
+
+// Store - penyimpanan key-value dengan semantik Redis: GET, SET key value PX ttl, dan DEL.
+// Client Redis cukup dibungkus adaptor kecil agar memenuhi interface ini, sehingga package ini tidak bergantung pada library Redis.
+type Store interface {
+    Get(ctx context.Context, key string) (value []byte, found bool, err error)  // found false untuk key yang tidak ada atau kedaluwarsa
+    Set(ctx context.Context, key string, value []byte, ttl time.Duration) error  // ttl 0 berarti tanpa batas waktu
+    Delete(ctx context.Context, keys ...string) error
+}
+
+// LRU - Store di memori proses dengan batas jumlah entri; entri yang paling lama tidak dipakai dibuang lebih dulu
+type LRU struct {
+    maxEntries int                            // Batas jumlah entri
+
+    mu      sync.Mutex                        // Melindungi field di bawah
+    order   *list.List                        // Entri terbaru di depan
+    entries map[string]*list.Element          // Key -> elemen di order
+}
+
+type lruEntry struct {                        // Mendefinisikan struct satu entri LRU
+    key       string                          // Key entri, untuk menghapus dari map saat dibuang
+    value     []byte                          // Nilai entri
+    expiresAt time.Time                       // Waktu kedaluwarsa, kosong untuk entri tanpa batas waktu
+}
+
+// NewLRU - membuat LRU dengan batas maxEntries, default 10000
+func NewLRU(maxEntries int) *LRU {
+    if maxEntries <= 0 {
+        maxEntries = 10000
+    }
+    return &LRU{maxEntries: maxEntries, order: list.New(), entries: map[string]*list.Element{}}
+}
+
+func (l *LRU) Get(_ context.Context, key string) ([]byte, bool, error) {  // Method untuk membaca entri yang belum kedaluwarsa
+    l.mu.Lock()
+    defer l.mu.Unlock()
+    element, ok := l.entries[key]
+    if !ok {
+        return nil, false, nil
+    }
+    entry := element.Value.(*lruEntry)
+    if !entry.expiresAt.IsZero() && time.Now().After(entry.expiresAt) {
+        l.remove(element)                     // Entri kedaluwarsa dibuang saat dibaca
+        return nil, false, nil
+    }
+    l.order.MoveToFront(element)
+    return entry.value, true, nil
+}
+
+func (l *LRU) Set(_ context.Context, key string, value []byte, ttl time.Duration) error {  // Method untuk menyimpan entri dan membuang entri terlama jika penuh
+    var expiresAt time.Time
+    if ttl > 0 {
+        expiresAt = time.Now().Add(ttl)
+    }
+
+    l.mu.Lock()
+    defer l.mu.Unlock()
+    if element, ok := l.entries[key]; ok {
+        entry := element.Value.(*lruEntry)
+        entry.value, entry.expiresAt = value, expiresAt
+        l.order.MoveToFront(element)
+        return nil
+    }
+    l.entries[key] = l.order.PushFront(&lruEntry{key: key, value: value, expiresAt: expiresAt})
+    for l.order.Len() > l.maxEntries {
+        l.remove(l.order.Back())
+    }
+    return nil
+}
+
+func (l *LRU) Delete(_ context.Context, keys ...string) error {  // Method untuk menghapus entri, key yang tidak ada diabaikan
+    l.mu.Lock()
+    defer l.mu.Unlock()
+    for _, key := range keys {
+        if element, ok := l.entries[key]; ok {
+            l.remove(element)
+        }
+    }
+    return nil
+}
+
+// Len - jumlah entri yang tersimpan, termasuk yang sudah kedaluwarsa tetapi belum dibaca
+func (l *LRU) Len() int {
+    l.mu.Lock()
+    defer l.mu.Unlock()
+    return l.order.Len()
+}
+
+func (l *LRU) remove(element *list.Element) {  // Fungsi untuk membuang satu entri; l.mu harus dipegang
+    l.order.Remove(element)
+    delete(l.entries, element.Value.(*lruEntry).key)
+}
+
+
+// {{{ Penjelasan Store }}}
+
+/*
+## Penjelasan Detail
+File store.go ini berisi interface Store dan implementasi LRU di memori. Berikut penjelasan detailnya:
+
+1. Store :
+
+    - Hanya tiga perintah yang dipakai Cache: Get, Set dengan TTL, dan Delete
+    - Semantiknya sama dengan GET, SET PX, dan DEL di Redis, sehingga cache dapat dibagi antar instance dengan adaptor Redis
+2. LRU :
+
+    - map untuk mencari key dan container/list untuk urutan pemakaian, keduanya O(1)
+    - Saat jumlah entri melebihi maxEntries, entri yang paling lama tidak dibaca dibuang
+    - TTL diperiksa saat entri dibaca; entri kedaluwarsa yang tidak pernah dibaca lagi akhirnya terbuang oleh batas LRU
+3. Konkurensi :
+
+    - Satu mutex untuk seluruh LRU; Get juga mengubah urutan sehingga tidak dapat memakai RWMutex
+*/
@@ -1,5 +1,11 @@
 package config                                // Mendefinisikan package config
 
+import (
+    "os"                                      // Package untuk membaca variabel lingkungan
+    "strconv"                                 // Package untuk konversi string ke angka/boolean
+    "time"                                    // Package untuk durasi
+)
+
 type Config struct {                          // Mendefinisikan struct Config untuk menyimpan konfigurasi aplikasi
     DBHost     string                         // Host database
     DBPort     string                         // Port database
@@ -7,17 +13,154 @@ type Config struct {                          // Mendefinisikan struct Config un
     DBPassword string                         // Password database
     DBName     string                         // Nama database
     ServerPort string                         // Port server aplikasi
+    GinMode    string                         // Mode Gin (debug, release, test)
+    MaxBodyBytes int64                        // Batas default ukuran body request dalam byte
+    ShutdownTimeout time.Duration             // Batas waktu menunggu request aktif selesai saat server berhenti
+    LogLevel   string                         // Level log minimum (debug, info, warn, error)
+    LogFormat  string                         // Format log (json atau text)
+    ServiceName        string                 // Nama service yang dilaporkan ke sistem tracing
+    TracingExporter    string                 // Exporter tracing (none, stdout, memory, otlp)
+    OTLPEndpoint       string                 // Alamat collector OTLP/HTTP (host:port)
+    OTLPInsecure       bool                   // Kirim trace ke collector tanpa TLS
+    TracingSampleRatio float64                // Rasio trace yang disampling (0.0 - 1.0)
+    DefaultCurrency    string                 // Mata uang default untuk harga tanpa currency (ISO 4217)
+    AuthSecret         string                 // Kunci HMAC untuk menandatangani token bearer
+    AuthTokenTTL       time.Duration          // Masa berlaku token bearer
+    CartTTL            time.Duration          // Lama cart tanpa aktivitas sebelum dihapus
+    StorageDriver      string                 // Penyimpanan file: local atau s3
+    StorageLocalDir    string                 // Folder untuk driver local
+    StoragePublicURL   string                 // Awalan URL file untuk driver local
+    S3Endpoint         string                 // Endpoint S3, kosong untuk AWS
+    S3Region           string                 // Region S3
+    S3Bucket           string                 // Nama bucket S3
+    S3AccessKey        string                 // Access key S3
+    S3SecretKey        string                 // Secret key S3
+    S3PathStyle        bool                   // Memakai URL endpoint/bucket/key (MinIO)
+    S3PublicURL        string                 // Awalan URL publik opsional untuk file di S3 (CDN)
+    MaxImageBytes      int64                  // Ukuran maksimum satu gambar yang diunggah
+    ImageSizes         string                 // Ukuran thumbnail, misal "small:150,medium:400,large:800"
+    JobQueues          string                 // Antrean job dan jumlah worker, misal "default:4,images:2"
+    JobPollInterval    time.Duration          // Seberapa sering worker memeriksa job baru
+    JobTimeout         time.Duration          // Batas waktu default satu percobaan job
+    JobMaxAttempts     int64                  // Batas percobaan default sebelum job menjadi dead
+    JobRetention       time.Duration          // Lama job yang berhasil atau dibatalkan disimpan
+    EventPollInterval  time.Duration          // Seberapa sering outbox event diperiksa
+    EventRetention     time.Duration          // Lama event yang sudah diteruskan disimpan di outbox
+    EventHTTPURL       string                 // URL yang menerima setiap event sebagai POST JSON, kosong berarti nonaktif
+    EventHTTPTypes     string                 // Pola tipe event untuk EventHTTPURL, misal "product.*,category.deleted"
+    EventHTTPTimeout   time.Duration          // Batas waktu satu request ke EventHTTPURL
+    WebhookTimeout     time.Duration          // Batas waktu satu request webhook ke partner
+    WebhookMaxAttempts int64                  // Jumlah percobaan per event sebelum pengiriman webhook menjadi dead
+    WebhookDisableAfter int64                 // Jumlah percobaan gagal berturut-turut sebelum subscription dinonaktifkan
+    WebhookRetention   time.Duration          // Lama log pengiriman webhook disimpan
+    WebhookAllowPrivate bool                  // Mengizinkan URL webhook ke alamat privat dan loopback
+    SSEReplaySize      int64                  // Jumlah event terakhir yang disimpan untuk resume stream SSE
+    SSEHeartbeat       time.Duration          // Interval event ping di stream SSE
+    WSSendBuffer       int64                  // Pesan yang boleh antre untuk satu koneksi WebSocket sebelum koneksi diputus
+    WSPingInterval     time.Duration          // Interval ping WebSocket; koneksi tanpa pong selama dua interval diputus
+    WSWriteTimeout     time.Duration          // Batas waktu menulis satu pesan WebSocket
+    WSMaxTopics        int64                  // Jumlah topik maksimum per koneksi WebSocket
+    CacheDriver        string                 // Cache hasil baca product dan category: memory atau none
+    CacheTTL           time.Duration          // Masa berlaku satu entri cache
+    CacheMaxEntries    int64                  // Jumlah entri maksimum cache memory
+    HTTPCacheMaxAge    time.Duration          // max-age header Cache-Control untuk GET product dan category, 0 berarti no-cache
 }
 
 func LoadConfig() *Config {                   // Fungsi untuk memuat konfigurasi
-    return &Config{                           // Mengembalikan pointer ke struct Config dengan nilai default
-        DBHost:     "localhost",              // Host database default: localhost
-        DBPort:     "3306",                   // Port default MariaDB: 3306
-        DBUser:     "root",                   // Username database default: root
-        DBPassword: "mariadb",                // Password database default: mariadb
-        DBName:     "learning-go-DB",         // Nama database default: learning-go-DB
-        ServerPort: "8080",                   // Port server default: 8080
+    return &Config{                           // Mengembalikan pointer ke struct Config dengan nilai dari env atau default
+        DBHost:     getEnv("DB_HOST", "localhost"),       // Host database default: localhost
+        DBPort:     getEnv("DB_PORT", "3306"),            // Port default MariaDB: 3306
+        DBUser:     getEnv("DB_USER", "root"),            // Username database default: root
+        DBPassword: getEnv("DB_PASSWORD", "mariadb"),     // Password database default: mariadb
+        DBName:     getEnv("DB_NAME", "learning-go-DB"),  // Nama database default: learning-go-DB
+        ServerPort: getEnv("SERVER_PORT", "8080"),        // Port server default: 8080
+        GinMode:    getEnv("GIN_MODE", "debug"),          // Mode default Gin: debug
+        MaxBodyBytes: getEnvInt64("MAX_BODY_BYTES", 1<<20),  // Default 1 MiB
+        ShutdownTimeout: getEnvDuration("SHUTDOWN_TIMEOUT", 15*time.Second),  // Default menunggu 15 detik
+        LogLevel:   getEnv("LOG_LEVEL", "info"),          // Level log default: info
+        LogFormat:  getEnv("LOG_FORMAT", "json"),         // Format log default: json
+        ServiceName:        getEnv("SERVICE_NAME", "rest-api-go"),       // Nama service default: rest-api-go
+        TracingExporter:    getEnv("TRACING_EXPORTER", "none"),          // Tracing default: nonaktif
+        OTLPEndpoint:       getEnv("OTLP_ENDPOINT", "localhost:4318"),   // Port default OTLP/HTTP: 4318
+        OTLPInsecure:       getEnvBool("OTLP_INSECURE", true),           // Default tanpa TLS untuk collector lokal
+        TracingSampleRatio: getEnvFloat("TRACING_SAMPLE_RATIO", 1.0),   // Default semua trace disampling
+        DefaultCurrency:    getEnv("DEFAULT_CURRENCY", "IDR"),           // Mata uang default: Rupiah
+        AuthSecret:         getEnv("AUTH_SECRET", ""),                    // Kosong: secret acak per proses
+        AuthTokenTTL:       getEnvDuration("AUTH_TOKEN_TTL", 24*time.Hour),  // Token berlaku 24 jam
+        CartTTL:            getEnvDuration("CART_TTL", 7*24*time.Hour),      // Cart terbengkalai dihapus setelah 7 hari
+        StorageDriver:      getEnv("STORAGE_DRIVER", "local"),           // Default menyimpan file di disk
+        StorageLocalDir:    getEnv("STORAGE_LOCAL_DIR", "uploads"),      // Folder default: ./uploads
+        StoragePublicURL:   getEnv("STORAGE_PUBLIC_URL", "/uploads"),    // Disajikan oleh router di /uploads
+        S3Endpoint:         getEnv("S3_ENDPOINT", ""),                   // Kosong: endpoint AWS sesuai region
+        S3Region:           getEnv("S3_REGION", "us-east-1"),            // Region default AWS
+        S3Bucket:           getEnv("S3_BUCKET", ""),
+        S3AccessKey:        getEnv("S3_ACCESS_KEY", ""),
+        S3SecretKey:        getEnv("S3_SECRET_KEY", ""),
+        S3PathStyle:        getEnvBool("S3_PATH_STYLE", false),          // true untuk MinIO
+        S3PublicURL:        getEnv("S3_PUBLIC_URL", ""),
+        MaxImageBytes:      getEnvInt64("MAX_IMAGE_BYTES", 5<<20),       // Default 5 MiB per gambar
+        ImageSizes:         getEnv("IMAGE_SIZES", "small:150,medium:400,large:800"),  // Sisi terpanjang thumbnail dalam piksel
+        JobQueues:          getEnv("JOB_QUEUES", "default:4"),           // Satu antrean dengan 4 worker
+        JobPollInterval:    getEnvDuration("JOB_POLL_INTERVAL", time.Second),  // Job baru diambil paling lambat 1 detik
+        JobTimeout:         getEnvDuration("JOB_TIMEOUT", 5*time.Minute),      // Satu percobaan maksimum 5 menit
+        JobMaxAttempts:     getEnvInt64("JOB_MAX_ATTEMPTS", 5),          // 5 percobaan sebelum dead
+        JobRetention:       getEnvDuration("JOB_RETENTION", 7*24*time.Hour),   // Job selesai dihapus setelah 7 hari
+        EventPollInterval:  getEnvDuration("EVENT_POLL_INTERVAL", time.Second),  // Event diteruskan paling lambat 1 detik
+        EventRetention:     getEnvDuration("EVENT_RETENTION", 7*24*time.Hour),   // Event yang sudah diteruskan dihapus setelah 7 hari
+        EventHTTPURL:       getEnv("EVENT_HTTP_URL", ""),                // Default tanpa sink HTTP
+        EventHTTPTypes:     getEnv("EVENT_HTTP_TYPES", "*"),             // Default semua event
+        EventHTTPTimeout:   getEnvDuration("EVENT_HTTP_TIMEOUT", 10*time.Second),  // Penerima lambat dianggap gagal setelah 10 detik
+        WebhookTimeout:     getEnvDuration("WEBHOOK_TIMEOUT", 10*time.Second),  // Partner lambat dianggap gagal setelah 10 detik
+        WebhookMaxAttempts: getEnvInt64("WEBHOOK_MAX_ATTEMPTS", 12),     // Sekitar 4,5 jam percobaan dengan backoff runner
+        WebhookDisableAfter: getEnvInt64("WEBHOOK_DISABLE_AFTER", 50),   // Subscription nonaktif setelah 50 percobaan gagal berturut-turut
+        WebhookRetention:   getEnvDuration("WEBHOOK_RETENTION", 30*24*time.Hour),  // Log pengiriman disimpan 30 hari
+        WebhookAllowPrivate: getEnvBool("WEBHOOK_ALLOW_PRIVATE", false),  // Hanya untuk pengembangan lokal dengan penerima di localhost
+        SSEReplaySize:      getEnvInt64("SSE_REPLAY_SIZE", 1000),        // 1000 event terakhir dapat dikirim ulang
+        SSEHeartbeat:       getEnvDuration("SSE_HEARTBEAT", 15*time.Second),  // Ping setiap 15 detik, di bawah timeout idle proxy umum
+        WSSendBuffer:       getEnvInt64("WS_SEND_BUFFER", 256),          // 256 pesan belum terkirim sebelum client dianggap terlalu lambat
+        WSPingInterval:     getEnvDuration("WS_PING_INTERVAL", 30*time.Second),  // Ping setiap 30 detik
+        WSWriteTimeout:     getEnvDuration("WS_WRITE_TIMEOUT", 10*time.Second),  // Tulis yang macet 10 detik memutus koneksi
+        WSMaxTopics:        getEnvInt64("WS_MAX_TOPICS", 100),           // 100 product atau kategori per terminal
+        CacheDriver:        getEnv("CACHE_DRIVER", "memory"),            // Default cache LRU di memori proses
+        CacheTTL:           getEnvDuration("CACHE_TTL", time.Minute),    // Entri disimpan paling lama 1 menit
+        CacheMaxEntries:    getEnvInt64("CACHE_MAX_ENTRIES", 10000),     // 10000 entri sebelum entri terlama dibuang
+        HTTPCacheMaxAge:    getEnvDuration("HTTP_CACHE_MAX_AGE", 0),     // Default browser dan proxy selalu memvalidasi ulang
+    }
+}
+
+func getEnv(key, fallback string) string {    // Fungsi helper untuk membaca variabel lingkungan dengan nilai default
+    if value, ok := os.LookupEnv(key); ok && value != "" {  // Jika variabel lingkungan ada dan tidak kosong
+        return value                          // Gunakan nilai dari variabel lingkungan
+    }
+    return fallback                           // Jika tidak ada, gunakan nilai default
+}
+
+func getEnvBool(key string, fallback bool) bool {  // Fungsi helper untuk membaca variabel lingkungan bertipe boolean
+    if value, err := strconv.ParseBool(os.Getenv(key)); err == nil {  // Menerima true/false/1/0
+        return value
+    }
+    return fallback                           // Kosong atau tidak valid, gunakan nilai default
+}
+
+func getEnvInt64(key string, fallback int64) int64 {  // Fungsi helper untuk membaca variabel lingkungan bertipe bilangan bulat
+    if value, err := strconv.ParseInt(os.Getenv(key), 10, 64); err == nil {
+        return value
+    }
+    return fallback                           // Kosong atau tidak valid, gunakan nilai default
+}
+
+func getEnvDuration(key string, fallback time.Duration) time.Duration {  // Fungsi helper untuk membaca variabel lingkungan bertipe durasi (misal 15s, 1m)
+    if value, err := time.ParseDuration(os.Getenv(key)); err == nil {
+        return value
+    }
+    return fallback                           // Kosong atau tidak valid, gunakan nilai default
+}
+
+func getEnvFloat(key string, fallback float64) float64 {  // Fungsi helper untuk membaca variabel lingkungan bertipe float
+    if value, err := strconv.ParseFloat(os.Getenv(key), 64); err == nil {
+        return value
     }
+    return fallback                           // Kosong atau tidak valid, gunakan nilai default
 }
 
 
@@ -37,9 +180,27 @@ File config.go ini berisi konfigurasi untuk koneksi database dan server aplikasi
     - DBPassword : Password untuk koneksi database
     - DBName : Nama database yang digunakan aplikasi
     - ServerPort : Port di mana server aplikasi akan berjalan
+    - GinMode : Mode Gin; di luar mode debug detail error internal tidak dikirim ke client
+    - MaxBodyBytes : Batas ukuran body request global; route tertentu dapat memasang batas sendiri dengan middleware.BodyLimit
+    - ShutdownTimeout : Lama server menunggu request yang sedang berjalan saat menerima SIGTERM
+    - LogLevel : Level log minimum yang ditulis (debug, info, warn, error)
+    - LogFormat : Format output log, json untuk produksi atau text untuk pengembangan lokal
+    - ServiceName, TracingExporter, OTLPEndpoint, OTLPInsecure, TracingSampleRatio : Pengaturan OpenTelemetry tracing
+    - DefaultCurrency : Mata uang default untuk harga yang dikirim tanpa currency
+    - AuthSecret, AuthTokenTTL : Kunci dan masa berlaku token bearer untuk login
+    - CartTTL : Lama cart tanpa aktivitas sebelum dianggap terbengkalai dan dihapus
+    - StorageDriver, StorageLocalDir, StoragePublicURL, S3* : Tempat menyimpan gambar product (lihat pkg/storage)
+    - MaxImageBytes, ImageSizes : Batas ukuran gambar yang diunggah dan ukuran thumbnail yang dibuat
+    - JobQueues, JobPollInterval, JobTimeout, JobMaxAttempts, JobRetention : Pengaturan job latar belakang (lihat pkg/jobs)
+    - EventPollInterval, EventRetention, EventHTTPURL, EventHTTPTypes, EventHTTPTimeout : Pengaturan outbox domain event dan sink HTTP (lihat pkg/events)
+    - WebhookTimeout, WebhookMaxAttempts, WebhookDisableAfter, WebhookRetention, WebhookAllowPrivate : Pengaturan pengiriman webhook ke partner (lihat modul webhook)
+    - SSEReplaySize, SSEHeartbeat : Buffer resume dan interval ping untuk GET /api/events/stream (lihat modul event)
+    - WSSendBuffer, WSPingInterval, WSWriteTimeout, WSMaxTopics : Backpressure, keepalive, dan batas topik untuk GET /api/ws (lihat pkg/realtime)
+    - CacheDriver, CacheTTL, CacheMaxEntries, HTTPCacheMaxAge : Cache hasil baca product dan category serta header Cache-Control (lihat pkg/cache)
 3. Fungsi LoadConfig :
 
-    - Mengembalikan instance Config dengan nilai default
+    - Membaca setiap nilai dari variabel lingkungan (DB_HOST, DB_PORT, LOG_LEVEL, LOG_FORMAT, dll.)
+    - Jika variabel lingkungan tidak diset, nilai default yang digunakan
     - Nilai-nilai ini digunakan untuk koneksi database dan konfigurasi server
 4. Penggunaan :
 
@@ -3,7 +3,10 @@ package database                              // Mendefinisikan package database
 import (
     "fmt"                                     // Package untuk formatting string
     "log"                                     // Package untuk logging
+    "log/slog"                                // Package structured logging bawaan Go
     "rest-api-go/pkg/config"                  // Mengimpor package config aplikasi
+    "rest-api-go/pkg/logger"                  // Mengimpor package logger aplikasi
+    "time"                                    // Package untuk durasi
 
     "gorm.io/driver/mysql"                    // Driver MySQL/MariaDB untuk GORM
     "gorm.io/gorm"                            // ORM GORM
@@ -22,7 +25,9 @@ func Connect() *gorm.DB {                     // Fungsi untuk membuat koneksi da
         cfg.DBName,                           // Nama database dari konfigurasi
     )
 
-    db, err := gorm.Open(mysql.Open(dsn), &gorm.Config{})  // Membuka koneksi database dengan GORM
+    db, err := gorm.Open(mysql.Open(dsn), &gorm.Config{  // Membuka koneksi database dengan GORM
+        Logger: logger.NewGormLogger(slog.Default(), 200*time.Millisecond),  // Log query melalui slog dengan request ID, query > 200ms dianggap lambat
+    })
     if err != nil {
         log.Fatal("Failed to connect to database:", err)   // Log error dan hentikan program jika koneksi gagal
     }
@@ -44,6 +49,7 @@ File database.go ini berisi fungsi untuk membuat koneksi ke database MariaDB/MyS
     - Memuat konfigurasi database dari package config
     - Membuat string koneksi (DSN - Data Source Name) dengan format yang sesuai untuk MySQL/MariaDB
     - Membuka koneksi database menggunakan GORM dengan driver MySQL
+    - Memasang logger.GormLogger sehingga log query ditulis melalui slog beserta request ID
     - Mengembalikan koneksi database jika berhasil atau menghentikan program jika gagal
 3. String Koneksi (DSN) :
 
@@ -0,0 +1,222 @@
+package events                                // Mendefinisikan package events
+
+import (
+    "context"                                 // Package untuk context loop dan job
+    "errors"                                  // Package untuk pengecekan error
+    "fmt"                                     // Package untuk formatting pesan error
+    "log/slog"                                // Package structured logging bawaan Go
+    "rest-api-go/pkg/jobs"                    // Mengimpor runner job untuk pengiriman dengan retry
+    "sync"                                    // Package untuk mutex dan menunggu loop berhenti
+    "time"                                    // Package untuk interval polling
+
+    "gorm.io/gorm"                            // Mengimpor ORM GORM
+    "gorm.io/gorm/clause"                     // Klausa SQL GORM untuk SKIP LOCKED
+)
+
+const (
+    DeliverJob = "events.deliver"             // Tipe job untuk mengirim satu event ke satu sink
+    cleanupJob = "events.cleanup"             // Tipe job bawaan untuk menghapus event lama dari outbox
+)
+
+// Options - pengaturan Dispatcher, nilai kosong memakai default
+type Options struct {
+    PollInterval time.Duration                // Seberapa sering outbox diperiksa, default 1 detik
+    BatchSize    int                          // Jumlah event per transaksi, default 100
+    Retention    time.Duration                // Lama event yang sudah diteruskan disimpan, 0 berarti tidak dihapus
+}
+
+// Dispatcher - meneruskan event dari outbox ke setiap sink lewat runner job
+type Dispatcher struct {
+    db     *gorm.DB                           // Dependency database
+    runner *jobs.Runner                       // Runner yang menjalankan job pengiriman
+    opts   Options                            // Pengaturan dengan default yang sudah diisi
+
+    mu      sync.Mutex                        // Melindungi sinks dan started
+    sinks   map[string]Sink                   // Nama -> sink
+    names   []string                          // Urutan sink sesuai AddSink
+    started bool                              // AddSink ditolak setelah Start
+    stop    context.CancelFunc                // Menghentikan loop polling
+    done    chan struct{}                     // Ditutup saat loop polling selesai
+}
+
+type delivery struct {                        // Mendefinisikan struct payload job DeliverJob
+    EventID uint   `json:"event_id"`          // Event di outbox
+    Sink    string `json:"sink"`              // Nama sink tujuan
+}
+
+// NewDispatcher - membuat dispatcher dan mendaftarkan job pengiriman ke runner; harus dipanggil sebelum runner.Start
+func NewDispatcher(db *gorm.DB, runner *jobs.Runner, opts Options) *Dispatcher {
+    if opts.PollInterval <= 0 {
+        opts.PollInterval = time.Second
+    }
+    if opts.BatchSize <= 0 {
+        opts.BatchSize = 100
+    }
+
+    d := &Dispatcher{db: db, runner: runner, opts: opts, sinks: map[string]Sink{}}
+    runner.Register(DeliverJob, d.deliver, jobs.TypeOptions{})
+    if opts.Retention > 0 {
+        runner.Register(cleanupJob, d.cleanup, jobs.TypeOptions{})
+        runner.Schedule(cleanupJob, "@hourly", cleanupJob, nil)
+    }
+    return d
+}
+
+// AddSink - menambahkan tujuan event; panic jika nama sudah dipakai atau dispatcher sudah berjalan
+func (d *Dispatcher) AddSink(sink Sink) {
+    d.mu.Lock()
+    defer d.mu.Unlock()
+    if d.started {
+        panic(fmt.Sprintf("events: AddSink(%q) after Start", sink.Name()))
+    }
+    if _, ok := d.sinks[sink.Name()]; ok {
+        panic(fmt.Sprintf("events: sink %q added twice", sink.Name()))
+    }
+    d.sinks[sink.Name()] = sink
+    d.names = append(d.names, sink.Name())
+}
+
+// Start - menjalankan loop yang memeriksa outbox setiap PollInterval
+func (d *Dispatcher) Start(ctx context.Context) error {
+    d.mu.Lock()
+    defer d.mu.Unlock()
+    if d.started {
+        return errors.New("events: dispatcher already started")
+    }
+
+    var loopCtx context.Context
+    loopCtx, d.stop = context.WithCancel(context.Background())  // Tidak diturunkan dari ctx agar hanya berhenti lewat Shutdown
+    d.done = make(chan struct{})
+    go d.loop(loopCtx)
+    d.started = true
+    slog.InfoContext(ctx, "event dispatcher started", "sinks", d.names)
+    return nil
+}
+
+// Shutdown - menghentikan loop; event yang belum diteruskan tetap di outbox untuk proses berikutnya
+func (d *Dispatcher) Shutdown(ctx context.Context) error {
+    d.mu.Lock()
+    started := d.started
+    d.mu.Unlock()
+    if !started {
+        return nil
+    }
+
+    d.stop()
+    select {
+    case <-d.done:
+        return nil
+    case <-ctx.Done():
+        return fmt.Errorf("events: dispatcher did not stop: %w", ctx.Err())
+    }
+}
+
+func (d *Dispatcher) loop(ctx context.Context) {  // Fungsi untuk memeriksa outbox secara berkala
+    defer close(d.done)
+    ticker := time.NewTicker(d.opts.PollInterval)
+    defer ticker.Stop()
+    for {
+        for {                                 // Habiskan antrean sebelum menunggu tick berikutnya
+            n, err := d.dispatch(ctx)
+            if err != nil && ctx.Err() == nil {
+                slog.ErrorContext(ctx, "failed to dispatch events", "error", err)
+            }
+            if err != nil || n < d.opts.BatchSize {
+                break
+            }
+        }
+        select {
+        case <-ctx.Done():
+            return
+        case <-ticker.C:
+        }
+    }
+}
+
+func (d *Dispatcher) dispatch(ctx context.Context) (int, error) {  // Fungsi untuk membuat job pengiriman satu batch event dalam satu transaksi
+    var batch []Event
+    err := d.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+        err := tx.Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).  // Instance lain melewati event yang sedang diproses
+            Where("dispatched_at IS NULL").
+            Order("id").
+            Limit(d.opts.BatchSize).
+            Find(&batch).Error
+        if err != nil || len(batch) == 0 {
+            return err
+        }
+
+        ids := make([]uint, 0, len(batch))
+        for i := range batch {
+            event := &batch[i]
+            for _, name := range d.names {
+                if matcher, ok := d.sinks[name].(Matcher); ok && !matcher.Matches(event.Type) {
+                    continue
+                }
+                if _, err := d.runner.EnqueueTx(tx, DeliverJob, delivery{EventID: event.ID, Sink: name}); err != nil {
+                    return err
+                }
+            }
+            ids = append(ids, event.ID)
+        }
+        return tx.Model(&Event{}).Where("id IN ?", ids).Update("dispatched_at", time.Now()).Error
+    })
+    return len(batch), err
+}
+
+func (d *Dispatcher) deliver(ctx context.Context, job *jobs.Job) error {  // Handler job DeliverJob
+    var payload delivery
+    if err := job.Decode(&payload); err != nil {
+        return jobs.Permanent(err)            // Payload rusak tidak akan berhasil walau dicoba lagi
+    }
+    d.mu.Lock()
+    sink, ok := d.sinks[payload.Sink]
+    d.mu.Unlock()
+    if !ok {
+        return jobs.Permanent(fmt.Errorf("events: sink %q is not configured", payload.Sink))  // Sink dihapus dari konfigurasi setelah job dibuat
+    }
+
+    var event Event
+    if err := d.db.WithContext(ctx).First(&event, payload.EventID).Error; err != nil {
+        if errors.Is(err, gorm.ErrRecordNotFound) {
+            return jobs.Permanent(fmt.Errorf("events: event %d no longer exists", payload.EventID))
+        }
+        return err
+    }
+    return sink.Publish(ctx, &event)
+}
+
+func (d *Dispatcher) cleanup(ctx context.Context, _ *jobs.Job) error {  // Handler job bawaan untuk menghapus event yang sudah lama diteruskan
+    cutoff := time.Now().Add(-d.opts.Retention)
+    result := d.db.WithContext(ctx).Where("dispatched_at < ?", cutoff).Delete(&Event{})
+    if result.Error == nil && result.RowsAffected > 0 {
+        slog.InfoContext(ctx, "old events deleted", "count", result.RowsAffected)
+    }
+    return result.Error
+}
+
+
+// {{{ Penjelasan Dispatcher }}}
+
+/*
+## Penjelasan Detail
+File dispatcher.go ini berisi Dispatcher yang meneruskan event dari outbox ke sink. Berikut penjelasan detailnya:
+
+1. Alur :
+
+    - Service menyimpan event dengan Record di transaksi yang sama dengan perubahan
+    - Setiap PollInterval, Dispatcher mengunci satu batch event yang belum diteruskan (FOR UPDATE SKIP LOCKED)
+    - Untuk setiap event dan setiap sink yang berminat dibuat satu job events.deliver, lalu dispatched_at diisi, semuanya dalam satu transaksi
+    - Job events.deliver membaca event dan memanggil sink.Publish
+2. Pengiriman :
+
+    - Retry, backoff, dead letter, dan endpoint /api/jobs berasal dari runner job
+    - Setiap sink punya job sendiri, sehingga sink yang gagal tidak membuat sink lain menerima event dua kali
+    - Pengiriman bersifat at-least-once: penerima harus mengabaikan event dengan ID yang sudah pernah diproses
+    - Urutan pengiriman tidak dijamin saat ada retry; penerima dapat memakai id atau occurred_at
+3. Beberapa Instance :
+
+    - SKIP LOCKED membuat setiap event diteruskan oleh satu instance saja
+4. Retensi :
+
+    - Jika Retention diisi, job events.cleanup menghapus event yang sudah diteruskan setiap jam
+*/
@@ -0,0 +1,104 @@
+package events                                // Mendefinisikan package events
+
+import (
+    "context"                                 // Package untuk context sink
+    "encoding/json"                           // Package untuk data event
+    "fmt"                                     // Package untuk membungkus error
+    "regexp"                                  // Package untuk format pola tipe event
+    "rest-api-go/pkg/logger"                  // Mengimpor package logger untuk request ID
+    "strings"                                 // Package untuk mencocokkan pola tipe event
+    "time"                                    // Package untuk waktu kejadian
+
+    "gorm.io/gorm"                            // Mengimpor ORM GORM
+)
+
+// Event - satu domain event di tabel outbox
+type Event struct {
+    ID           uint            `json:"id" gorm:"primaryKey"`  // ID event, urutan kejadian di seluruh aplikasi
+    Type         string          `json:"type" gorm:"size:128;not null;index"`  // Tipe event, misal "product.price_changed"
+    EntityType   string          `json:"entity" gorm:"size:64;not null"`  // Nama entitas, misal "product"
+    EntityID     uint            `json:"entity_id" gorm:"not null"`  // Primary key entitas
+    Data         json.RawMessage `json:"data" gorm:"type:text;not null"`  // Isi event dalam JSON
+    RequestID    string          `json:"request_id,omitempty" gorm:"size:128"`  // Request yang memicu event
+    OccurredAt   time.Time       `json:"occurred_at" gorm:"not null"`  // Waktu perubahan
+    DispatchedAt *time.Time      `json:"-" gorm:"index"`  // Waktu event diteruskan ke sink, null selama masih di outbox
+}
+
+func (Event) TableName() string {             // Nama tabel outbox
+    return "outbox_events"
+}
+
+// Decode - membaca data event ke v
+func (e *Event) Decode(v any) error {
+    return json.Unmarshal(e.Data, v)
+}
+
+// Record - menyimpan event ke outbox di transaksi tx, sehingga event hanya ada jika perubahan ikut di-commit
+func Record(tx *gorm.DB, typ, entity string, id uint, data any) error {
+    raw, err := json.Marshal(data)
+    if err != nil {
+        return fmt.Errorf("events: encode %s: %w", typ, err)
+    }
+    event := Event{
+        Type:       typ,
+        EntityType: entity,
+        EntityID:   id,
+        Data:       raw,
+        RequestID:  logger.RequestIDFromContext(tx.Statement.Context),
+        OccurredAt: time.Now().UTC(),
+    }
+    return tx.Create(&event).Error
+}
+
+// Sink - tujuan event, misal subscriber di proses yang sama, webhook, atau message broker
+type Sink interface {
+    Name() string                             // Nama unik, disimpan di job pengiriman
+    Publish(ctx context.Context, event *Event) error  // Error membuat pengiriman dicoba lagi oleh runner job
+}
+
+// Matcher - sink yang hanya menerima sebagian tipe event; sink tanpa Matcher menerima semua event
+type Matcher interface {
+    Matches(eventType string) bool
+}
+
+// Match - mencocokkan tipe event dengan pola: "*", "product.*", atau nama lengkap
+func Match(pattern, eventType string) bool {
+    if pattern == "*" || pattern == eventType {
+        return true
+    }
+    prefix, ok := strings.CutSuffix(pattern, "*")
+    return ok && strings.HasPrefix(eventType, prefix)
+}
+
+var patternFormat = regexp.MustCompile(`^[a-z][a-z0-9_]*(\.[a-z][a-z0-9_]*)*(\.\*)?$`)  // "product", "product.price_changed", "product.*"
+
+// ValidPattern - memeriksa format pola yang diterima Match, dipakai untuk memvalidasi input pengguna
+func ValidPattern(pattern string) bool {
+    return pattern == "*" || patternFormat.MatchString(pattern)
+}
+
+
+// {{{ Penjelasan Struktur Event }}}
+
+/*
+## Penjelasan Detail
+File event.go ini mendefinisikan domain event dan transactional outbox. Berikut penjelasan detailnya:
+
+1. Event :
+
+    - Disimpan di tabel outbox_events oleh Record, di transaksi yang sama dengan perubahan data
+    - Jika transaksi di-rollback, event ikut hilang; jika di-commit, event pasti akan dikirim
+    - DispatchedAt diisi oleh Dispatcher setelah job pengiriman untuk setiap sink dibuat
+2. Record :
+
+    - Dipanggil service di dalam db.Transaction, misal events.Record(tx, "product.created", "product", product.ID, product)
+    - Request ID diambil dari context tx sehingga event dapat dihubungkan dengan access log dan audit log
+3. Sink dan Matcher :
+
+    - Sink menerima event satu per satu; error membuat pengiriman ke sink itu saja yang dicoba lagi
+    - Matcher dipakai Dispatcher agar event yang tidak diminati sink tidak membuat job
+4. Match :
+
+    - "*" cocok dengan semua event, "product.*" dengan semua event product, selain itu harus sama persis
+    - ValidPattern menolak pola lain seperti "product*" atau "*.created", yang tidak akan pernah cocok seperti yang diharapkan
+*/
@@ -0,0 +1,176 @@
+package events                                // Mendefinisikan package events
+
+import (
+    "bytes"                                   // Package untuk body request HTTP
+    "context"                                 // Package untuk context pengiriman
+    "encoding/json"                           // Package untuk encoding event
+    "errors"                                  // Package untuk menggabungkan error subscriber
+    "fmt"                                     // Package untuk formatting pesan error
+    "io"                                      // Package untuk membuang body respons
+    "net/http"                                // Package client HTTP
+    "rest-api-go/pkg/jobs"                    // Mengimpor jobs.Permanent untuk error yang tidak perlu dicoba lagi
+    "slices"                                  // Package untuk mencocokkan daftar pola
+    "strings"                                 // Package untuk membersihkan pola tipe event
+    "sync"                                    // Package untuk mutex subscriber
+    "time"                                    // Package untuk timeout HTTP
+)
+
+// Subscriber - fungsi yang menerima event di proses yang sama
+type Subscriber func(ctx context.Context, event *Event) error
+
+type subscription struct {                    // Mendefinisikan struct satu subscriber
+    pattern string                            // Pola tipe event, misal "product.*"
+    fn      Subscriber                        // Fungsi yang dipanggil
+}
+
+// Bus - sink untuk subscriber di proses yang sama
+type Bus struct {
+    mu   sync.RWMutex                         // Melindungi daftar subscriber
+    subs []subscription                       // Subscriber sesuai urutan Subscribe
+}
+
+// NewBus - membuat bus tanpa subscriber
+func NewBus() *Bus {
+    return &Bus{}
+}
+
+// Subscribe - mendaftarkan fungsi untuk event yang cocok dengan pattern ("*", "product.*", atau "product.created")
+func (b *Bus) Subscribe(pattern string, fn Subscriber) {
+    b.mu.Lock()
+    defer b.mu.Unlock()
+    b.subs = append(b.subs, subscription{pattern, fn})
+}
+
+func (b *Bus) Name() string {                 // Nama sink di job pengiriman
+    return "local"
+}
+
+func (b *Bus) Matches(eventType string) bool {  // Event tanpa subscriber tidak membuat job
+    b.mu.RLock()
+    defer b.mu.RUnlock()
+    return slices.ContainsFunc(b.subs, func(s subscription) bool { return Match(s.pattern, eventType) })
+}
+
+func (b *Bus) Publish(ctx context.Context, event *Event) error {  // Memanggil semua subscriber yang cocok
+    b.mu.RLock()
+    subs := slices.Clone(b.subs)
+    b.mu.RUnlock()
+
+    var errs []error
+    for _, s := range subs {
+        if Match(s.pattern, event.Type) {
+            if err := s.fn(ctx, event); err != nil {
+                errs = append(errs, err)
+            }
+        }
+    }
+    return errors.Join(errs...)               // Retry memanggil ulang semua subscriber, sehingga subscriber harus idempotent
+}
+
+// HTTPSink - sink yang mengirim setiap event sebagai POST JSON ke satu URL
+type HTTPSink struct {
+    url    string                             // URL tujuan
+    types  []string                           // Pola tipe event, kosong berarti semua
+    client *http.Client                       // Client dengan timeout
+}
+
+// NewHTTPSink - membuat sink HTTP; types kosong berarti semua event
+func NewHTTPSink(url string, types []string, timeout time.Duration) *HTTPSink {
+    patterns := make([]string, 0, len(types))
+    for _, t := range types {
+        if t = strings.TrimSpace(t); t != "" {  // Mengizinkan spasi setelah koma di konfigurasi
+            patterns = append(patterns, t)
+        }
+    }
+    return &HTTPSink{url: url, types: patterns, client: &http.Client{Timeout: timeout}}
+}
+
+func (h *HTTPSink) Name() string {            // Nama sink di job pengiriman
+    return "http"
+}
+
+func (h *HTTPSink) Matches(eventType string) bool {
+    return len(h.types) == 0 || slices.ContainsFunc(h.types, func(pattern string) bool { return Match(pattern, eventType) })
+}
+
+func (h *HTTPSink) Publish(ctx context.Context, event *Event) error {  // Mengirim event, status 2xx berarti berhasil
+    body, err := json.Marshal(event)
+    if err != nil {
+        return jobs.Permanent(err)
+    }
+    req, err := http.NewRequestWithContext(ctx, http.MethodPost, h.url, bytes.NewReader(body))
+    if err != nil {
+        return jobs.Permanent(err)            // URL tidak valid
+    }
+    req.Header.Set("Content-Type", "application/json")
+    req.Header.Set("X-Event-ID", fmt.Sprint(event.ID))  // Penerima memakai ID ini untuk mengabaikan event duplikat
+    req.Header.Set("X-Event-Type", event.Type)
+
+    resp, err := h.client.Do(req)
+    if err != nil {
+        return err                            // Jaringan atau timeout, dicoba lagi
+    }
+    defer resp.Body.Close()
+    io.Copy(io.Discard, io.LimitReader(resp.Body, 64<<10))  // Koneksi dapat dipakai ulang
+
+    switch {
+    case resp.StatusCode >= 200 && resp.StatusCode < 300:
+        return nil
+    case resp.StatusCode >= 400 && resp.StatusCode < 500 && resp.StatusCode != http.StatusRequestTimeout && resp.StatusCode != http.StatusTooManyRequests:
+        return jobs.Permanent(fmt.Errorf("events: %s responded %s", h.url, resp.Status))  // Request ditolak, tidak akan berhasil walau dicoba lagi
+    default:
+        return fmt.Errorf("events: %s responded %s", h.url, resp.Status)
+    }
+}
+
+// Broker - client message broker (Kafka, NATS, RabbitMQ, ...) yang dipakai BrokerSink
+type Broker interface {
+    Publish(ctx context.Context, topic, key string, body []byte) error
+}
+
+// BrokerSink - sink yang menerbitkan event ke message broker, satu topik per tipe event
+type BrokerSink struct {
+    name   string                             // Nama sink, misal "kafka"
+    broker Broker                             // Client broker
+    prefix string                             // Awalan topik, misal "catalog."
+}
+
+// NewBrokerSink - membuat sink broker; topik = prefix + tipe event, key = "<entity>:<id>" agar event satu entitas masuk partisi yang sama
+func NewBrokerSink(name string, broker Broker, topicPrefix string) *BrokerSink {
+    return &BrokerSink{name: name, broker: broker, prefix: topicPrefix}
+}
+
+func (b *BrokerSink) Name() string {          // Nama sink di job pengiriman
+    return b.name
+}
+
+func (b *BrokerSink) Publish(ctx context.Context, event *Event) error {
+    body, err := json.Marshal(event)
+    if err != nil {
+        return jobs.Permanent(err)
+    }
+    return b.broker.Publish(ctx, b.prefix+event.Type, fmt.Sprintf("%s:%d", event.EntityType, event.EntityID), body)
+}
+
+
+// {{{ Penjelasan Sink }}}
+
+/*
+## Penjelasan Detail
+File sinks.go ini berisi tujuan event yang tersedia. Berikut penjelasan detailnya:
+
+1. Bus (sink "local") :
+
+    - Subscriber di proses yang sama, didaftarkan dengan bus.Subscribe("product.*", fn)
+    - Semua subscriber yang cocok dipanggil; jika salah satu gagal, semuanya dipanggil ulang saat retry
+    - Event tanpa subscriber yang cocok tidak membuat job
+2. HTTPSink (sink "http") :
+
+    - POST JSON event ke satu URL dengan header X-Event-ID dan X-Event-Type
+    - 2xx berhasil; 4xx selain 408 dan 429 langsung dead; error lain dan 5xx dicoba lagi
+3. BrokerSink :
+
+    - Adapter untuk message broker lewat interface Broker, sehingga package ini tidak bergantung pada library broker tertentu
+    - Topik prefix + tipe event (misal catalog.product.created), key "<entity>:<id>"
+Body yang dikirim HTTPSink dan BrokerSink sama dengan JSON Event: id, type, entity, entity_id, data, request_id, occurred_at.
+*/
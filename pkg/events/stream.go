@@ -0,0 +1,263 @@
+package events                                // Mendefinisikan package events
+
+import (
+    "context"                                 // Package untuk context loop
+    "errors"                                  // Package untuk membuat error
+    "fmt"                                     // Package untuk formatting pesan error
+    "log/slog"                                // Package structured logging bawaan Go
+    "slices"                                  // Package untuk membalik dan mencari buffer
+    "sync"                                    // Package untuk mutex dan menunggu loop berhenti
+    "time"                                    // Package untuk interval polling
+
+    "gorm.io/gorm"                            // Mengimpor ORM GORM
+)
+
+const (
+    streamBatchSize  = 500                    // Jumlah event per query outbox
+    clientBufferSize = 256                    // Event yang boleh antre untuk satu client sebelum client diputus
+    gapTimeout       = time.Minute            // Lama ID yang terlewat ditunggu, misal dari transaksi yang belum di-commit
+    maxGaps          = 1000                   // Batas ID terlewat yang ditunggu bersamaan
+)
+
+// StreamOptions - pengaturan Stream, nilai kosong memakai default
+type StreamOptions struct {
+    PollInterval time.Duration                // Seberapa sering outbox dibaca, default 1 detik
+    BufferSize   int                          // Jumlah event terakhir yang disimpan untuk resume, default 1000
+}
+
+// Stream - membaca outbox di setiap instance dan meneruskan event baru ke client yang terhubung (misal SSE)
+type Stream struct {
+    db   *gorm.DB                             // Dependency database
+    opts StreamOptions                        // Pengaturan dengan default yang sudah diisi
+
+    mu      sync.Mutex                        // Melindungi seluruh field di bawah
+    buffer  []Event                           // Event terakhir sesuai urutan diterima, untuk resume
+    lastID  uint                              // ID terbesar yang sudah dibaca
+    gaps    map[uint]time.Time                // ID yang terlewat dan waktu pertama kali terlihat
+    clients map[*StreamClient]struct{}        // Client yang sedang terhubung
+    started bool                              // Start sudah dipanggil
+    closed  bool                              // Shutdown sudah dipanggil
+    stop    context.CancelFunc                // Menghentikan loop polling
+    done    chan struct{}                     // Ditutup saat loop polling selesai
+}
+
+// StreamClient - satu client yang menerima event yang cocok dengan polanya
+type StreamClient struct {
+    ch       chan Event                       // Event untuk client, ditutup saat client diputus atau Stream berhenti
+    patterns []string                         // Pola tipe event
+    Dropped  bool                             // Diputus karena terlalu lambat membaca
+}
+
+// Events - channel event untuk client; ditutup saat client harus berhenti
+func (c *StreamClient) Events() <-chan Event {
+    return c.ch
+}
+
+func (c *StreamClient) matches(eventType string) bool {  // Fungsi untuk mencocokkan tipe event dengan pola client
+    return slices.ContainsFunc(c.patterns, func(pattern string) bool { return Match(pattern, eventType) })
+}
+
+// NewStream - membuat stream; Start harus dipanggil agar event dibaca dari outbox
+func NewStream(db *gorm.DB, opts StreamOptions) *Stream {
+    if opts.PollInterval <= 0 {
+        opts.PollInterval = time.Second
+    }
+    if opts.BufferSize <= 0 {
+        opts.BufferSize = 1000
+    }
+    return &Stream{db: db, opts: opts, gaps: map[uint]time.Time{}, clients: map[*StreamClient]struct{}{}}
+}
+
+// Start - mengisi buffer dengan event terakhir di outbox lalu menjalankan loop polling
+func (s *Stream) Start(ctx context.Context) error {
+    s.mu.Lock()
+    defer s.mu.Unlock()
+    if s.started {
+        return errors.New("events: stream already started")
+    }
+
+    var recent []Event                        // Client yang terhubung sebelum restart tetap dapat resume
+    if err := s.db.WithContext(ctx).Order("id DESC").Limit(s.opts.BufferSize).Find(&recent).Error; err != nil {
+        return fmt.Errorf("events: load recent events: %w", err)
+    }
+    slices.Reverse(recent)
+    s.buffer = recent
+    if len(recent) > 0 {
+        s.lastID = recent[len(recent)-1].ID
+    }
+
+    var loopCtx context.Context
+    loopCtx, s.stop = context.WithCancel(context.Background())  // Tidak diturunkan dari ctx agar hanya berhenti lewat Shutdown
+    s.done = make(chan struct{})
+    go s.loop(loopCtx)
+    s.started = true
+    return nil
+}
+
+// Shutdown - menghentikan loop dan memutus semua client agar request streaming selesai sebelum server berhenti
+func (s *Stream) Shutdown(ctx context.Context) error {
+    s.mu.Lock()
+    started := s.started && !s.closed
+    s.closed = true
+    for client := range s.clients {
+        close(client.ch)
+        delete(s.clients, client)
+    }
+    s.mu.Unlock()
+    if !started {
+        return nil
+    }
+
+    s.stop()
+    select {
+    case <-s.done:
+        return nil
+    case <-ctx.Done():
+        return fmt.Errorf("events: stream did not stop: %w", ctx.Err())
+    }
+}
+
+// Subscribe - mendaftarkan client dengan pola tipe event; jika lastID diisi, event setelahnya dari buffer dikembalikan untuk dikirim lebih dulu.
+// complete false berarti sebagian event setelah lastID sudah keluar dari buffer, sehingga client harus memuat ulang datanya.
+func (s *Stream) Subscribe(patterns []string, lastID uint) (client *StreamClient, replay []Event, complete bool) {
+    client = &StreamClient{ch: make(chan Event, clientBufferSize), patterns: patterns}
+    s.mu.Lock()
+    defer s.mu.Unlock()
+    if s.closed {
+        close(client.ch)                      // Server sedang berhenti, client langsung selesai dan akan tersambung ke instance lain
+        return client, nil, true
+    }
+    s.clients[client] = struct{}{}
+
+    if lastID == 0 {
+        return client, nil, true
+    }
+    i := slices.IndexFunc(s.buffer, func(e Event) bool { return e.ID == lastID })
+    if i < 0 {
+        return client, nil, lastID >= s.lastID  // Tidak ada event yang terlewat jika client sudah menerima ID terbesar
+    }
+    for _, event := range s.buffer[i+1:] {
+        if client.matches(event.Type) {
+            replay = append(replay, event)
+        }
+    }
+    return client, replay, true
+}
+
+// Unsubscribe - melepas client, dipanggil saat request streaming selesai
+func (s *Stream) Unsubscribe(client *StreamClient) {
+    s.mu.Lock()
+    defer s.mu.Unlock()
+    if _, ok := s.clients[client]; ok {
+        close(client.ch)
+        delete(s.clients, client)
+    }
+}
+
+func (s *Stream) loop(ctx context.Context) {  // Fungsi untuk membaca outbox secara berkala
+    defer close(s.done)
+    ticker := time.NewTicker(s.opts.PollInterval)
+    defer ticker.Stop()
+    for {
+        for {                                 // Habiskan event baru sebelum menunggu tick berikutnya
+            n, err := s.poll(ctx)
+            if err != nil && ctx.Err() == nil {
+                slog.ErrorContext(ctx, "failed to read events for stream", "error", err)
+            }
+            if err != nil || n < streamBatchSize {
+                break
+            }
+        }
+        select {
+        case <-ctx.Done():
+            return
+        case <-ticker.C:
+        }
+    }
+}
+
+func (s *Stream) poll(ctx context.Context) (int, error) {  // Fungsi untuk membaca event baru dan ID terlewat yang akhirnya di-commit
+    s.mu.Lock()
+    lastID := s.lastID
+    now := time.Now()
+    gaps := make([]uint, 0, len(s.gaps))
+    for id, seen := range s.gaps {
+        if now.Sub(seen) > gapTimeout {
+            delete(s.gaps, id)                // Transaksi di-rollback atau ID dilewati auto increment
+            continue
+        }
+        gaps = append(gaps, id)
+    }
+    s.mu.Unlock()
+
+    query := s.db.WithContext(ctx).Where("id > ?", lastID)
+    if len(gaps) > 0 {
+        query = s.db.WithContext(ctx).Where("id > ? OR id IN ?", lastID, gaps)
+    }
+    var batch []Event
+    if err := query.Order("id").Limit(streamBatchSize).Find(&batch).Error; err != nil {
+        return 0, err
+    }
+
+    s.mu.Lock()
+    defer s.mu.Unlock()
+    for _, event := range batch {
+        if event.ID > s.lastID {
+            if s.lastID > 0 && event.ID-s.lastID <= maxGaps {  // Lompatan besar berasal dari outbox kosong atau rollback massal, bukan transaksi yang masih berjalan
+                for id := s.lastID + 1; id < event.ID && len(s.gaps) < maxGaps; id++ {
+                    s.gaps[id] = now          // Transaksi dengan ID lebih kecil mungkin belum di-commit
+                }
+            }
+            s.lastID = event.ID
+        } else if _, ok := s.gaps[event.ID]; ok {
+            delete(s.gaps, event.ID)
+        } else {
+            continue                          // Sudah diteruskan sebelumnya
+        }
+        s.publish(event)
+    }
+    return len(batch), nil
+}
+
+func (s *Stream) publish(event Event) {       // Fungsi untuk menyimpan event di buffer dan mengirimnya ke client; s.mu harus dipegang
+    s.buffer = append(s.buffer, event)
+    if over := len(s.buffer) - s.opts.BufferSize; over > 0 {
+        s.buffer = slices.Delete(s.buffer, 0, over)
+    }
+    for client := range s.clients {
+        if !client.matches(event.Type) {
+            continue
+        }
+        select {
+        case client.ch <- event:
+        default:                              // Client tidak membaca cukup cepat; diputus agar tidak menahan client lain
+            client.Dropped = true
+            close(client.ch)
+            delete(s.clients, client)
+        }
+    }
+}
+
+
+// {{{ Penjelasan Stream }}}
+
+/*
+## Penjelasan Detail
+File stream.go ini berisi Stream yang meneruskan event dari outbox ke client yang terhubung langsung, misal Server-Sent Events. Berikut penjelasan detailnya:
+
+1. Membaca Outbox :
+
+    - Setiap instance membaca outbox_events sendiri (id > ID terakhir), sehingga client di instance mana pun menerima semua event
+    - Berbeda dengan Dispatcher, Stream tidak mengunci atau menandai event, dan tidak bergantung pada dispatched_at
+    - ID yang terlewat (transaksi dengan ID lebih kecil yang belum di-commit) ditunggu sampai gapTimeout, lalu dianggap di-rollback
+2. Buffer Replay :
+
+    - BufferSize event terakhir disimpan sesuai urutan diterima, dan diisi dari outbox saat Start agar resume tetap bekerja setelah restart
+    - Subscribe dengan lastID mengembalikan event setelah lastID di buffer; jika lastID sudah keluar dari buffer, complete bernilai false
+3. Client Lambat :
+
+    - Setiap client punya antrean clientBufferSize event; jika penuh, client diputus (Dropped) dan dapat tersambung lagi dengan Last-Event-ID
+4. Shutdown :
+
+    - Semua channel client ditutup agar handler streaming selesai dan server dapat berhenti tanpa menunggu SHUTDOWN_TIMEOUT
+*/
@@ -0,0 +1,212 @@
+package fieldset                              // Mendefinisikan package fieldset
+
+import (
+    "bytes"                                   // Package untuk menyusun ulang object JSON
+    "encoding/json"                           // Package untuk membaca dan menulis JSON
+    "fmt"                                     // Package untuk formatting pesan error
+    "net/http"                                // Package untuk konstanta HTTP
+    "net/url"                                 // Package untuk query string
+    "rest-api-go/pkg/utils"                   // Mengimpor utilitas aplikasi untuk HTTPError
+    "slices"                                  // Package untuk mengurutkan dan membuang duplikat
+    "strings"                                 // Package untuk memecah daftar dipisah koma
+
+    "github.com/gin-gonic/gin"                // Mengimpor framework web Gin
+)
+
+// Schema - whitelist field dan relasi satu entitas untuk ?fields= dan ?include=
+type Schema struct {
+    Fields   map[string][]string              // Nama field JSON -> kolom database; nil untuk field yang dimuat terpisah, misal relasi images
+    Always   []string                         // Kolom yang selalu dipilih, misal primary key untuk preload
+    Includes map[string][]string              // Relasi opsional -> kolom yang dibutuhkan untuk memuatnya, misal category -> category_id
+}
+
+// Selection - field dan relasi yang diminta client; nilai kosong berarti semua field tanpa relasi opsional
+type Selection struct {
+    fields   []string                         // Field yang diminta, terurut; nil berarti semua field
+    includes []string                         // Relasi yang diminta, terurut
+    columns  []string                         // Kolom SELECT, nil berarti semua kolom
+}
+
+// Parse - membaca ?fields=id,title,price dan ?include=category; nama di luar whitelist ditolak dengan 400
+func (s *Schema) Parse(query url.Values) (Selection, error) {
+    var sel Selection
+    if fields := split(query["fields"]); len(fields) > 0 {
+        columns := slices.Clone(s.Always)
+        for _, field := range fields {
+            fieldColumns, ok := s.Fields[field]
+            if !ok {
+                return Selection{}, unknown("field", field, s.Fields)
+            }
+            columns = append(columns, fieldColumns...)
+        }
+        sel.fields, sel.columns = fields, columns
+    }
+    for _, include := range split(query["include"]) {
+        includeColumns, ok := s.Includes[include]
+        if !ok {
+            return Selection{}, unknown("include", include, s.Includes)
+        }
+        sel.includes = append(sel.includes, include)
+        if sel.columns != nil {               // Foreign key relasi tetap dipilih walaupun tidak diminta di ?fields=
+            sel.columns = append(sel.columns, includeColumns...)
+        }
+    }
+    if sel.columns != nil {
+        slices.Sort(sel.columns)
+        sel.columns = slices.Compact(sel.columns)
+    }
+    return sel, nil
+}
+
+// Bind - membaca ?fields= dan ?include= dari request; jika tidak valid, respons 400 sudah dikirim dan ok bernilai false
+func (s *Schema) Bind(c *gin.Context) (sel Selection, ok bool) {
+    sel, err := s.Parse(c.Request.URL.Query())
+    if err != nil {
+        utils.HandleError(c, http.StatusBadRequest, err)
+        return Selection{}, false
+    }
+    return sel, true
+}
+
+// Respond - mengirim value dalam respons sukses standar, hanya dengan field yang diminta
+func Respond(c *gin.Context, status int, sel Selection, value any) {
+    data, err := sel.Project(value)
+    if err != nil {
+        utils.HandleError(c, http.StatusInternalServerError, err)
+        return
+    }
+    c.JSON(status, utils.SuccessResponse(data))
+}
+
+// Field - true jika field diminta atau ?fields= tidak dikirim
+func (s Selection) Field(name string) bool {
+    return s.fields == nil || slices.Contains(s.fields, name)
+}
+
+// Include - true jika relasi diminta lewat ?include=
+func (s Selection) Include(name string) bool {
+    return slices.Contains(s.includes, name)
+}
+
+// Columns - kolom untuk db.Select, nil berarti semua kolom
+func (s Selection) Columns() []string {
+    return s.columns
+}
+
+// Key - bentuk teks yang sama untuk pilihan yang sama, untuk key cache; kosong untuk pilihan default
+func (s Selection) Key() string {
+    if s.fields == nil && s.includes == nil {
+        return ""
+    }
+    return "fields=" + strings.Join(s.fields, ",") + ";include=" + strings.Join(s.includes, ",")
+}
+
+// Project - membuang key JSON yang tidak diminta dari object atau array object; tanpa ?fields= value dikembalikan apa adanya
+func (s Selection) Project(value any) (any, error) {
+    if s.fields == nil {
+        return value, nil
+    }
+    raw, err := json.Marshal(value)
+    if err != nil {
+        return nil, err
+    }
+    keep := append(slices.Clone(s.fields), s.includes...)  // Relasi yang di-include tetap dikirim
+
+    if !bytes.HasPrefix(raw, []byte("[")) {
+        return project(raw, keep)
+    }
+    var items []json.RawMessage
+    if err := json.Unmarshal(raw, &items); err != nil {
+        return nil, err
+    }
+    for i := range items {
+        if items[i], err = project(items[i], keep); err != nil {
+            return nil, err
+        }
+    }
+    return items, nil
+}
+
+func project(raw json.RawMessage, keep []string) (json.RawMessage, error) {  // Fungsi untuk menyalin key yang diminta dari satu object JSON, urutan key dipertahankan
+    dec := json.NewDecoder(bytes.NewReader(raw))
+    if token, err := dec.Token(); err != nil || token != json.Delim('{') {
+        return raw, err                       // Bukan object, misal null
+    }
+    var out bytes.Buffer
+    out.WriteByte('{')
+    for dec.More() {
+        token, err := dec.Token()
+        if err != nil {
+            return nil, err
+        }
+        key := token.(string)                 // Token pertama setiap pasangan di dalam object selalu key
+        var value json.RawMessage
+        if err := dec.Decode(&value); err != nil {
+            return nil, err
+        }
+        if !slices.Contains(keep, key) {
+            continue
+        }
+        if out.Len() > 1 {
+            out.WriteByte(',')
+        }
+        name, _ := json.Marshal(key)
+        out.Write(name)
+        out.WriteByte(':')
+        out.Write(value)
+    }
+    out.WriteByte('}')
+    return out.Bytes(), nil
+}
+
+func split(values []string) []string {        // Fungsi untuk memecah nilai dipisah koma, boleh diulang (?fields=id&fields=title)
+    var names []string
+    for _, value := range values {
+        for _, name := range strings.Split(value, ",") {
+            if name = strings.TrimSpace(name); name != "" {
+                names = append(names, name)
+            }
+        }
+    }
+    slices.Sort(names)
+    return slices.Compact(names)
+}
+
+func unknown(param, name string, allowed map[string][]string) error {  // Fungsi untuk membuat error 400 beserta daftar nama yang diizinkan
+    names := make([]string, 0, len(allowed))
+    for allowedName := range allowed {
+        names = append(names, allowedName)
+    }
+    slices.Sort(names)
+    return utils.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("unknown %s %q, allowed: %s", param, name, strings.Join(names, ", ")))
+}
+
+
+// {{{ Penjelasan Fieldset }}}
+
+/*
+## Penjelasan Detail
+File fieldset.go ini berisi sparse fieldset (?fields=) dan relasi opsional (?include=) untuk endpoint baca. Berikut penjelasan detailnya:
+
+1. Schema :
+
+    - Setiap entitas mendaftarkan whitelist field JSON beserta kolom database-nya, misal price -> price dan currency
+    - Field tanpa kolom (nil) adalah relasi yang selalu dikirim secara default, misal images; service tidak memuatnya jika tidak diminta
+    - Includes berisi relasi yang hanya dimuat jika diminta, beserta kolom yang dibutuhkan untuk memuatnya
+2. Parse :
+
+    - ?fields=id,title,price dan ?include=category, nilai dipisah koma atau parameter diulang
+    - Nama di luar whitelist ditolak dengan 400 beserta daftar nama yang diizinkan
+    - Kolom SELECT = Always + kolom field yang diminta + kolom yang dibutuhkan relasi
+3. Project :
+
+    - Kolom yang tidak dipilih tetap muncul di struct sebagai nilai kosong, sehingga key JSON yang tidak diminta dibuang setelah marshal
+    - Urutan key mengikuti struct, bukan urutan abjad
+    - Relasi yang di-include selalu dikirim walaupun tidak disebut di ?fields=
+4. Bind dan Respond :
+
+    - Dipakai handler: Bind mengirim 400 untuk nama yang tidak dikenal, Respond mengirim data yang sudah diproyeksikan dalam format respons standar
+5. Key :
+
+    - Pilihan yang sama selalu menghasilkan teks yang sama (nama diurutkan dan duplikat dibuang), dipakai sebagai bagian key cache
+*/
@@ -0,0 +1,131 @@
+package fieldset                              // Mendefinisikan package fieldset
+
+import (
+    "encoding/json"                           // Package untuk membandingkan hasil Project
+    "errors"                                  // Package untuk pengecekan error
+    "net/http"                                // Package untuk konstanta HTTP
+    "net/url"                                 // Package untuk query string
+    "rest-api-go/pkg/utils"                   // Mengimpor utilitas aplikasi untuk HTTPError
+    "slices"                                  // Package untuk membandingkan slice
+    "testing"                                 // Package testing bawaan Go
+)
+
+var testSchema = &Schema{                     // Schema kecil seperti milik product
+    Fields: map[string][]string{
+        "id":     {"id"},
+        "title":  {"title"},
+        "price":  {"price", "currency"},
+        "images": nil,
+    },
+    Always:   []string{"id"},
+    Includes: map[string][]string{"category": {"category_id"}},
+}
+
+func TestParse(t *testing.T) {
+    tests := []struct {
+        query    string
+        columns  []string
+        fields   []string
+        includes []string
+        key      string
+    }{
+        {"", nil, nil, nil, ""},
+        {"fields=title", []string{"id", "title"}, []string{"title"}, nil, "fields=title;include="},
+        {"fields=price,title", []string{"currency", "id", "price", "title"}, []string{"price", "title"}, nil, "fields=price,title;include="},
+        {"fields=title&fields=price", []string{"currency", "id", "price", "title"}, []string{"price", "title"}, nil, "fields=price,title;include="},  // Parameter diulang sama dengan dipisah koma
+        {"fields=title,title,+id+", []string{"id", "title"}, []string{"id", "title"}, nil, "fields=id,title;include="},
+        {"fields=images", []string{"id"}, []string{"images"}, nil, "fields=images;include="},
+        {"include=category", nil, nil, []string{"category"}, "fields=;include=category"},  // Tanpa ?fields= semua kolom dipilih
+        {"fields=title&include=category", []string{"category_id", "id", "title"}, []string{"title"}, []string{"category"}, "fields=title;include=category"},
+    }
+    for _, tt := range tests {
+        query, _ := url.ParseQuery(tt.query)
+        sel, err := testSchema.Parse(query)
+        if err != nil {
+            t.Errorf("Parse(%q) error: %v", tt.query, err)
+            continue
+        }
+        if !slices.Equal(sel.Columns(), tt.columns) || !slices.Equal(sel.fields, tt.fields) || !slices.Equal(sel.includes, tt.includes) {
+            t.Errorf("Parse(%q) = columns %v fields %v includes %v; want %v %v %v", tt.query, sel.Columns(), sel.fields, sel.includes, tt.columns, tt.fields, tt.includes)
+        }
+        if got := sel.Key(); got != tt.key {
+            t.Errorf("Parse(%q).Key() = %q, want %q", tt.query, got, tt.key)
+        }
+    }
+}
+
+func TestParseUnknown(t *testing.T) {
+    for _, raw := range []string{"fields=title,secret", "include=owner", "fields=category"} {
+        query, _ := url.ParseQuery(raw)
+        _, err := testSchema.Parse(query)
+        var httpErr *utils.HTTPError
+        if !errors.As(err, &httpErr) || httpErr.Status != http.StatusBadRequest {
+            t.Errorf("Parse(%q) error = %v, want 400", raw, err)
+        }
+    }
+}
+
+func TestProject(t *testing.T) {
+    type item struct {
+        ID       uint    `json:"id"`
+        Title    string  `json:"title"`
+        Price    string  `json:"price"`
+        Category *string `json:"category"`
+    }
+    category := "Shoes"
+    value := item{ID: 1, Title: "Runner", Price: "12.50", Category: &category}
+
+    tests := []struct {
+        query string
+        value any
+        want  string
+    }{
+        {"fields=title", value, `{"title":"Runner"}`},
+        {"fields=price,id", value, `{"id":1,"price":"12.50"}`},  // Urutan key mengikuti struct
+        {"fields=title&include=category", value, `{"title":"Runner","category":"Shoes"}`},
+        {"fields=id", []item{value, {ID: 2}}, `[{"id":1},{"id":2}]`},
+        {"fields=id", []item{}, `[]`},
+        {"fields=id", nil, `null`},
+    }
+    for _, tt := range tests {
+        query, _ := url.ParseQuery(tt.query)
+        sel, err := testSchema.Parse(query)
+        if err != nil {
+            t.Fatalf("Parse(%q) error: %v", tt.query, err)
+        }
+        got, err := sel.Project(tt.value)
+        if err != nil {
+            t.Errorf("Project(%q) error: %v", tt.query, err)
+            continue
+        }
+        if raw := marshal(t, got); raw != tt.want {
+            t.Errorf("Project(%q) = %s, want %s", tt.query, raw, tt.want)
+        }
+    }
+
+    sel, _ := testSchema.Parse(url.Values{})
+    if got, _ := sel.Project(value); got != any(value) {  // Tanpa ?fields= value tidak di-marshal ulang
+        t.Errorf("Project without fields = %v, want the value unchanged", got)
+    }
+}
+
+func marshal(t *testing.T, value any) string {  // Fungsi helper untuk membandingkan hasil Project sebagai teks JSON
+    t.Helper()
+    raw, err := json.Marshal(value)
+    if err != nil {
+        t.Fatal(err)
+    }
+    return string(raw)
+}
+
+
+// {{{ Penjelasan Test Fieldset }}}
+
+/*
+## Penjelasan Detail
+File fieldset_test.go ini menguji package fieldset dengan table test. Berikut penjelasan detailnya:
+
+1. TestParse : Kolom SELECT, field, relasi, dan Key untuk kombinasi ?fields= dan ?include=, termasuk parameter diulang, duplikat, dan spasi
+2. TestParseUnknown : Field atau relasi di luar whitelist ditolak dengan HTTPError 400
+3. TestProject : Key yang tidak diminta dibuang dari object dan array, urutan key mengikuti struct, dan relasi yang di-include tetap dikirim
+*/
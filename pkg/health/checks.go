@@ -0,0 +1,63 @@
+package health                                // Mendefinisikan package health
+
+import (
+    "context"                                 // Package untuk context dengan timeout
+    "fmt"                                     // Package untuk formatting error
+    "strings"                                 // Package untuk menggabungkan nama tabel
+
+    "gorm.io/gorm"                            // Mengimpor ORM GORM
+)
+
+func DBPing(db *gorm.DB) CheckFunc {          // Fungsi untuk membuat pemeriksaan koneksi database
+    return func(ctx context.Context) error {
+        sqlDB, err := db.DB()                 // Mengambil *sql.DB dari koneksi database.Connect
+        if err != nil {
+            return err                        // Koneksi tidak tersedia
+        }
+        return sqlDB.PingContext(ctx)         // Ping database dengan timeout dari context
+    }
+}
+
+func Migrations(db *gorm.DB, models ...interface{}) CheckFunc {  // Fungsi untuk membuat pemeriksaan migrasi
+    return func(ctx context.Context) error {
+        migrator := db.WithContext(ctx).Migrator()  // Migrator GORM dengan context
+        var missing []string                  // Tabel yang belum dibuat
+        for _, model := range models {        // Periksa setiap model
+            if !migrator.HasTable(model) {
+                stmt := &gorm.Statement{DB: db}
+                if err := stmt.Parse(model); err != nil {  // Dapatkan nama tabel dari model
+                    return err
+                }
+                missing = append(missing, stmt.Schema.Table)
+            }
+        }
+        if len(missing) > 0 {
+            return fmt.Errorf("missing tables: %s", strings.Join(missing, ", "))  // Migrasi belum dijalankan
+        }
+        return nil                            // Semua tabel tersedia
+    }
+}
+
+
+
+// {{{ Penjelasan Pemeriksaan Health }}}
+
+/*
+## Penjelasan Detail
+File checks.go ini berisi pemeriksaan readiness yang umum dipakai. Berikut penjelasan detailnya:
+
+1. DBPing :
+
+    - Mengambil *sql.DB dari koneksi GORM yang dibuat database.Connect
+    - Menjalankan PingContext sehingga pemeriksaan berhenti saat timeout tercapai
+2. Migrations :
+
+    - Menerima daftar model (misal migration.Models())
+    - Memeriksa apakah tabel untuk setiap model sudah ada
+    - Jika ada tabel yang hilang, error berisi nama-nama tabel tersebut
+3. Penggunaan di main.go :
+
+    - checker.Add("database", health.DBPing(db))
+    - checker.Add("migrations", health.Migrations(db, migration.Models()...))
+Pemeriksaan lain (cache, message broker, dll.) dapat ditambahkan dengan fungsi bertipe CheckFunc.
+*/
@@ -0,0 +1,133 @@
+package health                                // Mendefinisikan package health
+
+import (
+    "context"                                 // Package untuk context dengan timeout
+    "errors"                                  // Package untuk membuat error
+    "net/http"                                // Package untuk konstanta HTTP
+    "rest-api-go/pkg/utils"                   // Mengimpor utilitas aplikasi
+    "sync"                                    // Package untuk sinkronisasi goroutine
+    "sync/atomic"                             // Package untuk flag atomik
+    "time"                                    // Package untuk durasi
+
+    "github.com/gin-gonic/gin"                // Mengimpor framework web Gin
+)
+
+const checkTimeout = 2 * time.Second          // Batas waktu setiap pemeriksaan readiness
+
+var ErrShuttingDown = errors.New("server is shutting down")  // Error saat server sedang berhenti
+
+type CheckFunc func(ctx context.Context) error  // Tipe fungsi pemeriksaan, mengembalikan nil jika sehat
+
+type CheckResult struct {                     // Mendefinisikan struct hasil satu pemeriksaan
+    Status     string  `json:"status"`        // "ok" atau "fail"
+    DurationMs float64 `json:"duration_ms"`   // Lama pemeriksaan dalam milidetik
+    Error      string  `json:"error,omitempty"`  // Pesan error jika gagal
+}
+
+type Report struct {                          // Mendefinisikan struct laporan readiness
+    Status string                 `json:"status"`  // Status keseluruhan: "ok" atau "fail"
+    Checks map[string]CheckResult `json:"checks"`  // Hasil per pemeriksaan
+}
+
+type check struct {                           // Pemeriksaan yang terdaftar
+    name string                               // Nama pemeriksaan (muncul di JSON)
+    fn   CheckFunc                            // Fungsi pemeriksaan
+}
+
+type Checker struct {                         // Mendefinisikan struct checker
+    checks       []check                      // Daftar pemeriksaan readiness
+    shuttingDown atomic.Bool                  // Flag yang diset saat server mulai berhenti
+}
+
+func NewChecker() *Checker {                  // Constructor untuk checker
+    return &Checker{}                         // Mengembalikan checker tanpa pemeriksaan
+}
+
+func (h *Checker) Add(name string, fn CheckFunc) {  // Method untuk mendaftarkan pemeriksaan readiness
+    h.checks = append(h.checks, check{name: name, fn: fn})  // Tambahkan ke daftar
+}
+
+func (h *Checker) SetShuttingDown() {         // Method yang dipanggil saat sinyal berhenti diterima
+    h.shuttingDown.Store(true)                // Readiness langsung gagal agar orchestrator berhenti mengirim traffic
+}
+
+func (h *Checker) Check(ctx context.Context) Report {  // Method untuk menjalankan semua pemeriksaan
+    report := Report{Status: "ok", Checks: make(map[string]CheckResult, len(h.checks)+1)}  // Laporan awal
+
+    shutdown := CheckResult{Status: "ok"}     // Pemeriksaan bawaan: server tidak sedang berhenti
+    if h.shuttingDown.Load() {
+        shutdown = CheckResult{Status: "fail", Error: ErrShuttingDown.Error()}
+        report.Status = "fail"
+    }
+    report.Checks["shutdown"] = shutdown
+
+    var mu sync.Mutex                         // Mutex untuk menulis ke map dari banyak goroutine
+    var wg sync.WaitGroup                     // WaitGroup untuk menunggu semua pemeriksaan
+    for _, chk := range h.checks {            // Jalankan setiap pemeriksaan secara paralel
+        wg.Add(1)
+        go func(chk check) {
+            defer wg.Done()
+            checkCtx, cancel := context.WithTimeout(ctx, checkTimeout)  // Batasi waktu pemeriksaan
+            defer cancel()
+
+            start := time.Now()
+            err := chk.fn(checkCtx)           // Jalankan pemeriksaan
+            result := CheckResult{Status: "ok", DurationMs: float64(time.Since(start).Microseconds()) / 1000}
+            if err != nil {
+                result.Status = "fail"
+                result.Error = err.Error()
+            }
+
+            mu.Lock()
+            report.Checks[chk.name] = result  // Simpan hasil
+            if err != nil {
+                report.Status = "fail"        // Satu pemeriksaan gagal berarti tidak siap
+            }
+            mu.Unlock()
+        }(chk)
+    }
+    wg.Wait()                                 // Tunggu semua pemeriksaan selesai
+
+    return report                             // Mengembalikan laporan lengkap
+}
+
+func (h *Checker) Liveness(c *gin.Context) {  // Handler untuk GET /healthz
+    c.JSON(http.StatusOK, utils.SuccessResponse(gin.H{"status": "ok"}))  // Proses hidup dan bisa melayani HTTP
+}
+
+func (h *Checker) Readiness(c *gin.Context) {  // Handler untuk GET /readyz
+    report := h.Check(c.Request.Context())    // Jalankan semua pemeriksaan
+    if report.Status != "ok" {
+        c.JSON(http.StatusServiceUnavailable, utils.Response{Success: false, Data: report, Error: "service not ready"})  // 503 agar orchestrator tidak mengirim traffic
+        return
+    }
+    c.JSON(http.StatusOK, utils.SuccessResponse(report))  // Siap menerima traffic
+}
+
+
+
+// {{{ Penjelasan Package Health }}}
+
+/*
+## Penjelasan Detail
+File health.go ini berisi endpoint liveness dan readiness untuk orchestrator (Kubernetes, Nomad, load balancer). Berikut penjelasan detailnya:
+
+1. Tujuan : Memberi tahu orchestrator kapan proses harus di-restart dan kapan boleh menerima traffic.
+2. Liveness (GET /healthz) :
+
+    - Selalu 200 selama proses bisa menjawab HTTP
+    - Tidak memeriksa database agar gangguan database tidak membuat semua pod di-restart
+3. Readiness (GET /readyz) :
+
+    - Menjalankan semua pemeriksaan yang didaftarkan dengan Add secara paralel, masing-masing dengan timeout 2 detik
+    - Pemeriksaan bawaan "shutdown" gagal setelah SetShuttingDown dipanggil
+    - 200 jika semua ok, 503 jika ada yang gagal, dengan detail per pemeriksaan
+4. Format Respons :
+
+    - Tetap memakai utils.Response; field data berisi status keseluruhan dan map checks
+    - Setiap check berisi status, duration_ms, dan error jika gagal
+5. Pemeriksaan Umum :
+
+    - checks.go menyediakan DBPing dan Migrations
+Dengan memisahkan liveness dan readiness, server yang sedang berhenti atau kehilangan database dikeluarkan dari load balancer tanpa di-restart.
+*/
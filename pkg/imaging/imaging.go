@@ -0,0 +1,187 @@
+package imaging                               // Mendefinisikan package imaging
+
+import (
+    "bytes"                                   // Package untuk membaca data gambar dari memori
+    "errors"                                  // Package untuk membuat error
+    "fmt"                                     // Package untuk formatting pesan error
+    "image"                                   // Package gambar bawaan Go
+    "image/color"                             // Warna latar untuk JPEG
+    "image/draw"                              // Menyalin piksel antar gambar
+    _ "image/gif"                             // Mendaftarkan decoder GIF
+    "image/jpeg"                              // Decoder dan encoder JPEG
+    "image/png"                               // Decoder dan encoder PNG
+    "net/http"                                // DetectContentType untuk sniffing
+    "strconv"                                 // Package untuk parsing ukuran
+    "strings"                                 // Package untuk manipulasi string
+)
+
+const MaxPixels = 40_000_000                  // Batas jumlah piksel (misal 8000x5000) untuk mencegah decompression bomb
+
+var (
+    ErrUnsupported = errors.New("image must be a JPEG, PNG or GIF file")  // Isi file bukan gambar yang didukung
+    ErrTooManyPixels = fmt.Errorf("image must not have more than %d pixels", MaxPixels)  // Dimensi gambar terlalu besar
+)
+
+var contentTypes = map[string]string{         // Content type hasil sniffing ke ekstensi file
+    "image/jpeg": "jpg",
+    "image/png":  "png",
+    "image/gif":  "gif",
+}
+
+// Size - satu ukuran thumbnail, Max adalah panjang sisi terpanjang dalam piksel
+type Size struct {
+    Name string
+    Max  int
+}
+
+// Options - aturan unggah gambar
+type Options struct {
+    MaxBytes   int64                          // Ukuran maksimum satu file
+    Thumbnails []Size                         // Thumbnail yang dibuat untuk setiap gambar
+}
+
+// Sniff - menentukan content type dari isi file, bukan dari nama file atau header client
+func Sniff(data []byte) (contentType, ext string, err error) {
+    contentType = http.DetectContentType(data)
+    ext, ok := contentTypes[contentType]
+    if !ok {
+        return "", "", ErrUnsupported
+    }
+    return contentType, ext, nil
+}
+
+// Check - memeriksa jenis dan dimensi gambar tanpa mendekode seluruh piksel
+func Check(data []byte) error {
+    if _, _, err := Sniff(data); err != nil {
+        return err
+    }
+    cfg, _, err := image.DecodeConfig(bytes.NewReader(data))  // Hanya membaca header
+    if err != nil {
+        return ErrUnsupported
+    }
+    if cfg.Width <= 0 || cfg.Height <= 0 || cfg.Width*cfg.Height > MaxPixels {
+        return ErrTooManyPixels
+    }
+    return nil
+}
+
+// Decode - membaca gambar setelah memeriksa jenis dan dimensinya
+func Decode(data []byte) (image.Image, error) {
+    if err := Check(data); err != nil {
+        return nil, err
+    }
+    img, _, err := image.Decode(bytes.NewReader(data))
+    if err != nil {
+        return nil, ErrUnsupported            // File rusak atau terpotong
+    }
+    return img, nil
+}
+
+// Thumbnail - memperkecil gambar agar sisi terpanjangnya maksimal max piksel; gambar kecil tidak diperbesar
+func Thumbnail(src image.Image, max int) image.Image {
+    b := src.Bounds()
+    sw, sh := b.Dx(), b.Dy()
+    if sw <= max && sh <= max {
+        return src
+    }
+    dw, dh := max, sh*max/sw                  // Pertahankan rasio
+    if sh > sw {
+        dw, dh = sw*max/sh, max
+    }
+    if dw < 1 {
+        dw = 1
+    }
+    if dh < 1 {
+        dh = 1
+    }
+
+    rgba := image.NewRGBA(image.Rect(0, 0, sw, sh))  // Format piksel seragam (alpha premultiplied)
+    draw.Draw(rgba, rgba.Bounds(), src, b.Min, draw.Src)
+    dst := image.NewRGBA(image.Rect(0, 0, dw, dh))
+
+    for dy := 0; dy < dh; dy++ {              // Area averaging: setiap piksel tujuan adalah rata-rata kotak piksel sumber
+        y0, y1 := dy*sh/dh, (dy+1)*sh/dh
+        for dx := 0; dx < dw; dx++ {
+            x0, x1 := dx*sw/dw, (dx+1)*sw/dw
+            var r, g, bl, a, n uint64
+            for y := y0; y < y1; y++ {
+                row := rgba.Pix[y*rgba.Stride:]
+                for x := x0; x < x1; x++ {
+                    p := row[x*4 : x*4+4]
+                    r, g, bl, a = r+uint64(p[0]), g+uint64(p[1]), bl+uint64(p[2]), a+uint64(p[3])
+                    n++
+                }
+            }
+            o := dst.PixOffset(dx, dy)
+            dst.Pix[o], dst.Pix[o+1], dst.Pix[o+2], dst.Pix[o+3] = uint8(r/n), uint8(g/n), uint8(bl/n), uint8(a/n)
+        }
+    }
+    return dst
+}
+
+// Encode - menyimpan thumbnail; PNG tetap PNG agar transparansi tidak hilang, lainnya menjadi JPEG
+func Encode(img image.Image, sourceType string) (data []byte, contentType, ext string, err error) {
+    var buf bytes.Buffer
+    if sourceType == "image/png" {
+        err = png.Encode(&buf, img)
+        return buf.Bytes(), "image/png", "png", err
+    }
+
+    flat := image.NewRGBA(img.Bounds())      // GIF transparan diberi latar putih karena JPEG tidak memiliki alpha
+    draw.Draw(flat, flat.Bounds(), image.NewUniform(color.White), image.Point{}, draw.Src)
+    draw.Draw(flat, flat.Bounds(), img, img.Bounds().Min, draw.Over)
+    err = jpeg.Encode(&buf, flat, &jpeg.Options{Quality: 85})
+    return buf.Bytes(), "image/jpeg", "jpg", err
+}
+
+// ParseSizes - membaca daftar ukuran seperti "small:150,medium:400,large:800"
+func ParseSizes(s string) ([]Size, error) {
+    var sizes []Size
+    seen := map[string]bool{}
+    for _, part := range strings.Split(s, ",") {
+        part = strings.TrimSpace(part)
+        if part == "" {
+            continue
+        }
+        name, value, ok := strings.Cut(part, ":")
+        max, err := strconv.Atoi(strings.TrimSpace(value))
+        name = strings.TrimSpace(name)
+        if !ok || err != nil || max <= 0 || name == "" || name == "original" || seen[name] {
+            return nil, fmt.Errorf("invalid image size %q (expected name:pixels)", part)
+        }
+        seen[name] = true
+        sizes = append(sizes, Size{Name: name, Max: max})
+    }
+    return sizes, nil
+}
+
+
+
+// {{{ Penjelasan Package Imaging }}}
+
+/*
+## Penjelasan Detail
+File imaging.go ini berisi fungsi untuk memeriksa dan memperkecil gambar yang diunggah. Berikut penjelasan detailnya:
+
+1. Sniff :
+
+    - Content type ditentukan dari isi file (http.DetectContentType), bukan dari nama file atau header yang dikirim client
+    - Hanya JPEG, PNG, dan GIF yang diterima karena decoder-nya tersedia di library standar Go
+2. Check dan Decode :
+
+    - Check dipakai untuk memeriksa semua file dalam satu request sebelum ada yang diproses
+    - Membaca header gambar terlebih dahulu dan menolak gambar di atas MaxPixels sebelum seluruh piksel didekode
+    - File kecil dengan dimensi sangat besar (decompression bomb) tidak sempat memakan memori
+3. Thumbnail :
+
+    - Sisi terpanjang dibatasi sesuai ukuran, rasio gambar dipertahankan, gambar kecil tidak diperbesar
+    - Memakai area averaging (rata-rata kotak piksel) yang cukup tajam untuk memperkecil gambar tanpa library tambahan
+4. Encode :
+
+    - PNG disimpan sebagai PNG agar transparansi tetap ada
+    - JPEG dan GIF disimpan sebagai JPEG kualitas 85 dengan latar putih
+5. ParseSizes :
+
+    - Membaca IMAGE_SIZES, misal "small:150,medium:400,large:800"
+    - Nama "original" dipakai untuk file asli sehingga tidak boleh dipakai sebagai nama thumbnail
+*/
@@ -0,0 +1,171 @@
+package jobs                                  // Mendefinisikan package jobs
+
+import (
+    "fmt"                                     // Package untuk formatting pesan error
+    "strconv"                                 // Package untuk parsing angka
+    "strings"                                 // Package untuk memecah ekspresi
+    "time"                                    // Package untuk perhitungan waktu
+)
+
+// Cron - jadwal yang menghitung waktu eksekusi berikutnya
+type Cron interface {
+    Next(after time.Time) time.Time           // Waktu eksekusi pertama setelah after (UTC)
+}
+
+var descriptors = map[string]string{          // Singkatan yang umum dipakai crontab
+    "@yearly":   "0 0 1 1 *",
+    "@annually": "0 0 1 1 *",
+    "@monthly":  "0 0 1 * *",
+    "@weekly":   "0 0 * * 0",
+    "@daily":    "0 0 * * *",
+    "@midnight": "0 0 * * *",
+    "@hourly":   "0 * * * *",
+}
+
+// ParseCron - membaca ekspresi cron lima field (menit jam tanggal bulan hari) dalam UTC, singkatan seperti @daily, atau "@every 10m"
+func ParseCron(spec string) (Cron, error) {
+    spec = strings.TrimSpace(spec)
+    if value, ok := strings.CutPrefix(spec, "@every "); ok {
+        interval, err := time.ParseDuration(strings.TrimSpace(value))
+        if err != nil || interval < time.Second {
+            return nil, fmt.Errorf("invalid cron spec %q: @every needs a duration of at least 1s", spec)
+        }
+        return every(interval), nil
+    }
+    if expanded, ok := descriptors[spec]; ok {
+        spec = expanded
+    }
+
+    fields := strings.Fields(spec)
+    if len(fields) != 5 {
+        return nil, fmt.Errorf("invalid cron spec %q: expected 5 fields (minute hour day month weekday)", spec)
+    }
+    var c cron
+    var err error
+    if c.minute, err = parseField(fields[0], 0, 59); err == nil {
+        if c.hour, err = parseField(fields[1], 0, 23); err == nil {
+            if c.day, err = parseField(fields[2], 1, 31); err == nil {
+                if c.month, err = parseField(fields[3], 1, 12); err == nil {
+                    c.weekday, err = parseField(fields[4], 0, 7)
+                }
+            }
+        }
+    }
+    if err != nil {
+        return nil, fmt.Errorf("invalid cron spec %q: %w", spec, err)
+    }
+    if c.weekday&(1<<7) != 0 {                // 7 dan 0 sama-sama hari Minggu
+        c.weekday |= 1
+    }
+    c.anyDay = strings.HasPrefix(fields[2], "*")  // "*" dan "*/2" dianggap tidak dibatasi, seperti crontab
+    c.anyWeekday = strings.HasPrefix(fields[4], "*")
+    if c.Next(time.Now()).IsZero() {          // Misal "0 0 30 2 *" (30 Februari)
+        return nil, fmt.Errorf("invalid cron spec %q: never matches", spec)
+    }
+    return &c, nil
+}
+
+type every time.Duration                      // Jadwal dengan interval tetap
+
+func (e every) Next(after time.Time) time.Time {
+    return after.UTC().Add(time.Duration(e))
+}
+
+type cron struct {                            // Mendefinisikan struct ekspresi cron, satu bit per nilai yang cocok
+    minute, hour, day, month, weekday uint64
+    anyDay, anyWeekday                bool    // Field tanggal atau hari berisi "*"
+}
+
+func (c *cron) Next(after time.Time) time.Time {
+    t := after.UTC().Truncate(time.Minute).Add(time.Minute)
+    limit := t.AddDate(5, 0, 0)               // Ekspresi yang tidak pernah cocok berhenti setelah 5 tahun
+    for t.Before(limit) {
+        switch {
+        case c.month&(1<<uint(t.Month())) == 0:
+            t = time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, time.UTC)
+        case !c.dayMatches(t):
+            t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, time.UTC)
+        case c.hour&(1<<uint(t.Hour())) == 0:
+            t = t.Truncate(time.Hour).Add(time.Hour)
+        case c.minute&(1<<uint(t.Minute())) == 0:
+            t = t.Add(time.Minute)
+        default:
+            return t
+        }
+    }
+    return time.Time{}
+}
+
+func (c *cron) dayMatches(t time.Time) bool { // Jika tanggal dan hari sama-sama diisi, cukup salah satu yang cocok (seperti crontab)
+    day := c.day&(1<<uint(t.Day())) != 0
+    weekday := c.weekday&(1<<uint(t.Weekday())) != 0
+    if !c.anyDay && !c.anyWeekday {
+        return day || weekday
+    }
+    return day && weekday
+}
+
+func parseField(field string, min, max int) (uint64, error) {  // Fungsi untuk membaca satu field: *, 5, 1-5, */15, 0-30/10, atau daftar dengan koma
+    var bits uint64
+    for _, part := range strings.Split(field, ",") {
+        rangePart, stepPart, hasStep := strings.Cut(part, "/")
+        step := 1
+        if hasStep {
+            var err error
+            if step, err = strconv.Atoi(stepPart); err != nil || step <= 0 {
+                return 0, fmt.Errorf("invalid step in %q", part)
+            }
+        }
+
+        low, high := min, max
+        switch {
+        case rangePart == "*":
+        case strings.Contains(rangePart, "-"):
+            from, to, _ := strings.Cut(rangePart, "-")
+            var errFrom, errTo error
+            low, errFrom = strconv.Atoi(from)
+            high, errTo = strconv.Atoi(to)
+            if errFrom != nil || errTo != nil || low > high {
+                return 0, fmt.Errorf("invalid range %q", part)
+            }
+        default:
+            value, err := strconv.Atoi(rangePart)
+            if err != nil {
+                return 0, fmt.Errorf("invalid value %q", part)
+            }
+            low, high = value, value
+            if hasStep {                      // "5/15" berarti 5, 20, 35, 50
+                high = max
+            }
+        }
+        if low < min || high > max {
+            return 0, fmt.Errorf("%q is outside %d-%d", part, min, max)
+        }
+        for value := low; value <= high; value += step {
+            bits |= 1 << uint(value)
+        }
+    }
+    return bits, nil
+}
+
+
+// {{{ Penjelasan Cron }}}
+
+/*
+## Penjelasan Detail
+File cron.go ini berisi parser ekspresi cron untuk job berkala. Berikut penjelasan detailnya:
+
+1. Format :
+
+    - Lima field: menit (0-59), jam (0-23), tanggal (1-31), bulan (1-12), hari (0-7, 0 dan 7 adalah Minggu)
+    - Setiap field menerima *, angka, rentang (1-5), langkah dengan / (misal 0-30/10), dan daftar dengan koma
+    - Singkatan @hourly, @daily, @weekly, @monthly, @yearly, dan @every <durasi> (misal @every 10m)
+    - Semua waktu dihitung dalam UTC agar jadwal tidak bergeser karena zona waktu server atau daylight saving
+2. Aturan Tanggal dan Hari :
+
+    - Sama dengan crontab: jika tanggal dan hari sama-sama diisi, jadwal berjalan saat salah satunya cocok
+3. Next :
+
+    - Melompat per bulan, hari, jam, lalu menit sehingga tidak perlu memeriksa setiap menit
+    - Ekspresi yang tidak pernah cocok (misal 30 Februari) ditolak oleh ParseCron
+*/
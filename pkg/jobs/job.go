@@ -0,0 +1,137 @@
+package jobs                                  // Mendefinisikan package jobs
+
+import (
+    "context"                                 // Package untuk context handler
+    "encoding/json"                           // Package untuk payload job
+    "errors"                                  // Package untuk pengecekan error
+    "time"                                    // Package untuk waktu eksekusi
+)
+
+// Status - tahap job di dalam antrean
+type Status string
+
+const (
+    StatusPending   Status = "pending"        // Menunggu dijalankan, termasuk job yang menunggu retry
+    StatusRunning   Status = "running"        // Sedang dijalankan oleh salah satu worker
+    StatusSucceeded Status = "succeeded"      // Selesai tanpa error
+    StatusDead      Status = "dead"           // Gagal setelah semua percobaan atau error permanen (dead letter)
+    StatusCancelled Status = "cancelled"      // Dibatalkan sebelum dijalankan
+)
+
+// Statuses - semua status yang valid, dipakai untuk filter daftar job
+var Statuses = []Status{StatusPending, StatusRunning, StatusSucceeded, StatusDead, StatusCancelled}
+
+// Job - satu pekerjaan latar belakang yang disimpan di database
+type Job struct {
+    ID          uint            `json:"id" gorm:"primaryKey"`  // ID job sebagai primary key
+    Queue       string          `json:"queue" gorm:"size:64;not null;index:idx_job_claim,priority:1"`  // Nama antrean (pool worker)
+    Type        string          `json:"type" gorm:"size:128;not null;index"`  // Nama handler, misal "cart.purge_expired"
+    Payload     json.RawMessage `json:"payload" gorm:"type:text;not null"`  // Data untuk handler dalam bentuk JSON
+    Status      Status          `json:"status" gorm:"size:16;not null;index:idx_job_claim,priority:2"`  // Tahap job
+    RunAt       time.Time       `json:"run_at" gorm:"not null;index:idx_job_claim,priority:3"`  // Waktu paling awal job boleh dijalankan
+    Attempts    int             `json:"attempts" gorm:"not null;default:0"`  // Jumlah percobaan yang sudah dimulai
+    MaxAttempts int             `json:"max_attempts" gorm:"not null"`  // Batas percobaan sebelum job menjadi dead
+    LastError   string          `json:"last_error,omitempty" gorm:"type:text"`  // Error dari percobaan terakhir
+    LockedBy    string          `json:"locked_by,omitempty" gorm:"size:128"`  // Worker yang sedang menjalankan job
+    LockedUntil *time.Time      `json:"locked_until,omitempty"`  // Setelah waktu ini job dianggap ditinggalkan worker
+    FinishedAt  *time.Time      `json:"finished_at,omitempty"`  // Waktu job berhasil, dead, atau dibatalkan
+    CreatedAt   time.Time       `json:"created_at"`  // Waktu job dibuat
+    UpdatedAt   time.Time       `json:"updated_at"`  // Waktu job terakhir berubah
+}
+
+func (Job) TableName() string {               // Nama tabel dipakai bersama oleh semua modul
+    return "jobs"
+}
+
+// Decode - membaca payload job ke v
+func (j *Job) Decode(v any) error {
+    return json.Unmarshal(j.Payload, v)
+}
+
+// Schedule - job berkala yang didaftarkan dengan ekspresi cron
+type Schedule struct {
+    ID        uint            `json:"id" gorm:"primaryKey"`  // ID jadwal sebagai primary key
+    Name      string          `json:"name" gorm:"size:128;not null;uniqueIndex"`  // Nama unik jadwal
+    Spec      string          `json:"spec" gorm:"size:128;not null"`  // Ekspresi cron, misal "0 * * * *" atau "@every 10m"
+    Type      string          `json:"type" gorm:"size:128;not null"`  // Tipe job yang dibuat
+    Payload   json.RawMessage `json:"payload" gorm:"type:text;not null"`  // Payload job yang dibuat
+    NextRunAt time.Time       `json:"next_run_at" gorm:"not null;index"`  // Waktu job berikutnya dibuat
+    LastRunAt *time.Time      `json:"last_run_at,omitempty"`  // Waktu job terakhir dibuat
+    LastJobID *uint           `json:"last_job_id,omitempty"`  // Job terakhir yang dibuat jadwal ini
+    CreatedAt time.Time       `json:"created_at"`  // Waktu jadwal pertama kali didaftarkan
+    UpdatedAt time.Time       `json:"updated_at"`  // Waktu jadwal terakhir berubah
+}
+
+func (Schedule) TableName() string {          // Nama tabel jadwal
+    return "job_schedules"
+}
+
+// Handler - fungsi yang menjalankan satu job; error membuat job dicoba lagi
+type Handler func(ctx context.Context, job *Job) error
+
+// TypeOptions - pengaturan per tipe job, nilai kosong memakai Options runner
+type TypeOptions struct {
+    Queue       string                        // Antrean tempat job dijalankan, default "default"
+    MaxAttempts int                           // Batas percobaan, default Options.MaxAttempts
+    Timeout     time.Duration                 // Batas waktu satu percobaan, default Options.Timeout
+}
+
+var (
+    ErrUnknownType = errors.New("jobs: unknown job type")  // Enqueue atau Schedule untuk tipe yang belum didaftarkan
+    ErrStarted     = errors.New("jobs: runner already started")  // Register atau Schedule setelah Start
+)
+
+type permanentError struct {                  // Mendefinisikan struct error yang tidak perlu dicoba lagi
+    err error
+}
+
+func (e *permanentError) Error() string {
+    return e.err.Error()
+}
+
+func (e *permanentError) Unwrap() error {
+    return e.err
+}
+
+// Permanent - menandai error yang tidak akan berhasil walau dicoba lagi (misal payload rusak), job langsung menjadi dead
+func Permanent(err error) error {
+    if err == nil {
+        return nil
+    }
+    return &permanentError{err}
+}
+
+// IsPermanent - memeriksa apakah err ditandai dengan Permanent
+func IsPermanent(err error) bool {
+    var permanent *permanentError
+    return errors.As(err, &permanent)
+}
+
+
+// {{{ Penjelasan Struktur Job }}}
+
+/*
+## Penjelasan Detail
+File job.go ini mendefinisikan data antrean job latar belakang. Berikut penjelasan detailnya:
+
+1. Job :
+
+    - Disimpan di tabel jobs sehingga job yang belum selesai tetap ada setelah aplikasi restart
+    - Payload disimpan sebagai JSON dan dibaca handler dengan job.Decode(&payload)
+    - Index (queue, status, run_at) dipakai worker untuk mengambil job berikutnya
+    - LockedBy dan LockedUntil diisi selama job berjalan; jika worker mati, job diambil ulang setelah LockedUntil lewat
+2. Status :
+
+    - pending -> running -> succeeded
+    - running -> pending (run_at di masa depan) saat gagal dan masih ada percobaan tersisa
+    - running -> dead saat percobaan habis atau error ditandai Permanent (dead letter, dapat di-retry lewat API)
+    - pending -> cancelled saat dibatalkan lewat API
+3. Schedule :
+
+    - Satu baris per jadwal cron di tabel job_schedules
+    - NextRunAt disimpan di database sehingga beberapa instance aplikasi tidak membuat job yang sama dua kali
+4. Handler dan TypeOptions :
+
+    - Handler menerima context yang dibatalkan saat timeout atau saat aplikasi berhenti
+    - TypeOptions mengatur antrean, batas percobaan, dan timeout per tipe job
+*/
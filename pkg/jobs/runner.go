@@ -0,0 +1,590 @@
+package jobs                                  // Mendefinisikan package jobs
+
+import (
+    "context"                                 // Package untuk context job dan shutdown
+    "encoding/json"                           // Package untuk payload job
+    "errors"                                  // Package untuk membuat error
+    "fmt"                                     // Package untuk formatting pesan error
+    "log/slog"                                // Package structured logging bawaan Go
+    "math/rand/v2"                            // Package untuk jitter backoff
+    "os"                                      // Package untuk hostname dan PID worker
+    "rest-api-go/pkg/metrics"                 // Mengimpor metric job
+    "rest-api-go/pkg/tracing"                 // Mengimpor package tracing untuk span job
+    "runtime/debug"                           // Package untuk stack trace panic
+    "sort"                                    // Package untuk urutan nama antrean
+    "strconv"                                 // Package untuk parsing konkurensi
+    "strings"                                 // Package untuk parsing daftar antrean
+    "sync"                                    // Package untuk sinkronisasi goroutine
+    "sync/atomic"                             // Package untuk menghitung job yang berjalan
+    "time"                                    // Package untuk jadwal dan timeout
+
+    "go.opentelemetry.io/otel/attribute"      // Atribut span
+    "gorm.io/gorm"                            // Mengimpor ORM GORM
+    "gorm.io/gorm/clause"                     // Klausa SQL tambahan (FOR UPDATE SKIP LOCKED, ON CONFLICT)
+)
+
+const (
+    DefaultQueue   = "default"                // Antrean untuk tipe job tanpa TypeOptions.Queue
+    cleanupJob     = "jobs.cleanup"           // Job bawaan untuk menghapus job lama
+    maxErrorLength = 4000                     // Panjang maksimum LastError yang disimpan
+    lockGrace      = time.Minute              // Waktu tambahan setelah timeout sebelum job dianggap ditinggalkan worker
+    releaseWait    = 5 * time.Second          // Waktu menunggu handler berhenti setelah context dibatalkan saat shutdown
+)
+
+// Options - pengaturan runner, nilai kosong memakai default
+type Options struct {
+    Queues       map[string]int               // Jumlah worker per antrean, default {"default": 4}
+    PollInterval time.Duration                // Seberapa sering database diperiksa untuk job baru, default 1s
+    Timeout      time.Duration                // Batas waktu default satu percobaan, default 5m
+    MaxAttempts  int                          // Batas percobaan default, default 5
+    MinBackoff   time.Duration                // Jeda sebelum percobaan kedua, default 10s, lalu berlipat dua
+    MaxBackoff   time.Duration                // Jeda maksimum antar percobaan, default 1h
+    Retention    time.Duration                // Job succeeded dan cancelled yang lebih lama dari ini dihapus setiap jam, 0 berarti disimpan
+}
+
+type definition struct {                      // Mendefinisikan struct tipe job yang terdaftar
+    handler Handler
+    TypeOptions
+}
+
+type scheduleDefinition struct {              // Mendefinisikan struct jadwal cron yang terdaftar
+    spec    string
+    typ     string
+    cron    Cron
+    payload json.RawMessage
+}
+
+// Runner - antrean job yang disimpan di database dengan pool worker per antrean
+type Runner struct {
+    db        *gorm.DB                        // Dependency database
+    opts      Options                         // Pengaturan runner
+    worker    string                          // Identitas proses ini di kolom locked_by
+    types     map[string]definition           // Tipe job -> handler
+    schedules map[string]scheduleDefinition   // Nama jadwal -> definisi
+    wake      map[string]chan struct{}        // Membangunkan poller antrean tanpa menunggu PollInterval
+
+    mu      sync.Mutex                        // Melindungi started
+    started bool                              // Register dan Schedule ditolak setelah Start
+    stop    context.CancelFunc                // Menghentikan poller dan scheduler
+    abort   context.CancelFunc                // Membatalkan context job yang sedang berjalan
+    jobCtx  context.Context                   // Induk context setiap job
+    pollers sync.WaitGroup                    // Poller dan scheduler yang berjalan
+    running sync.WaitGroup                    // Job yang sedang berjalan
+    active  atomic.Int64                      // Jumlah job yang sedang berjalan
+}
+
+// NewRunner - membuat runner; daftarkan tipe job dan jadwal sebelum Start
+func NewRunner(db *gorm.DB, opts Options) *Runner {
+    if len(opts.Queues) == 0 {
+        opts.Queues = map[string]int{DefaultQueue: 4}
+    }
+    if opts.PollInterval <= 0 {
+        opts.PollInterval = time.Second
+    }
+    if opts.Timeout <= 0 {
+        opts.Timeout = 5 * time.Minute
+    }
+    if opts.MaxAttempts <= 0 {
+        opts.MaxAttempts = 5
+    }
+    if opts.MinBackoff <= 0 {
+        opts.MinBackoff = 10 * time.Second
+    }
+    if opts.MaxBackoff < opts.MinBackoff {
+        opts.MaxBackoff = max(time.Hour, opts.MinBackoff)
+    }
+
+    host, _ := os.Hostname()
+    r := &Runner{
+        db:        db,
+        opts:      opts,
+        worker:    fmt.Sprintf("%s-%d-%04x", host, os.Getpid(), rand.N(0x10000)),  // Unik per proses, juga untuk beberapa container dengan hostname sama
+        types:     map[string]definition{},
+        schedules: map[string]scheduleDefinition{},
+        wake:      map[string]chan struct{}{},
+    }
+    for queue := range opts.Queues {
+        r.wake[queue] = make(chan struct{}, 1)
+    }
+    if opts.Retention > 0 {
+        r.Register(cleanupJob, r.cleanup, TypeOptions{Queue: r.firstQueue()})
+        r.Schedule(cleanupJob, "@hourly", cleanupJob, nil)
+    }
+    return r
+}
+
+// Register - mendaftarkan handler untuk tipe job; panic jika tipe sudah terdaftar atau runner sudah berjalan
+func (r *Runner) Register(typ string, handler Handler, opts TypeOptions) {
+    r.mu.Lock()
+    defer r.mu.Unlock()
+    if r.started {
+        panic(ErrStarted)
+    }
+    if _, ok := r.types[typ]; ok {
+        panic(fmt.Sprintf("jobs: type %q registered twice", typ))
+    }
+    if opts.Queue == "" {
+        opts.Queue = DefaultQueue
+    }
+    if opts.MaxAttempts <= 0 {
+        opts.MaxAttempts = r.opts.MaxAttempts
+    }
+    if opts.Timeout <= 0 {
+        opts.Timeout = r.opts.Timeout
+    }
+    r.types[typ] = definition{handler, opts}
+}
+
+// Schedule - membuat job typ dengan payload sesuai ekspresi cron spec; panic jika spec tidak valid atau typ belum didaftarkan
+func (r *Runner) Schedule(name, spec, typ string, payload any) {
+    r.mu.Lock()
+    defer r.mu.Unlock()
+    if r.started {
+        panic(ErrStarted)
+    }
+    if _, ok := r.types[typ]; !ok {
+        panic(fmt.Sprintf("%v %q in schedule %q", ErrUnknownType, typ, name))
+    }
+    cron, err := ParseCron(spec)
+    if err != nil {
+        panic(fmt.Sprintf("jobs: schedule %q: %v", name, err))
+    }
+    raw, err := json.Marshal(payload)
+    if err != nil {
+        panic(fmt.Sprintf("jobs: schedule %q: %v", name, err))
+    }
+    r.schedules[name] = scheduleDefinition{spec: spec, typ: typ, cron: cron, payload: raw}
+}
+
+// Start - menyimpan jadwal ke database lalu menjalankan poller per antrean dan scheduler
+func (r *Runner) Start(ctx context.Context) error {
+    r.mu.Lock()
+    defer r.mu.Unlock()
+    if r.started {
+        return ErrStarted
+    }
+
+    queueTypes := map[string][]string{}      // Antrean -> tipe job yang dijalankan proses ini
+    for typ, def := range r.types {
+        if _, ok := r.opts.Queues[def.Queue]; !ok {
+            return fmt.Errorf("jobs: queue %q used by %q is not configured (add it to JOB_QUEUES)", def.Queue, typ)
+        }
+        queueTypes[def.Queue] = append(queueTypes[def.Queue], typ)
+    }
+    if err := r.syncSchedules(ctx); err != nil {
+        return err
+    }
+
+    var pollCtx context.Context
+    pollCtx, r.stop = context.WithCancel(context.Background())  // Tidak diturunkan dari ctx agar hanya berhenti lewat Shutdown
+    r.jobCtx, r.abort = context.WithCancel(context.Background())
+    for queue, types := range queueTypes {
+        r.pollers.Add(1)
+        go r.poll(pollCtx, queue, r.opts.Queues[queue], types)
+    }
+    if len(r.schedules) > 0 {
+        r.pollers.Add(1)
+        go r.scheduleLoop(pollCtx)
+    }
+    r.started = true
+    slog.InfoContext(ctx, "job runner started", "worker", r.worker, "queues", r.opts.Queues, "types", len(r.types), "schedules", len(r.schedules))
+    return nil
+}
+
+// Shutdown - berhenti mengambil job baru dan menunggu job yang berjalan selesai sampai ctx habis;
+// setelah itu context job dibatalkan dan job yang berhenti dikembalikan ke antrean
+func (r *Runner) Shutdown(ctx context.Context) error {
+    r.mu.Lock()
+    started := r.started
+    r.mu.Unlock()
+    if !started {
+        return nil
+    }
+
+    r.stop()                                  // Poller dan scheduler berhenti
+    r.pollers.Wait()
+    done := make(chan struct{})
+    go func() {
+        r.running.Wait()
+        close(done)
+    }()
+
+    select {
+    case <-done:
+        return nil                            // Semua job selesai dengan normal
+    case <-ctx.Done():
+    }
+    active := r.active.Load()
+    r.abort()                                 // Handler yang memperhatikan ctx berhenti dan job-nya dikembalikan ke antrean
+    select {
+    case <-done:
+        return fmt.Errorf("jobs: %d running jobs were cancelled and returned to the queue", active)
+    case <-time.After(releaseWait):
+        return fmt.Errorf("jobs: %d jobs did not stop and will run again after their lock expires", r.active.Load())
+    }
+}
+
+// Enqueue - menambahkan job yang langsung siap dijalankan
+func (r *Runner) Enqueue(ctx context.Context, typ string, payload any) (*Job, error) {
+    return r.EnqueueAt(ctx, typ, payload, time.Now())
+}
+
+// EnqueueAt - menambahkan job yang dijalankan paling cepat pada runAt
+func (r *Runner) EnqueueAt(ctx context.Context, typ string, payload any, runAt time.Time) (*Job, error) {
+    job, err := r.insert(r.db.WithContext(ctx), typ, payload, runAt)
+    if err != nil {
+        return nil, err
+    }
+    r.nudge(job.Queue)                        // Worker di proses ini langsung memeriksa antrean
+    return job, nil
+}
+
+// EnqueueTx - menambahkan job di dalam transaksi pemanggil; job hanya ada jika transaksi di-commit
+func (r *Runner) EnqueueTx(tx *gorm.DB, typ string, payload any) (*Job, error) {
+    return r.insert(tx, typ, payload, time.Now())
+}
+
+func (r *Runner) insert(db *gorm.DB, typ string, payload any, runAt time.Time) (*Job, error) {  // Fungsi untuk menyimpan job baru
+    def, ok := r.types[typ]
+    if !ok {
+        return nil, fmt.Errorf("%w %q", ErrUnknownType, typ)
+    }
+    raw, err := json.Marshal(payload)         // nil menjadi "null"
+    if err != nil {
+        return nil, fmt.Errorf("jobs: encode payload of %q: %w", typ, err)
+    }
+    job := &Job{Queue: def.Queue, Type: typ, Payload: raw, Status: StatusPending, RunAt: runAt, MaxAttempts: def.MaxAttempts}
+    if err := db.Create(job).Error; err != nil {
+        return nil, err
+    }
+    return job, nil
+}
+
+func (r *Runner) poll(ctx context.Context, queue string, concurrency int, types []string) {  // Fungsi untuk mengambil dan menjalankan job satu antrean
+    defer r.pollers.Done()
+    slots := make(chan struct{}, concurrency) // Satu slot per worker
+    ticker := time.NewTicker(r.opts.PollInterval)
+    defer ticker.Stop()
+
+    for {
+        if free := concurrency - len(slots); free > 0 {
+            claimed, err := r.claim(ctx, queue, types, free)
+            if err != nil && ctx.Err() == nil {
+                slog.ErrorContext(ctx, "failed to claim jobs", "queue", queue, "error", err)
+            }
+            for _, job := range claimed {
+                slots <- struct{}{}
+                r.running.Add(1)
+                r.active.Add(1)
+                go func(job Job) {
+                    defer func() {
+                        r.active.Add(-1)
+                        r.running.Done()
+                        <-slots
+                        r.nudge(queue)        // Slot kosong, ambil job berikutnya
+                    }()
+                    r.run(job)
+                }(job)
+            }
+        }
+        select {
+        case <-ctx.Done():
+            return
+        case <-ticker.C:
+        case <-r.wake[queue]:
+        }
+    }
+}
+
+func (r *Runner) claim(ctx context.Context, queue string, types []string, limit int) ([]Job, error) {  // Fungsi untuk mengunci job yang siap dijalankan
+    var claimed []Job
+    now := time.Now()
+    err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+        var candidates []Job
+        err := tx.Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).  // Job yang dikunci worker lain dilewati, bukan ditunggu
+            Where("queue = ? AND type IN ?", queue, types).
+            Where("(status = ? AND run_at <= ?) OR (status = ? AND locked_until < ?)", StatusPending, now, StatusRunning, now).
+            Order("run_at, id").Limit(limit).Find(&candidates).Error
+        if err != nil {
+            return err
+        }
+        for i := range candidates {
+            job := &candidates[i]
+            if job.Status == StatusRunning {  // Worker sebelumnya mati atau macet, percobaan itu dihitung gagal
+                outcome, err := r.fail(tx, job, errors.New("worker stopped while running the job (lock expired)"), now)
+                if err != nil {
+                    return err
+                }
+                slog.WarnContext(ctx, "job lock expired", "job_id", job.ID, "type", job.Type, "locked_by", job.LockedBy, "outcome", outcome)
+                continue
+            }
+            until := now.Add(r.types[job.Type].Timeout + lockGrace)
+            job.Status = StatusRunning
+            job.Attempts++
+            job.LockedBy = r.worker
+            job.LockedUntil = &until
+            err := tx.Model(&Job{}).Where("id = ?", job.ID).Updates(map[string]any{
+                "status": job.Status, "attempts": job.Attempts, "locked_by": job.LockedBy, "locked_until": until,
+            }).Error
+            if err != nil {
+                return err
+            }
+            claimed = append(claimed, *job)
+        }
+        return nil
+    })
+    if err != nil {
+        return nil, err
+    }
+    return claimed, nil
+}
+
+func (r *Runner) run(job Job) {                // Fungsi untuk menjalankan satu percobaan job dan menyimpan hasilnya
+    def := r.types[job.Type]
+    metrics.JobStarted(job.Queue)
+    start := time.Now()
+
+    ctx, cancel := context.WithTimeout(r.jobCtx, def.Timeout)
+    ctx, span := tracing.Start(ctx, "job "+job.Type,
+        attribute.Int64("job.id", int64(job.ID)),
+        attribute.String("job.queue", job.Queue),
+        attribute.Int("job.attempt", job.Attempts))
+    err := call(ctx, def.handler, &job)
+    if err != nil {
+        span.RecordError(err)
+    }
+    span.End()
+    cancel()
+
+    log := slog.With("job_id", job.ID, "type", job.Type, "queue", job.Queue, "attempt", job.Attempts, "max_attempts", job.MaxAttempts)
+    db := r.db.WithContext(context.Background())  // Hasil tetap disimpan walau context job sudah dibatalkan
+    now := time.Now()
+    var outcome string
+    var saveErr error
+    switch {
+    case err == nil:
+        outcome = "succeeded"
+        saveErr = r.finish(db, &job, map[string]any{"status": StatusSucceeded, "finished_at": now, "last_error": "", "locked_by": "", "locked_until": nil})
+        log.Info("job succeeded", "duration", time.Since(start))
+    case r.jobCtx.Err() != nil:               // Dibatalkan karena shutdown, percobaan ini tidak dihitung
+        outcome = "released"
+        saveErr = r.finish(db, &job, map[string]any{"status": StatusPending, "attempts": job.Attempts - 1, "run_at": now, "locked_by": "", "locked_until": nil})
+        log.Warn("job interrupted by shutdown, returned to the queue", "error", err)
+    default:
+        outcome, saveErr = r.fail(db, &job, err, now)
+        if outcome == "dead" {
+            log.Error("job failed permanently", "error", err)
+        } else {
+            log.Warn("job failed, will retry", "error", err)
+        }
+    }
+    if saveErr != nil {
+        log.Error("failed to save job result, the job runs again after its lock expires", "error", saveErr)
+    }
+    metrics.JobFinished(job.Queue, job.Type, outcome, time.Since(start))
+}
+
+func (r *Runner) fail(db *gorm.DB, job *Job, cause error, now time.Time) (string, error) {  // Fungsi untuk menjadwalkan ulang job yang gagal atau menjadikannya dead
+    message := cause.Error()
+    if len(message) > maxErrorLength {
+        message = message[:maxErrorLength]
+    }
+    updates := map[string]any{"last_error": message, "locked_by": "", "locked_until": nil}
+    if IsPermanent(cause) || job.Attempts >= job.MaxAttempts {
+        updates["status"] = StatusDead
+        updates["finished_at"] = now
+        return "dead", r.finish(db, job, updates)
+    }
+    updates["status"] = StatusPending
+    updates["run_at"] = now.Add(r.backoff(job.Attempts))
+    return "retry", r.finish(db, job, updates)
+}
+
+func (r *Runner) finish(db *gorm.DB, job *Job, updates map[string]any) error {  // Fungsi untuk menyimpan hasil, hanya jika job masih dikunci oleh percobaan ini
+    result := db.Model(&Job{}).
+        Where("id = ? AND status = ? AND locked_by = ? AND attempts = ?", job.ID, StatusRunning, job.LockedBy, job.Attempts).
+        Updates(updates)
+    if result.Error == nil && result.RowsAffected == 0 {  // Lock sudah kedaluwarsa dan diambil alih, hasil worker lain yang berlaku
+        slog.Warn("job was taken over by another worker, result discarded", "job_id", job.ID, "type", job.Type)
+    }
+    return result.Error
+}
+
+func (r *Runner) backoff(attempt int) time.Duration {  // Fungsi untuk menghitung jeda sebelum percobaan berikutnya: MinBackoff * 2^(attempt-1), dengan jitter
+    delay := r.opts.MaxBackoff
+    if attempt <= 30 {
+        if d := r.opts.MinBackoff << (attempt - 1); d > 0 && d < delay {
+            delay = d
+        }
+    }
+    return delay/2 + rand.N(delay/2+1)        // Antara setengah dan penuh agar job yang gagal bersamaan tidak dicoba bersamaan
+}
+
+func (r *Runner) scheduleLoop(ctx context.Context) {  // Fungsi untuk membuat job dari jadwal yang sudah jatuh tempo
+    defer r.pollers.Done()
+    ticker := time.NewTicker(r.opts.PollInterval)
+    defer ticker.Stop()
+    for {
+        if err := r.enqueueDue(ctx); err != nil && ctx.Err() == nil {
+            slog.ErrorContext(ctx, "failed to enqueue scheduled jobs", "error", err)
+        }
+        select {
+        case <-ctx.Done():
+            return
+        case <-ticker.C:
+        }
+    }
+}
+
+func (r *Runner) enqueueDue(ctx context.Context) error {  // Fungsi untuk membuat job dan memajukan NextRunAt dalam satu transaksi
+    names := make([]string, 0, len(r.schedules))
+    for name := range r.schedules {
+        names = append(names, name)
+    }
+    now := time.Now()
+    var queues []string
+    err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+        var due []Schedule
+        err := tx.Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).  // Hanya satu instance yang membuat job untuk setiap jadwal
+            Where("name IN ? AND next_run_at <= ?", names, now).Find(&due).Error
+        if err != nil {
+            return err
+        }
+        for _, schedule := range due {
+            def := r.schedules[schedule.Name]
+            job, err := r.insert(tx, def.typ, def.payload, now)
+            if err != nil {
+                return err
+            }
+            err = tx.Model(&Schedule{}).Where("id = ?", schedule.ID).Updates(map[string]any{
+                "next_run_at": def.cron.Next(now), "last_run_at": now, "last_job_id": job.ID,  // Jadwal yang terlewat saat aplikasi mati hanya dijalankan sekali
+            }).Error
+            if err != nil {
+                return err
+            }
+            queues = append(queues, job.Queue)
+        }
+        return nil
+    })
+    for _, queue := range queues {
+        r.nudge(queue)
+    }
+    return err
+}
+
+func (r *Runner) syncSchedules(ctx context.Context) error {  // Fungsi untuk menyimpan jadwal baru dan memperbarui jadwal yang berubah
+    db := r.db.WithContext(ctx)
+    now := time.Now()
+    for name, def := range r.schedules {
+        row := Schedule{Name: name, Spec: def.spec, Type: def.typ, Payload: def.payload, NextRunAt: def.cron.Next(now)}
+        if err := db.Clauses(clause.OnConflict{DoNothing: true}).Create(&row).Error; err != nil {  // Instance lain mungkin sudah membuatnya
+            return err
+        }
+        err := db.Model(&Schedule{}).
+            Where("name = ? AND (spec <> ? OR type <> ? OR payload <> ?)", name, def.spec, def.typ, string(def.payload)).
+            Updates(map[string]any{"spec": def.spec, "type": def.typ, "payload": string(def.payload), "next_run_at": def.cron.Next(now)}).Error
+        if err != nil {
+            return err
+        }
+    }
+    return nil
+}
+
+func (r *Runner) cleanup(ctx context.Context, _ *Job) error {  // Handler job bawaan untuk menghapus job yang sudah lama selesai
+    cutoff := time.Now().Add(-r.opts.Retention)
+    result := r.db.WithContext(ctx).
+        Where("status IN ? AND finished_at < ?", []Status{StatusSucceeded, StatusCancelled}, cutoff).  // Job dead disimpan untuk diperiksa
+        Delete(&Job{})
+    if result.Error == nil && result.RowsAffected > 0 {
+        slog.InfoContext(ctx, "old jobs deleted", "count", result.RowsAffected)
+    }
+    return result.Error
+}
+
+func (r *Runner) nudge(queue string) {        // Fungsi untuk membangunkan poller antrean tanpa menunggu
+    select {
+    case r.wake[queue] <- struct{}{}:
+    default:                                  // Poller sudah dibangunkan
+    }
+}
+
+func (r *Runner) firstQueue() string {        // Fungsi untuk memilih antrean job bawaan: default jika ada, selain itu nama pertama
+    if _, ok := r.opts.Queues[DefaultQueue]; ok {
+        return DefaultQueue
+    }
+    names := make([]string, 0, len(r.opts.Queues))
+    for name := range r.opts.Queues {
+        names = append(names, name)
+    }
+    sort.Strings(names)
+    return names[0]
+}
+
+func call(ctx context.Context, handler Handler, job *Job) (err error) {  // Fungsi untuk menjalankan handler, panic diubah menjadi error
+    defer func() {
+        if p := recover(); p != nil {
+            slog.ErrorContext(ctx, "job panicked", "job_id", job.ID, "type", job.Type, "panic", p, "stack", string(debug.Stack()))
+            err = fmt.Errorf("panic: %v", p)
+        }
+    }()
+    return handler(ctx, job)
+}
+
+// ParseQueues - membaca daftar antrean seperti "default:4,images:2" (nama:jumlah worker)
+func ParseQueues(s string) (map[string]int, error) {
+    queues := map[string]int{}
+    for _, part := range strings.Split(s, ",") {
+        part = strings.TrimSpace(part)
+        if part == "" {
+            continue
+        }
+        name, value, ok := strings.Cut(part, ":")
+        workers, err := strconv.Atoi(strings.TrimSpace(value))
+        name = strings.TrimSpace(name)
+        if _, seen := queues[name]; !ok || err != nil || workers <= 0 || name == "" || seen {
+            return nil, fmt.Errorf("invalid job queue %q (expected name:workers)", part)
+        }
+        queues[name] = workers
+    }
+    return queues, nil
+}
+
+
+// {{{ Penjelasan Runner }}}
+
+/*
+## Penjelasan Detail
+File runner.go ini berisi antrean job latar belakang yang disimpan di database. Berikut penjelasan detailnya:
+
+1. Alur Penggunaan :
+
+    - main.go membuat runner dengan NewRunner, modul mendaftarkan handler dengan Register dan jadwal dengan Schedule
+    - Start dijalankan setelah semua modul diinisialisasi, Shutdown saat aplikasi berhenti
+    - Service menambahkan job dengan Enqueue, EnqueueAt (dijalankan nanti), atau EnqueueTx (di dalam transaksi service)
+2. Worker :
+
+    - Satu poller per antrean dengan jumlah worker dari Options.Queues (JOB_QUEUES), sehingga job lambat di satu antrean tidak menahan antrean lain
+    - Job diambil dengan SELECT ... FOR UPDATE SKIP LOCKED, sehingga beberapa instance aplikasi dapat berjalan bersamaan tanpa menjalankan job yang sama
+    - Database diperiksa setiap PollInterval; Enqueue di proses yang sama dan worker yang selesai langsung membangunkan poller
+    - Setiap percobaan memiliki timeout, span tracing "job <type>", log dengan job_id, dan metric Prometheus
+    - Panic di handler ditangkap dan dihitung sebagai percobaan yang gagal
+3. Retry dan Dead Letter :
+
+    - Job yang gagal dijadwalkan ulang dengan backoff eksponensial (10s, 20s, 40s, ... maksimum 1 jam) dengan jitter
+    - Setelah MaxAttempts percobaan, atau jika handler mengembalikan jobs.Permanent(err), job menjadi dead dan tidak dicoba lagi
+    - Job dead dapat dijalankan ulang lewat API; LastError menyimpan error terakhir
+    - Jika worker mati di tengah job, job diambil ulang setelah LockedUntil (timeout + 1 menit) dan dihitung sebagai percobaan gagal
+    - finish hanya menyimpan hasil jika job masih dikunci percobaan yang sama, sehingga worker yang terlambat tidak menimpa hasil worker lain
+4. Jadwal Cron :
+
+    - Schedule menyimpan jadwal di tabel job_schedules saat Start; jadwal yang definisinya berubah dihitung ulang
+    - Scheduler membuat job dan memajukan NextRunAt dalam satu transaksi dengan SKIP LOCKED, sehingga satu jadwal hanya membuat satu job walau ada beberapa instance
+    - Jadwal yang terlewat saat aplikasi mati dijalankan satu kali saat aplikasi hidup kembali
+    - Jika Retention diisi, job bawaan jobs.cleanup menghapus job succeeded dan cancelled yang lama setiap jam
+5. Shutdown :
+
+    - Poller dan scheduler berhenti sehingga tidak ada job baru yang diambil
+    - Job yang sedang berjalan ditunggu sampai ctx habis (SHUTDOWN_TIMEOUT)
+    - Setelah itu context job dibatalkan; job yang berhenti dikembalikan ke pending tanpa menghitung percobaan
+    - Handler yang tidak memperhatikan ctx ditinggalkan dan dijalankan lagi setelah lock kedaluwarsa
+*/
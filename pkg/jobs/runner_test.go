@@ -0,0 +1,47 @@
+package jobs                                  // Mendefinisikan package jobs
+
+import (
+    "testing"                                 // Package testing bawaan Go
+    "time"                                    // Package untuk durasi backoff
+)
+
+func TestBackoff(t *testing.T) {
+    tests := []struct {
+        name    string
+        opts    Options
+        attempt int
+        want    time.Duration                 // Jeda sebelum jitter; hasil harus di antara want/2 dan want
+    }{
+        {"first retry", Options{}, 1, 10 * time.Second},
+        {"doubles", Options{}, 2, 20 * time.Second},
+        {"doubles again", Options{}, 3, 40 * time.Second},
+        {"below cap", Options{}, 9, 2560 * time.Second},
+        {"capped", Options{}, 10, time.Hour},  // 5120s melewati MaxBackoff default 1 jam
+        {"large attempt", Options{}, 31, time.Hour},
+        {"shift overflow", Options{}, 30, time.Hour},  // 10s << 29 masih positif tetapi jauh di atas batas
+        {"custom", Options{MinBackoff: time.Second, MaxBackoff: 5 * time.Second}, 3, 4 * time.Second},
+        {"custom capped", Options{MinBackoff: time.Second, MaxBackoff: 5 * time.Second}, 4, 5 * time.Second},
+        {"max below min", Options{MinBackoff: 2 * time.Hour, MaxBackoff: time.Minute}, 1, 2 * time.Hour},  // MaxBackoff dinaikkan ke MinBackoff
+    }
+    for _, tt := range tests {
+        r := NewRunner(nil, tt.opts)          // Tanpa Retention, NewRunner tidak menyentuh database
+        for i := 0; i < 200; i++ {            // Jitter acak, diulang agar kedua batas teruji
+            got := r.backoff(tt.attempt)
+            if got < tt.want/2 || got > tt.want {
+                t.Fatalf("%s: backoff(%d) = %v, want between %v and %v", tt.name, tt.attempt, got, tt.want/2, tt.want)
+            }
+        }
+    }
+}
+
+
+// {{{ Penjelasan Test Runner }}}
+
+/*
+## Penjelasan Detail
+File runner_test.go ini menguji jadwal retry runner job. Berikut penjelasan detailnya:
+
+1. TestBackoff : MinBackoff * 2^(attempt-1) dibatasi MaxBackoff, dengan jitter antara setengah dan penuh
+2. Default : 10 detik, 20 detik, 40 detik, dan seterusnya sampai 1 jam, jadwal yang sama dengan retry webhook.deliver
+3. Batas : attempt besar dan shift yang melewati batas tetap menghasilkan MaxBackoff, dan MaxBackoff di bawah MinBackoff dinaikkan oleh NewRunner
+*/
@@ -0,0 +1,108 @@
+package logger                                // Mendefinisikan package logger
+
+import (
+    "context"                                 // Package untuk context request
+    "errors"                                  // Package untuk pengecekan error
+    "fmt"                                     // Package untuk formatting string
+    "log/slog"                                // Package structured logging bawaan Go
+    "time"                                    // Package untuk durasi query
+
+    "gorm.io/gorm"                            // Mengimpor ORM GORM
+    gormlogger "gorm.io/gorm/logger"          // Interface logger milik GORM
+)
+
+// GormLogger - adapter yang menulis log GORM melalui slog
+type GormLogger struct {                      // Mendefinisikan struct adapter logger GORM
+    logger        *slog.Logger                // Logger slog tujuan
+    level         gormlogger.LogLevel         // Level log GORM
+    slowThreshold time.Duration               // Batas durasi query dianggap lambat
+}
+
+// NewGormLogger - membuat adapter logger GORM berbasis slog
+func NewGormLogger(logger *slog.Logger, slowThreshold time.Duration) *GormLogger {  // Constructor untuk GormLogger
+    level := gormlogger.Warn                  // Secara default hanya query lambat dan error yang dicatat
+    if logger.Enabled(context.Background(), slog.LevelDebug) {  // Jika level debug aktif
+        level = gormlogger.Info               // Catat semua query
+    }
+    return &GormLogger{logger: logger, level: level, slowThreshold: slowThreshold}  // Mengembalikan instance adapter
+}
+
+func (l *GormLogger) LogMode(level gormlogger.LogLevel) gormlogger.Interface {  // Method untuk mengubah level log GORM
+    clone := *l                               // Salin adapter agar instance asli tidak berubah
+    clone.level = level                       // Set level baru
+    return &clone                             // Mengembalikan salinan adapter
+}
+
+func (l *GormLogger) Info(ctx context.Context, msg string, args ...interface{}) {  // Method log level info
+    if l.level >= gormlogger.Info {
+        l.log(ctx).Info(fmt.Sprintf(msg, args...))  // Tulis log info dengan request ID
+    }
+}
+
+func (l *GormLogger) Warn(ctx context.Context, msg string, args ...interface{}) {  // Method log level warning
+    if l.level >= gormlogger.Warn {
+        l.log(ctx).Warn(fmt.Sprintf(msg, args...))  // Tulis log warning dengan request ID
+    }
+}
+
+func (l *GormLogger) Error(ctx context.Context, msg string, args ...interface{}) {  // Method log level error
+    if l.level >= gormlogger.Error {
+        l.log(ctx).Error(fmt.Sprintf(msg, args...))  // Tulis log error dengan request ID
+    }
+}
+
+func (l *GormLogger) Trace(ctx context.Context, begin time.Time, fc func() (string, int64), err error) {  // Method yang dipanggil GORM setelah setiap query
+    if l.level <= gormlogger.Silent {         // Jika log dimatikan
+        return                                // Tidak perlu mencatat apa pun
+    }
+
+    elapsed := time.Since(begin)              // Hitung durasi query
+    sql, rows := fc()                         // Ambil SQL dan jumlah baris yang terpengaruh
+    attrs := []any{                           // Atribut log yang selalu disertakan
+        "sql", sql,
+        "rows", rows,
+        "duration_ms", float64(elapsed.Microseconds()) / 1000,
+    }
+
+    switch {
+    case err != nil && l.level >= gormlogger.Error && !errors.Is(err, gorm.ErrRecordNotFound):  // Query gagal (record tidak ditemukan bukan error sistem)
+        l.log(ctx).Error("database query failed", append(attrs, "error", err.Error())...)  // Catat sebagai error
+    case l.slowThreshold > 0 && elapsed > l.slowThreshold && l.level >= gormlogger.Warn:  // Query lebih lambat dari batas
+        l.log(ctx).Warn("slow database query", append(attrs, "threshold_ms", l.slowThreshold.Milliseconds())...)  // Catat sebagai warning
+    case l.level >= gormlogger.Info:          // Mode verbose
+        l.log(ctx).Debug("database query", attrs...)  // Catat semua query di level debug
+    }
+}
+
+func (l *GormLogger) log(ctx context.Context) *slog.Logger {  // Helper untuk menambahkan request ID ke logger
+    if requestID := RequestIDFromContext(ctx); requestID != "" {  // Jika query dijalankan dalam context request
+        return l.logger.With("request_id", requestID)  // Sertakan request ID
+    }
+    return l.logger                           // Query di luar request (seeder, startup)
+}
+
+
+
+// {{{ Penjelasan GormLogger }}}
+
+/*
+## Penjelasan Detail
+File gorm.go ini berisi adapter yang menghubungkan logger GORM dengan slog. Berikut penjelasan detailnya:
+
+1. Tujuan : Agar log query database memakai format yang sama dengan access log dan membawa request ID.
+2. Implementasi gormlogger.Interface :
+
+    - LogMode : Mengembalikan salinan adapter dengan level berbeda
+    - Info, Warn, Error : Meneruskan pesan GORM ke slog
+    - Trace : Dipanggil setelah setiap query dengan SQL, jumlah baris, durasi, dan error
+3. Aturan Trace :
+
+    - Query yang error dicatat di level error (kecuali gorm.ErrRecordNotFound yang merupakan kondisi normal)
+    - Query yang melebihi slowThreshold dicatat di level warn
+    - Saat LOG_LEVEL=debug, semua query dicatat di level debug
+4. Request ID :
+
+    - Service memanggil db.WithContext(ctx) dengan context dari request
+    - Adapter membaca request ID dari context tersebut dan menambahkannya ke setiap baris log
+Dengan adapter ini, satu request ID dapat dipakai untuk mencari semua query yang dijalankan oleh sebuah request.
+*/
@@ -0,0 +1,99 @@
+package logger                                // Mendefinisikan package logger
+
+import (
+    "context"                                 // Package untuk membawa nilai per request
+    "io"                                      // Package untuk interface writer
+    "log/slog"                                // Package structured logging bawaan Go
+    "os"                                      // Package untuk akses stdout
+    "strings"                                 // Package untuk manipulasi string
+    "rest-api-go/pkg/config"                  // Mengimpor package config aplikasi
+)
+
+type contextKey string                        // Tipe khusus untuk key context agar tidak bentrok dengan package lain
+
+const requestIDKey contextKey = "request_id"  // Key context untuk menyimpan request ID
+
+// New - membuat logger slog sesuai level dan format di konfigurasi
+func New(cfg *config.Config) *slog.Logger {   // Fungsi untuk membuat logger baru dari konfigurasi
+    return NewWithWriter(os.Stdout, cfg.LogLevel, cfg.LogFormat)  // Secara default log ditulis ke stdout
+}
+
+// NewWithWriter - membuat logger slog dengan writer tertentu
+func NewWithWriter(w io.Writer, level, format string) *slog.Logger {  // Fungsi untuk membuat logger dengan writer kustom
+    opts := &slog.HandlerOptions{Level: ParseLevel(level)}  // Opsi handler dengan level minimum
+
+    var handler slog.Handler                  // Variabel untuk menampung handler slog
+    if strings.EqualFold(format, "text") {    // Jika format text dipilih
+        handler = slog.NewTextHandler(w, opts)  // Gunakan handler text (nyaman dibaca saat pengembangan)
+    } else {
+        handler = slog.NewJSONHandler(w, opts)  // Selain itu gunakan handler JSON (mudah diproses mesin)
+    }
+
+    return slog.New(handler)                  // Mengembalikan logger baru
+}
+
+// ParseLevel - mengubah string level menjadi slog.Level
+func ParseLevel(level string) slog.Level {    // Fungsi untuk parsing level log
+    switch strings.ToLower(level) {           // Mencocokkan level tanpa memperhatikan huruf besar/kecil
+    case "debug":
+        return slog.LevelDebug                // Level debug
+    case "warn", "warning":
+        return slog.LevelWarn                 // Level warning
+    case "error":
+        return slog.LevelError                // Level error
+    default:
+        return slog.LevelInfo                 // Default level info
+    }
+}
+
+// WithRequestID - menyimpan request ID ke dalam context
+func WithRequestID(ctx context.Context, requestID string) context.Context {  // Fungsi untuk menambahkan request ID ke context
+    return context.WithValue(ctx, requestIDKey, requestID)  // Mengembalikan context baru yang membawa request ID
+}
+
+// RequestIDFromContext - mengambil request ID dari context
+func RequestIDFromContext(ctx context.Context) string {  // Fungsi untuk membaca request ID dari context
+    if ctx == nil {                           // Jika context kosong
+        return ""                             // Tidak ada request ID
+    }
+    requestID, _ := ctx.Value(requestIDKey).(string)  // Mengambil nilai request ID dengan type assertion
+    return requestID                          // Mengembalikan request ID (string kosong jika tidak ada)
+}
+
+// FromContext - mengembalikan logger default yang sudah dilengkapi request ID
+func FromContext(ctx context.Context) *slog.Logger {  // Fungsi untuk mendapatkan logger per request
+    if requestID := RequestIDFromContext(ctx); requestID != "" {  // Jika request ID tersedia
+        return slog.Default().With("request_id", requestID)  // Tambahkan atribut request_id ke setiap log
+    }
+    return slog.Default()                     // Jika tidak, gunakan logger default
+}
+
+
+
+// {{{ Penjelasan Package Logger }}}
+
+/*
+## Penjelasan Detail
+File logger.go ini berisi pembuatan logger terstruktur menggunakan package log/slog bawaan Go. Berikut penjelasan detailnya:
+
+1. Tujuan : Menyediakan satu tempat untuk membuat logger aplikasi dan membawa request ID di sepanjang alur request.
+2. Fungsi New dan NewWithWriter :
+
+    - Membaca LogLevel dan LogFormat dari config.Config
+    - Format json menghasilkan satu objek JSON per baris (cocok untuk log collector)
+    - Format text menghasilkan key=value yang lebih mudah dibaca manusia
+3. Fungsi ParseLevel :
+
+    - Mengubah string seperti "debug" atau "warn" menjadi slog.Level
+    - Nilai yang tidak dikenal dianggap info
+4. Request ID di Context :
+
+    - WithRequestID menyimpan request ID ke context.Context
+    - RequestIDFromContext membacanya kembali (dipakai oleh middleware, GORM logger, dan respons error)
+    - FromContext mengembalikan logger yang otomatis menyertakan atribut request_id
+5. Penggunaan :
+
+    - main.go memanggil logger.New(cfg) lalu slog.SetDefault agar semua log memakai format yang sama
+    - Middleware RequestID mengisi context, sehingga service dan GORM dapat menulis log dengan request ID yang sama
+Dengan request ID di setiap baris log, satu request dapat ditelusuri dari access log sampai query database yang dijalankannya.
+*/
@@ -0,0 +1,119 @@
+package metrics                               // Mendefinisikan package metrics
+
+import (
+    "errors"                                  // Package untuk pengecekan error
+    "time"                                    // Package untuk durasi query
+
+    "github.com/prometheus/client_golang/prometheus"  // Library client Prometheus
+    "github.com/prometheus/client_golang/prometheus/collectors"  // Collector bawaan untuk sql.DB
+    "gorm.io/gorm"                            // Mengimpor ORM GORM
+)
+
+const startTimeKey = "metrics:start_time"     // Key instance GORM untuk menyimpan waktu mulai query
+
+var (
+    dbQueryDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{  // Histogram durasi query database
+        Namespace: namespace,
+        Name:      "db_query_duration_seconds",
+        Help:      "GORM query duration in seconds by operation and table.",
+        Buckets:   []float64{.001, .0025, .005, .01, .025, .05, .1, .25, .5, 1, 2.5},  // Bucket lebih kecil dari HTTP karena query biasanya cepat
+    }, []string{"operation", "table"})
+
+    dbQueryErrors = prometheus.NewCounterVec(prometheus.CounterOpts{  // Counter query database yang gagal
+        Namespace: namespace,
+        Name:      "db_query_errors_total",
+        Help:      "Total number of failed GORM queries by operation and table.",
+    }, []string{"operation", "table"})
+)
+
+// GormPlugin - plugin GORM untuk mencatat durasi dan error query
+type GormPlugin struct{}                      // Struct kosong, semua metric disimpan di variabel package
+
+// NewGormPlugin - membuat plugin metric GORM
+func NewGormPlugin() *GormPlugin {            // Constructor untuk plugin
+    return &GormPlugin{}                      // Mengembalikan instance plugin
+}
+
+func (p *GormPlugin) Name() string {          // Nama plugin (wajib untuk interface gorm.Plugin)
+    return "metrics"
+}
+
+func (p *GormPlugin) Initialize(db *gorm.DB) error {  // Method yang dipanggil saat db.Use(plugin)
+    cb := db.Callback()                       // Mengambil registry callback GORM
+    errs := []error{                          // Daftarkan callback before dan after untuk setiap operasi
+        cb.Create().Before("gorm:create").Register("metrics:before_create", before),
+        cb.Create().After("gorm:create").Register("metrics:after_create", after("create")),
+        cb.Query().Before("gorm:query").Register("metrics:before_query", before),
+        cb.Query().After("gorm:query").Register("metrics:after_query", after("query")),
+        cb.Update().Before("gorm:update").Register("metrics:before_update", before),
+        cb.Update().After("gorm:update").Register("metrics:after_update", after("update")),
+        cb.Delete().Before("gorm:delete").Register("metrics:before_delete", before),
+        cb.Delete().After("gorm:delete").Register("metrics:after_delete", after("delete")),
+        cb.Row().Before("gorm:row").Register("metrics:before_row", before),
+        cb.Row().After("gorm:row").Register("metrics:after_row", after("row")),
+        cb.Raw().Before("gorm:raw").Register("metrics:before_raw", before),
+        cb.Raw().After("gorm:raw").Register("metrics:after_raw", after("raw")),
+    }
+    return errors.Join(errs...)               // Gabungkan error pendaftaran callback (nil jika semua berhasil)
+}
+
+func before(db *gorm.DB) {                    // Callback sebelum query: simpan waktu mulai
+    db.InstanceSet(startTimeKey, time.Now())  // Disimpan per instance statement agar aman untuk query paralel
+}
+
+func after(operation string) func(*gorm.DB) {  // Callback setelah query: catat durasi dan error
+    return func(db *gorm.DB) {
+        value, ok := db.InstanceGet(startTimeKey)  // Ambil waktu mulai
+        if !ok {
+            return                            // Callback before tidak berjalan
+        }
+        start, _ := value.(time.Time)
+        table := db.Statement.Table           // Nama tabel yang di-query
+        if table == "" {
+            table = "unknown"                 // Query raw tanpa model
+        }
+
+        dbQueryDuration.WithLabelValues(operation, table).Observe(time.Since(start).Seconds())  // Catat durasi
+        if db.Error != nil && !errors.Is(db.Error, gorm.ErrRecordNotFound) {  // Record tidak ditemukan bukan kegagalan database
+            dbQueryErrors.WithLabelValues(operation, table).Inc()  // Tambah counter error
+        }
+    }
+}
+
+// RegisterDBStats - mendaftarkan statistik connection pool dari sql.DB
+func RegisterDBStats(db *gorm.DB, dbName string) error {  // Fungsi untuk mengekspos sql.DB.Stats()
+    sqlDB, err := db.DB()                     // Mengambil *sql.DB di balik GORM
+    if err != nil {
+        return err                            // Gagal mendapatkan koneksi
+    }
+    return Registry.Register(collectors.NewDBStatsCollector(sqlDB, dbName))  // Collector membaca sqlDB.Stats() setiap kali /metrics di-scrape
+}
+
+
+
+// {{{ Penjelasan Metric Database }}}
+
+/*
+## Penjelasan Detail
+File gorm.go ini berisi plugin GORM dan collector connection pool untuk Prometheus. Berikut penjelasan detailnya:
+
+1. Tujuan : Mengetahui seberapa lama query database berjalan, berapa yang gagal, dan kondisi connection pool.
+2. GormPlugin :
+
+    - Mengimplementasikan interface gorm.Plugin (Name dan Initialize)
+    - Mendaftarkan callback before/after untuk operasi create, query, update, delete, row, dan raw
+    - Waktu mulai disimpan dengan db.InstanceSet sehingga setiap statement memiliki nilainya sendiri
+3. Metric Query :
+
+    - rest_api_db_query_duration_seconds : Histogram durasi query per operasi dan tabel
+    - rest_api_db_query_errors_total : Jumlah query gagal per operasi dan tabel (gorm.ErrRecordNotFound tidak dihitung)
+4. RegisterDBStats :
+
+    - Menggunakan collectors.NewDBStatsCollector yang membaca sql.DB.Stats()
+    - Mengekspos go_sql_open_connections, go_sql_in_use_connections, go_sql_idle_connections, go_sql_wait_count_total, dll.
+5. Penggunaan di main.go :
+
+    - db.Use(metrics.NewGormPlugin())
+    - metrics.RegisterDBStats(db, cfg.DBName)
+Metric ini melengkapi log query dari logger.GormLogger: log untuk detail per query, metric untuk tren dan alert.
+*/
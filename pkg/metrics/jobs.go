@@ -0,0 +1,65 @@
+package metrics                               // Mendefinisikan package metrics
+
+import (
+    "time"                                    // Package untuk durasi job
+
+    "github.com/prometheus/client_golang/prometheus"  // Library client Prometheus
+)
+
+var (
+    jobsProcessed = prometheus.NewCounterVec(prometheus.CounterOpts{  // Counter hasil percobaan job
+        Namespace: namespace,
+        Name:      "jobs_processed_total",
+        Help:      "Total number of background job attempts by queue, type and outcome (succeeded, retry, dead, released).",
+    }, []string{"queue", "type", "outcome"})
+
+    jobDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{  // Histogram durasi percobaan job
+        Namespace: namespace,
+        Name:      "job_duration_seconds",
+        Help:      "Background job attempt duration in seconds by queue and type.",
+        Buckets:   []float64{.01, .05, .1, .5, 1, 5, 10, 30, 60, 300},  // Job bisa jauh lebih lama dari request HTTP
+    }, []string{"queue", "type"})
+
+    jobsRunning = prometheus.NewGaugeVec(prometheus.GaugeOpts{  // Gauge job yang sedang berjalan
+        Namespace: namespace,
+        Name:      "jobs_running",
+        Help:      "Number of background jobs currently running by queue.",
+    }, []string{"queue"})
+)
+
+func init() {                                 // Mendaftarkan metric job ke registry aplikasi
+    Registry.MustRegister(jobsProcessed, jobDuration, jobsRunning)
+}
+
+// JobStarted - menambah gauge job yang sedang berjalan
+func JobStarted(queue string) {               // Dipanggil runner sebelum handler dijalankan
+    jobsRunning.WithLabelValues(queue).Inc()
+}
+
+// JobFinished - mencatat satu percobaan job yang telah selesai
+func JobFinished(queue, jobType, outcome string, duration time.Duration) {  // Dipanggil runner setelah handler selesai
+    jobsRunning.WithLabelValues(queue).Dec()
+    jobsProcessed.WithLabelValues(queue, jobType, outcome).Inc()
+    jobDuration.WithLabelValues(queue, jobType).Observe(duration.Seconds())
+}
+
+
+// {{{ Penjelasan Metric Job }}}
+
+/*
+## Penjelasan Detail
+File jobs.go ini berisi metric Prometheus untuk job latar belakang (pkg/jobs). Berikut penjelasan detailnya:
+
+1. Metric :
+
+    - rest_api_jobs_processed_total : Jumlah percobaan job per antrean, tipe, dan hasil
+    - rest_api_job_duration_seconds : Histogram durasi percobaan job per antrean dan tipe
+    - rest_api_jobs_running : Jumlah job yang sedang berjalan per antrean
+2. Hasil (outcome) :
+
+    - succeeded : Handler selesai tanpa error
+    - retry : Handler gagal dan job dijadwalkan ulang
+    - dead : Percobaan habis atau error permanen
+    - released : Job dihentikan karena aplikasi berhenti dan dikembalikan ke antrean tanpa menghitung percobaan
+3. Penggunaan : Dipanggil oleh jobs.Runner, sehingga setiap tipe job otomatis memiliki metric tanpa kode tambahan.
+*/
@@ -0,0 +1,111 @@
+package metrics                               // Mendefinisikan package metrics
+
+import (
+    "context"                                 // Package untuk context request
+    "strconv"                                 // Package untuk konversi status code ke string
+    "time"                                    // Package untuk durasi
+
+    "github.com/gin-gonic/gin"                // Mengimpor framework web Gin
+    "github.com/prometheus/client_golang/prometheus"  // Library client Prometheus
+    "github.com/prometheus/client_golang/prometheus/collectors"  // Collector bawaan (Go runtime, proses, sql.DB)
+    "github.com/prometheus/client_golang/prometheus/promhttp"    // Handler HTTP untuk endpoint /metrics
+)
+
+const namespace = "rest_api"                  // Prefix untuk semua nama metric aplikasi
+
+var Registry = prometheus.NewRegistry()       // Registry khusus aplikasi (bukan registry global) agar isi /metrics terkontrol
+
+var (
+    httpRequestsTotal = prometheus.NewCounterVec(prometheus.CounterOpts{  // Counter jumlah request HTTP
+        Namespace: namespace,
+        Name:      "http_requests_total",
+        Help:      "Total number of HTTP requests by method, route template and status code.",
+    }, []string{"method", "route", "status"})
+
+    httpRequestDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{  // Histogram latency request HTTP
+        Namespace: namespace,
+        Name:      "http_request_duration_seconds",
+        Help:      "HTTP request latency in seconds by method, route template and status code.",
+        Buckets:   prometheus.DefBuckets,     // Bucket default: 5ms sampai 10s
+    }, []string{"method", "route", "status"})
+
+    httpRequestsInFlight = prometheus.NewGauge(prometheus.GaugeOpts{  // Gauge jumlah request yang sedang diproses
+        Namespace: namespace,
+        Name:      "http_requests_in_flight",
+        Help:      "Number of HTTP requests currently being served.",
+    })
+
+    panicsTotal = prometheus.NewCounter(prometheus.CounterOpts{  // Counter panic yang ditangkap middleware recovery
+        Namespace: namespace,
+        Name:      "panics_recovered_total",
+        Help:      "Total number of panics recovered while serving HTTP requests.",
+    })
+)
+
+func init() {                                 // Fungsi yang dijalankan otomatis saat package dimuat
+    Registry.MustRegister(                    // Mendaftarkan semua collector ke registry aplikasi
+        collectors.NewGoCollector(collectors.WithGoCollectorRuntimeMetrics(collectors.MetricsAll)),  // Metric runtime Go (goroutine, GC, memori, scheduler)
+        collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),  // Metric proses (CPU, file descriptor, memori)
+        httpRequestsTotal,
+        httpRequestDuration,
+        httpRequestsInFlight,
+        panicsTotal,
+        dbQueryDuration,
+        dbQueryErrors,
+    )
+}
+
+// RequestStarted - menambah gauge request yang sedang diproses
+func RequestStarted() {                       // Dipanggil middleware sebelum handler dijalankan
+    httpRequestsInFlight.Inc()                // Tambah satu request aktif
+}
+
+// RequestFinished - mencatat request yang telah selesai
+func RequestFinished(method, route string, status int, duration time.Duration) {  // Dipanggil middleware setelah handler selesai
+    httpRequestsInFlight.Dec()                // Kurangi satu request aktif
+    code := strconv.Itoa(status)              // Status code sebagai label
+    httpRequestsTotal.WithLabelValues(method, route, code).Inc()  // Tambah counter request
+    httpRequestDuration.WithLabelValues(method, route, code).Observe(duration.Seconds())  // Catat latency
+}
+
+// ReportPanic - mencatat panic, dipakai sebagai ErrorReporter di middleware recovery
+func ReportPanic(ctx context.Context, err error, stack []byte) {  // Signature sama dengan middleware.ErrorReporterFunc
+    panicsTotal.Inc()                         // Tambah counter panic
+}
+
+// Handler - handler Gin untuk endpoint /metrics
+func Handler() gin.HandlerFunc {              // Fungsi untuk membuat handler endpoint metrics
+    h := promhttp.HandlerFor(Registry, promhttp.HandlerOpts{Registry: Registry})  // Handler Prometheus untuk registry aplikasi
+    return gin.WrapH(h)                       // Membungkus http.Handler menjadi gin.HandlerFunc
+}
+
+
+
+// {{{ Penjelasan Package Metrics }}}
+
+/*
+## Penjelasan Detail
+File metrics.go ini berisi definisi metric Prometheus untuk aplikasi. Berikut penjelasan detailnya:
+
+1. Tujuan : Memberikan visibilitas kondisi API di produksi melalui endpoint GET /metrics.
+2. Registry :
+
+    - Menggunakan registry sendiri (bukan prometheus.DefaultRegisterer) agar hanya metric aplikasi yang diekspos
+    - Go collector mengekspos seluruh metric runtime Go (goroutine, GC, heap, scheduler)
+    - Process collector mengekspos CPU, memori, dan file descriptor proses
+3. Metric HTTP :
+
+    - rest_api_http_requests_total : Jumlah request per method, template route, dan status
+    - rest_api_http_request_duration_seconds : Histogram latency per method, template route, dan status
+    - rest_api_http_requests_in_flight : Jumlah request yang sedang diproses
+    - rest_api_panics_recovered_total : Jumlah panic yang ditangkap middleware Recovery (melalui ReportPanic)
+4. Label Route :
+
+    - Menggunakan template route (misal /api/products/:id), bukan path asli
+    - Ini mencegah ledakan jumlah label (cardinality) karena setiap ID berbeda
+5. Penggunaan :
+
+    - Middleware Metrics memanggil RequestStarted dan RequestFinished
+    - main.go mendaftarkan r.GET("/metrics", metrics.Handler())
+Metric database didefinisikan di gorm.go dalam package yang sama.
+*/
@@ -0,0 +1,39 @@
+package middleware                            // Mendefinisikan package middleware
+
+import (
+    "rest-api-go/pkg/audit"                   // Mengimpor package audit untuk context actor
+
+    "github.com/gin-gonic/gin"                // Mengimpor framework web Gin
+)
+
+func AuditActor() gin.HandlerFunc {           // Fungsi untuk middleware yang menyimpan actor audit ke context request
+    return func(c *gin.Context) {             // Mengembalikan fungsi handler middleware
+        actor := audit.Actor{IP: c.ClientIP()}  // IP client, memperhatikan proxy terpercaya Gin
+        if userID, ok := CurrentUserID(c); ok {  // User yang login, diisi oleh Authenticate
+            actor.UserID = &userID
+        }
+        c.Request = c.Request.WithContext(audit.WithActor(c.Request.Context(), actor))  // Dibaca plugin audit lewat db.WithContext(ctx)
+
+        c.Next()                              // Melanjutkan ke middleware atau handler berikutnya
+    }
+}
+
+
+
+// {{{ Penjelasan Middleware AuditActor }}}
+
+/*
+## Penjelasan Detail
+File audit.go ini berisi middleware yang menentukan siapa pelaku perubahan untuk audit log. Berikut penjelasan detailnya:
+
+1. Tujuan : Plugin audit GORM hanya melihat context statement, sehingga user dan IP harus dibawa di context request.
+2. Alur Kerja :
+
+    - Membaca user_id yang diisi middleware Authenticate (kosong untuk request anonim)
+    - Membaca IP client dengan c.ClientIP()
+    - Menyimpan keduanya dengan audit.WithActor ke c.Request.Context()
+3. Penggunaan :
+
+    - Didaftarkan di main.go setelah middleware.Authenticate: r.Use(middleware.AuditActor())
+    - Service harus memakai db.WithContext(ctx) agar actor ikut tercatat
+*/
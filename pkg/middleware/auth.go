@@ -0,0 +1,156 @@
+package middleware                            // Mendefinisikan package middleware
+
+import (
+    "net/http"                                // Package untuk konstanta HTTP
+    "rest-api-go/pkg/auth"                    // Mengimpor package auth untuk memeriksa token
+    "rest-api-go/pkg/utils"                   // Mengimpor utilitas aplikasi
+    "strings"                                 // Package untuk memotong prefix Bearer
+
+    "github.com/gin-gonic/gin"                // Mengimpor framework web Gin
+)
+
+func Authenticate(tokens *auth.Manager) gin.HandlerFunc {  // Fungsi untuk middleware autentikasi opsional
+    return func(c *gin.Context) {             // Mengembalikan fungsi handler middleware
+        header := c.GetHeader("Authorization")
+        if header == "" {
+            c.Next()                          // Request anonim tetap dilanjutkan
+            return
+        }
+
+        token, ok := strings.CutPrefix(header, "Bearer ")
+        if !ok {
+            utils.ErrorJSON(c, http.StatusUnauthorized, "Authorization header must use the Bearer scheme")
+            c.Abort()
+            return
+        }
+        claims, err := tokens.Verify(strings.TrimSpace(token))
+        if err != nil {                       // Token dikirim tetapi tidak valid, jangan diperlakukan sebagai anonim
+            utils.ErrorJSON(c, http.StatusUnauthorized, err.Error())
+            c.Abort()
+            return
+        }
+
+        c.Set(UserIDKey, claims.UserID)       // ID user tersedia untuk handler dan access log
+        c.Set(RoleKey, claims.Role)           // Peran user untuk RequireStaff dan pemeriksaan di handler
+        c.Next()                              // Melanjutkan ke middleware atau handler berikutnya
+    }
+}
+
+// ScopedTokenParam - nama query string dan cookie untuk token ber-scope
+const ScopedTokenParam = "access_token"
+
+func AuthenticateScoped(tokens *auth.Manager, scope string) gin.HandlerFunc {  // Fungsi untuk middleware per route yang juga menerima token ber-scope dari query string atau cookie
+    return func(c *gin.Context) {             // Mengembalikan fungsi handler middleware
+        if _, ok := CurrentUserID(c); ok {    // Sudah login lewat header Authorization
+            c.Next()
+            return
+        }
+        token := c.Query(ScopedTokenParam)
+        if token == "" {
+            token, _ = c.Cookie(ScopedTokenParam)
+        }
+        if token == "" {
+            c.Next()                          // RequireAuth setelahnya menjawab 401
+            return
+        }
+        claims, err := tokens.VerifyScoped(token, scope)
+        if err != nil {
+            utils.ErrorJSON(c, http.StatusUnauthorized, err.Error())
+            c.Abort()
+            return
+        }
+
+        c.Set(UserIDKey, claims.UserID)
+        c.Set(RoleKey, claims.Role)
+        c.Next()
+    }
+}
+
+func RequireAuth() gin.HandlerFunc {          // Fungsi untuk middleware yang mewajibkan login
+    return func(c *gin.Context) {             // Mengembalikan fungsi handler middleware
+        if _, ok := c.Get(UserIDKey); !ok {   // Authenticate belum mengisi user_id
+            utils.ErrorJSON(c, http.StatusUnauthorized, "authentication required")
+            c.Abort()
+            return
+        }
+        c.Next()                              // Melanjutkan ke middleware atau handler berikutnya
+    }
+}
+
+func RequireStaff() gin.HandlerFunc {         // Fungsi untuk middleware yang hanya mengizinkan staff dan admin
+    return requireRole(auth.Role.IsStaff, "staff role required")
+}
+
+func RequireAdmin() gin.HandlerFunc {         // Fungsi untuk middleware yang hanya mengizinkan admin
+    return requireRole(auth.Role.IsAdmin, "admin role required")
+}
+
+func requireRole(allowed func(auth.Role) bool, message string) gin.HandlerFunc {  // Fungsi untuk middleware pemeriksa peran: 401 untuk anonim, 403 untuk peran lain
+    return func(c *gin.Context) {
+        if _, ok := CurrentUserID(c); !ok {
+            utils.ErrorJSON(c, http.StatusUnauthorized, "authentication required")
+            c.Abort()
+            return
+        }
+        if !allowed(CurrentRole(c)) {
+            utils.ErrorJSON(c, http.StatusForbidden, message)
+            c.Abort()
+            return
+        }
+        c.Next()
+    }
+}
+
+// CurrentRole - mengembalikan peran user yang sedang login, kosong untuk request anonim
+func CurrentRole(c *gin.Context) auth.Role {
+    role, _ := c.Get(RoleKey)
+    r, _ := role.(auth.Role)
+    return r
+}
+
+// CurrentUserID - mengembalikan ID user yang sedang login
+func CurrentUserID(c *gin.Context) (uint, bool) {  // Mengembalikan false untuk request anonim
+    value, ok := c.Get(UserIDKey)
+    if !ok {
+        return 0, false
+    }
+    userID, ok := value.(uint)
+    return userID, ok
+}
+
+
+
+// {{{ Penjelasan Middleware Auth }}}
+
+/*
+## Penjelasan Detail
+File auth.go ini berisi middleware autentikasi berbasis token bearer. Berikut penjelasan detailnya:
+
+1. Authenticate :
+
+    - Dipasang global di main.go setelah RequestID dan Logger
+    - Tanpa header Authorization, request dilanjutkan sebagai anonim
+    - Dengan token valid, ID user disimpan di gin.Context dengan key user_id
+    - Dengan token tidak valid atau kedaluwarsa, request ditolak dengan 401 (bukan diperlakukan sebagai anonim)
+2. AuthenticateScoped :
+
+    - Dipasang per route sebelum RequireAuth, hanya untuk endpoint yang dibuka client tanpa header, misal EventSource di GET /api/events/stream
+    - Jika belum login lewat header, membaca ?access_token= atau cookie access_token dan memeriksanya dengan VerifyScoped
+    - Token login biasa ditolak di sini, dan token ber-scope ditolak Authenticate, sehingga keduanya tidak bisa saling menggantikan
+3. RequireAuth :
+
+    - Dipasang per route yang wajib login, misal cart.POST("/merge", middleware.RequireAuth(), handler.Merge)
+    - Mengembalikan 401 jika request anonim
+4. RequireStaff dan RequireAdmin :
+
+    - Dipasang per route yang hanya untuk staff/admin, misal users.PUT("/:id/role", middleware.RequireAdmin(), handler.SetRole)
+    - 401 untuk request anonim, 403 untuk user dengan peran lain
+    - Peran dibaca dari klaim token (lihat pkg/auth/role.go), bukan dari database
+5. CurrentUserID dan CurrentRole :
+
+    - Helper untuk handler agar tidak perlu type assertion sendiri, misal untuk membatasi data customer ke miliknya sendiri
+6. Access Log :
+
+    - Middleware Logger membaca user_id yang sama sehingga setiap baris log mencatat user yang login
+Token dibuat oleh POST /api/users/login melalui auth.Manager.
+*/
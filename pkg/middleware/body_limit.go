@@ -0,0 +1,54 @@
+package middleware                            // Mendefinisikan package middleware
+
+import (
+    "io"                                      // Package untuk interface ReadCloser
+    "net/http"                                // Package untuk http.MaxBytesReader
+
+    "github.com/gin-gonic/gin"                // Mengimpor framework web Gin
+)
+
+const originalBodyKey = "body_limit_original_body"  // Key gin.Context untuk menyimpan body asli sebelum dibatasi
+
+func BodyLimit(maxBytes int64) gin.HandlerFunc {  // Fungsi untuk middleware batas ukuran body
+    return func(c *gin.Context) {             // Mengembalikan fungsi handler middleware
+        body := c.Request.Body                // Body saat ini
+        if original, ok := c.Get(originalBodyKey); ok {  // Jika sudah dibatasi oleh BodyLimit sebelumnya (global)
+            body = original.(io.ReadCloser)   // Batasi body asli agar batas per route dapat lebih besar atau lebih kecil dari batas global
+        } else {
+            c.Set(originalBodyKey, body)      // Simpan body asli untuk BodyLimit berikutnya
+        }
+
+        if maxBytes > 0 {
+            c.Request.Body = http.MaxBytesReader(c.Writer, body, maxBytes)  // Membaca lebih dari maxBytes akan menghasilkan *http.MaxBytesError
+        } else {
+            c.Request.Body = body             // Tanpa batas untuk route ini
+        }
+
+        c.Next()                              // Melanjutkan ke middleware atau handler berikutnya
+    }
+}
+
+
+
+// {{{ Penjelasan Middleware BodyLimit }}}
+
+/*
+## Penjelasan Detail
+File body_limit.go ini berisi middleware untuk membatasi ukuran body request. Berikut penjelasan detailnya:
+
+1. Tujuan : Mencegah client mengirim body yang sangat besar dan menghabiskan memori server.
+2. Cara Kerja :
+
+    - Body request dibungkus dengan http.MaxBytesReader
+    - Saat handler membaca lebih dari batas, pembacaan gagal dengan *http.MaxBytesError
+    - utils.BindJSON mengubah error tersebut menjadi 413 Request Entity Too Large
+3. Batas Global dan Per Route :
+
+    - main.go memasang batas global: r.Use(middleware.BodyLimit(cfg.MaxBodyBytes))
+    - Route tertentu dapat memasang batas sendiri, misal products.POST("", middleware.BodyLimit(64<<10), handler.Create)
+    - Body asli disimpan di gin.Context sehingga batas per route menggantikan batas global, bukan menumpuk
+4. Nilai 0 :
+
+    - maxBytes <= 0 berarti tanpa batas untuk route tersebut
+Middleware ini tidak membaca body sendiri, sehingga tidak menambah latency untuk request yang valid.
+*/
@@ -0,0 +1,55 @@
+package middleware                            // Mendefinisikan package middleware
+
+import (
+    "fmt"                                     // Package untuk membentuk nilai header
+    "net/http"                                // Package untuk konstanta status HTTP
+    "time"                                    // Package untuk durasi max-age
+
+    "github.com/gin-gonic/gin"                // Mengimpor framework web Gin
+)
+
+// CacheControl - middleware per route yang mengirim header Cache-Control pada respons 200, misal products.GET("/:id", cacheControl, handler.GetByID)
+func CacheControl(maxAge time.Duration) gin.HandlerFunc {  // maxAge 0 berarti client selalu memeriksa ulang
+    value := "no-cache"
+    if seconds := int64(maxAge / time.Second); seconds > 0 {
+        value = fmt.Sprintf("public, max-age=%d", seconds)
+    }
+    return func(c *gin.Context) {             // Mengembalikan fungsi handler middleware
+        c.Writer = &cacheControlWriter{ResponseWriter: c.Writer, value: value}
+        c.Next()                              // Melanjutkan ke middleware atau handler berikutnya
+    }
+}
+
+type cacheControlWriter struct {              // Mendefinisikan struct writer yang menambah header sebelum status ditulis
+    gin.ResponseWriter
+    value string                              // Nilai header Cache-Control
+}
+
+func (w *cacheControlWriter) WriteHeader(code int) {  // Error tidak pernah di-cache oleh browser atau CDN
+    if code == http.StatusOK && w.Header().Get("Cache-Control") == "" {
+        w.Header().Set("Cache-Control", w.value)
+    }
+    w.ResponseWriter.WriteHeader(code)
+}
+
+
+
+// {{{ Penjelasan Middleware CacheControl }}}
+
+/*
+## Penjelasan Detail
+File cache_control.go ini berisi middleware untuk header Cache-Control. Berikut penjelasan detailnya:
+
+1. Tujuan : Mengizinkan browser dan CDN menyimpan respons baca product dan category selama HTTP_CACHE_MAX_AGE.
+2. Nilai Header :
+
+    - HTTP_CACHE_MAX_AGE > 0 : public, max-age=<detik>
+    - HTTP_CACHE_MAX_AGE = 0 : no-cache, client selalu meminta data terbaru
+3. Hanya Respons 200 :
+
+    - Writer dibungkus agar header ditambahkan saat status ditulis, sehingga 404 dan 500 tidak pernah di-cache
+    - Header yang sudah diisi handler tidak ditimpa
+4. Hubungan dengan Cache Server :
+
+    - Cache di service (pkg/cache) dan header ini terpisah; header mengatur salinan di client, cache service mengurangi query ke database
+*/
@@ -0,0 +1,46 @@
+package middleware                            // Mendefinisikan package middleware
+
+import (
+    "rest-api-go/pkg/metrics"                 // Mengimpor package metrics aplikasi
+    "time"                                    // Package untuk menghitung durasi request
+
+    "github.com/gin-gonic/gin"                // Mengimpor framework web Gin
+)
+
+func Metrics() gin.HandlerFunc {              // Fungsi untuk middleware metric Prometheus
+    return func(c *gin.Context) {             // Mengembalikan fungsi handler middleware
+        start := time.Now()                   // Catat waktu mulai request
+        metrics.RequestStarted()              // Tambah gauge request yang sedang diproses
+
+        c.Next()                              // Jalankan handler berikutnya
+
+        route := c.FullPath()                 // Template route agar label tidak meledak karena ID
+        if route == "" {
+            route = "unmatched"               // Request ke path yang tidak terdaftar (404)
+        }
+        metrics.RequestFinished(c.Request.Method, route, c.Writer.Status(), time.Since(start))  // Catat counter, histogram, dan kurangi gauge
+    }
+}
+
+
+
+// {{{ Penjelasan Middleware Metrics }}}
+
+/*
+## Penjelasan Detail
+File metrics.go ini berisi middleware yang mencatat metric Prometheus untuk setiap request HTTP. Berikut penjelasan detailnya:
+
+1. Tujuan : Mengisi metric jumlah request, latency, dan request aktif yang diekspos di GET /metrics.
+2. Alur Kerja :
+
+    - Sebelum handler: menambah gauge rest_api_http_requests_in_flight
+    - Setelah handler: mencatat counter dan histogram dengan label method, route, dan status lalu mengurangi gauge
+3. Label Route :
+
+    - Menggunakan c.FullPath() seperti /api/products/:id
+    - Path yang tidak terdaftar dikelompokkan sebagai "unmatched" sehingga scanner yang mencoba banyak URL tidak membuat label baru
+4. Penggunaan :
+
+    - Didaftarkan di main.go dengan r.Use(middleware.Metrics())
+Middleware ini sebaiknya dipasang setelah RequestID dan sebelum handler lain agar durasi mencakup seluruh rantai middleware.
+*/
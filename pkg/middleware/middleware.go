@@ -1,18 +1,65 @@
 package middleware                            // Mendefinisikan package middleware
 
 import (
+    "log/slog"                                // Package structured logging bawaan Go
+    "time"                                    // Package untuk menghitung latency
+
     "github.com/gin-gonic/gin"                // Mengimpor framework web Gin
+    "go.opentelemetry.io/otel/trace"          // API span OpenTelemetry untuk korelasi log dan trace
 )
 
-func Logger() gin.HandlerFunc {               // Fungsi untuk middleware logging
-    return gin.Logger()                       // Mengembalikan middleware logger bawaan Gin
+const UserIDKey = "user_id"                   // Key di gin.Context untuk ID user yang sedang login
+
+const RoleKey = "user_role"                   // Key di gin.Context untuk peran user yang sedang login
+
+func Logger(logger *slog.Logger) gin.HandlerFunc {  // Fungsi untuk middleware access log berbasis slog
+    return func(c *gin.Context) {             // Mengembalikan fungsi handler middleware
+        start := time.Now()                   // Catat waktu mulai request
+        c.Next()                              // Jalankan handler berikutnya terlebih dahulu
+
+        route := c.FullPath()                 // Template route (misal /api/products/:id), bukan path asli
+        if route == "" {
+            route = "unmatched"               // Request yang tidak cocok dengan route mana pun
+        }
+
+        attrs := []any{                       // Atribut access log
+            "request_id", c.GetString(RequestIDKey),
+            "method", c.Request.Method,
+            "route", route,
+            "path", c.Request.URL.Path,
+            "status", c.Writer.Status(),
+            "latency_ms", float64(time.Since(start).Microseconds()) / 1000,
+            "bytes", c.Writer.Size(),
+            "client_ip", c.ClientIP(),
+            "user_agent", c.Request.UserAgent(),
+        }
+        if userID, ok := c.Get(UserIDKey); ok {  // Jika request berasal dari user yang sudah login
+            attrs = append(attrs, "user_id", userID)  // Sertakan ID user
+        }
+        if span := trace.SpanContextFromContext(c.Request.Context()); span.HasTraceID() {  // Jika request sedang ditrace
+            attrs = append(attrs, "trace_id", span.TraceID().String())  // Sertakan trace ID agar log bisa dicocokkan dengan trace
+        }
+        if len(c.Errors) > 0 {                // Jika handler mencatat error di gin.Context
+            attrs = append(attrs, "errors", c.Errors.String())
+        }
+
+        level := slog.LevelInfo               // Level default untuk request sukses
+        switch {
+        case c.Writer.Status() >= 500:
+            level = slog.LevelError           // Error server
+        case c.Writer.Status() >= 400:
+            level = slog.LevelWarn            // Error dari sisi client
+        }
+        logger.Log(c.Request.Context(), level, "http request", attrs...)  // Tulis satu baris access log
+    }
 }
 
 func CORS() gin.HandlerFunc {                 // Fungsi untuk middleware CORS (Cross-Origin Resource Sharing)
     return func(c *gin.Context) {             // Mengembalikan fungsi handler middleware
         c.Writer.Header().Set("Access-Control-Allow-Origin", "*")  // Mengizinkan akses dari semua origin
-        c.Writer.Header().Set("Access-Control-Allow-Methods", "POST, GET, OPTIONS, PUT, DELETE")  // Mengizinkan metode HTTP tertentu
-        c.Writer.Header().Set("Access-Control-Allow-Headers", "Content-Type, Content-Length, Accept-Encoding, X-CSRF-Token, Authorization")  // Mengizinkan header tertentu
+        c.Writer.Header().Set("Access-Control-Allow-Methods", "POST, GET, OPTIONS, PUT, PATCH, DELETE")  // Mengizinkan metode HTTP tertentu
+        c.Writer.Header().Set("Access-Control-Allow-Headers", "Content-Type, Content-Length, Accept-Encoding, X-CSRF-Token, Authorization, X-Request-ID, X-Cart-Session")  // Mengizinkan header tertentu
+        c.Writer.Header().Set("Access-Control-Expose-Headers", "X-Request-ID, X-Cart-Session")  // Mengizinkan browser membaca header request ID dan sesi cart
 
         if c.Request.Method == "OPTIONS" {    // Jika request adalah OPTIONS (preflight request)
             c.AbortWithStatus(204)            // Mengembalikan status 204 (No Content) dan menghentikan chain middleware
@@ -37,9 +84,11 @@ File middleware.go ini berisi implementasi middleware untuk aplikasi web Gin. Be
     - Menangani CORS (Cross-Origin Resource Sharing)
 2. Middleware Logger :
 
-    - Menggunakan middleware logger bawaan Gin
-    - Mencatat informasi request seperti metode HTTP, path, status code, dan waktu respons
-    - Membantu dalam debugging dan monitoring aplikasi
+    - Menulis satu baris access log per request melalui slog (JSON atau text sesuai konfigurasi)
+    - Mencatat request ID, metode HTTP, template route, path, status code, latency, ukuran respons, IP client, dan user ID jika ada
+    - Jika tracing aktif, trace_id ikut dicatat sehingga log dapat dicocokkan dengan trace
+    - Template route (c.FullPath) dipakai agar log mudah dikelompokkan per endpoint
+    - Level log mengikuti status: error untuk 5xx, warn untuk 4xx, info untuk sisanya
 3. Middleware CORS :
 
     - Menambahkan header CORS ke respons HTTP
@@ -0,0 +1,123 @@
+package middleware                            // Mendefinisikan package middleware
+
+import (
+    "context"                                 // Package untuk context request
+    "errors"                                  // Package untuk pengecekan error
+    "fmt"                                     // Package untuk mengubah nilai panic menjadi error
+    "log/slog"                                // Package structured logging bawaan Go
+    "net"                                     // Package untuk mendeteksi error koneksi
+    "net/http"                                // Package untuk konstanta HTTP
+    "os"                                      // Package untuk error syscall
+    "rest-api-go/pkg/utils"                   // Mengimpor utilitas aplikasi
+    "runtime/debug"                           // Package untuk mengambil stack trace
+    "strings"                                 // Package untuk pengecekan pesan error
+
+    "github.com/gin-gonic/gin"                // Mengimpor framework web Gin
+)
+
+// ErrorReporter - hook untuk meneruskan panic ke layanan pelaporan error (Sentry, Rollbar, dll.)
+type ErrorReporter interface {                // Mendefinisikan interface reporter
+    Report(ctx context.Context, err error, stack []byte)  // Dipanggil sekali untuk setiap panic
+}
+
+// ErrorReporterFunc - adapter agar fungsi biasa dapat dipakai sebagai ErrorReporter
+type ErrorReporterFunc func(ctx context.Context, err error, stack []byte)
+
+func (f ErrorReporterFunc) Report(ctx context.Context, err error, stack []byte) {  // Implementasi interface ErrorReporter
+    f(ctx, err, stack)                        // Panggil fungsi yang dibungkus
+}
+
+func Recovery(logger *slog.Logger, reporters ...ErrorReporter) gin.HandlerFunc {  // Fungsi untuk middleware recovery
+    return func(c *gin.Context) {             // Mengembalikan fungsi handler middleware
+        defer func() {
+            recovered := recover()            // Tangkap panic dari handler berikutnya
+            if recovered == nil {
+                return                        // Tidak ada panic
+            }
+            if recovered == http.ErrAbortHandler {  // Panic sengaja untuk membatalkan respons
+                panic(recovered)              // Teruskan ke net/http seperti perilaku standar
+            }
+
+            err, ok := recovered.(error)      // Ubah nilai panic menjadi error
+            if !ok {
+                err = fmt.Errorf("%v", recovered)
+            }
+            stack := debug.Stack()            // Ambil stack trace goroutine saat ini
+
+            if isBrokenPipe(err) {            // Client sudah memutus koneksi
+                logger.WarnContext(c.Request.Context(), "client connection lost",
+                    "request_id", c.GetString(RequestIDKey), "error", err.Error())
+                c.Error(err)                  // Catat di gin.Context untuk access log
+                c.Abort()                     // Tidak perlu menulis respons ke koneksi yang sudah putus
+                return
+            }
+
+            logger.ErrorContext(c.Request.Context(), "panic recovered",  // Catat panic beserta stack trace
+                "request_id", c.GetString(RequestIDKey),
+                "method", c.Request.Method,
+                "route", c.FullPath(),
+                "error", err.Error(),
+                "stack", string(stack),
+            )
+            for _, reporter := range reporters {  // Teruskan ke setiap reporter yang terdaftar
+                reporter.Report(c.Request.Context(), err, stack)
+            }
+
+            c.Abort()                         // Hentikan handler yang tersisa
+            if c.Writer.Written() {           // Handler sudah mulai menulis respons
+                return                        // Header tidak bisa diubah lagi
+            }
+            message := http.StatusText(http.StatusInternalServerError)  // Pesan umum untuk produksi
+            if gin.IsDebugging() {            // Di mode debug tampilkan pesan panic untuk memudahkan pengembangan
+                message = err.Error()
+            }
+            utils.ErrorJSON(c, http.StatusInternalServerError, message)  // Respons error standar dengan request ID
+        }()
+
+        c.Next()                              // Melanjutkan ke middleware atau handler berikutnya
+    }
+}
+
+func isBrokenPipe(err error) bool {           // Fungsi untuk mendeteksi koneksi yang diputus client
+    var opErr *net.OpError
+    if !errors.As(err, &opErr) {
+        return false                          // Bukan error jaringan
+    }
+    var syscallErr *os.SyscallError
+    if !errors.As(opErr, &syscallErr) {
+        return false
+    }
+    msg := strings.ToLower(syscallErr.Error())
+    return strings.Contains(msg, "broken pipe") || strings.Contains(msg, "connection reset by peer")
+}
+
+
+
+// {{{ Penjelasan Middleware Recovery }}}
+
+/*
+## Penjelasan Detail
+File recovery.go ini berisi middleware untuk menangkap panic dan tetap mengembalikan respons JSON standar. Berikut penjelasan detailnya:
+
+1. Tujuan : Recovery bawaan gin.Default() hanya mengembalikan status 500 tanpa body, sedangkan client selalu membaca utils.Response.
+2. Alur Kerja :
+
+    - Panic ditangkap dengan recover() lalu diubah menjadi error
+    - Stack trace dicatat melalui slog bersama request ID, method, dan route
+    - Setiap ErrorReporter yang didaftarkan dipanggil (misal untuk Sentry atau metric)
+    - Client menerima {"success": false, "error": "...", "request_id": "..."} dengan status 500
+3. Menyembunyikan Detail Internal :
+
+    - Di mode release pesan error selalu "Internal Server Error"
+    - Di mode debug (GIN_MODE=debug) pesan panic ditampilkan untuk memudahkan pengembangan
+4. Kasus Khusus :
+
+    - http.ErrAbortHandler diteruskan kembali agar perilaku net/http tetap sama
+    - Koneksi yang diputus client (broken pipe) hanya dicatat sebagai warning tanpa menulis respons
+    - Jika handler sudah menulis sebagian respons, header tidak diubah lagi
+5. ErrorReporter :
+
+    - Interface dengan satu method Report(ctx, err, stack)
+    - ErrorReporterFunc memungkinkan fungsi biasa dipakai sebagai reporter
+Middleware ini menggantikan gin.Recovery() di main.go dan dipasang setelah RequestID agar request ID tersedia.
+*/
@@ -0,0 +1,76 @@
+package middleware                            // Mendefinisikan package middleware
+
+import (
+    "crypto/rand"                             // Package untuk menghasilkan byte acak yang aman
+    "encoding/hex"                            // Package untuk encoding hex
+    "rest-api-go/pkg/logger"                  // Mengimpor package logger untuk context request ID
+
+    "github.com/gin-gonic/gin"                // Mengimpor framework web Gin
+)
+
+const (
+    RequestIDHeader = "X-Request-ID"          // Nama header untuk request ID
+    RequestIDKey    = "request_id"            // Key di gin.Context untuk request ID
+    maxRequestIDLen = 128                     // Panjang maksimal request ID dari client
+)
+
+func RequestID() gin.HandlerFunc {            // Fungsi untuk middleware request ID
+    return func(c *gin.Context) {             // Mengembalikan fungsi handler middleware
+        requestID := c.GetHeader(RequestIDHeader)  // Mengambil request ID dari header jika dikirim client/proxy
+        if !validRequestID(requestID) {       // Jika tidak ada atau formatnya tidak aman
+            requestID = newRequestID()        // Buat request ID baru
+        }
+
+        c.Set(RequestIDKey, requestID)        // Simpan di gin.Context untuk handler
+        c.Request = c.Request.WithContext(logger.WithRequestID(c.Request.Context(), requestID))  // Simpan di context request untuk service dan GORM
+        c.Writer.Header().Set(RequestIDHeader, requestID)  // Kembalikan request ID ke client lewat header respons
+
+        c.Next()                              // Melanjutkan ke middleware atau handler berikutnya
+    }
+}
+
+func validRequestID(id string) bool {         // Fungsi untuk memeriksa request ID dari client
+    if id == "" || len(id) > maxRequestIDLen {  // Kosong atau terlalu panjang
+        return false
+    }
+    for _, r := range id {                    // Periksa setiap karakter
+        if r < 0x21 || r > 0x7e {             // Hanya karakter ASCII yang terlihat yang diizinkan (mencegah log injection)
+            return false
+        }
+    }
+    return true                               // Request ID aman dipakai
+}
+
+func newRequestID() string {                  // Fungsi untuk membuat request ID acak
+    b := make([]byte, 16)                     // 16 byte = 128 bit keacakan
+    if _, err := rand.Read(b); err != nil {   // Isi dengan byte acak
+        return "unknown"                      // Sangat jarang terjadi, gunakan nilai penanda
+    }
+    return hex.EncodeToString(b)              // Mengembalikan 32 karakter hex
+}
+
+
+
+// {{{ Penjelasan Middleware RequestID }}}
+
+/*
+## Penjelasan Detail
+File request_id.go ini berisi middleware untuk memberikan ID unik pada setiap request. Berikut penjelasan detailnya:
+
+1. Tujuan : Setiap request memiliki ID yang sama di access log, log query database, dan respons error.
+2. Alur Kerja :
+
+    - Jika client atau load balancer mengirim header X-Request-ID yang valid, ID tersebut dipakai
+    - Jika tidak ada (atau berisi karakter aneh), dibuat ID acak 32 karakter hex
+    - ID disimpan di gin.Context (key "request_id") dan di context.Context milik request
+    - ID dikirim kembali ke client melalui header X-Request-ID
+3. Validasi :
+
+    - Maksimal 128 karakter
+    - Hanya karakter ASCII yang terlihat, sehingga tidak bisa menyisipkan baris baru ke log
+4. Penggunaan :
+
+    - Daftarkan paling awal di main.go: r.Use(middleware.RequestID())
+    - Service meneruskan context ke GORM dengan db.WithContext(ctx) agar log query membawa ID yang sama
+Dengan request ID, laporan error dari client dapat langsung dicocokkan dengan log di server.
+*/
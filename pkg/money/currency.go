@@ -0,0 +1,55 @@
+package money                                 // Mendefinisikan package money
+
+import "strings"                              // Package untuk normalisasi kode mata uang
+
+type Currency struct {                        // Mendefinisikan struct Currency (ISO 4217)
+    Code      string                          // Kode tiga huruf, misal IDR, USD
+    Digits    int                             // Jumlah digit minor unit (IDR/USD 2, JPY 0, KWD 3)
+    Symbol    string                          // Simbol untuk tampilan, misal Rp, $
+    Thousands string                          // Pemisah ribuan
+    Decimal   string                          // Pemisah desimal
+}
+
+var currencies = map[string]Currency{         // Daftar mata uang yang didukung
+    "IDR": {Code: "IDR", Digits: 2, Symbol: "Rp", Thousands: ".", Decimal: ","},
+    "USD": {Code: "USD", Digits: 2, Symbol: "$", Thousands: ",", Decimal: "."},
+    "EUR": {Code: "EUR", Digits: 2, Symbol: "€", Thousands: ".", Decimal: ","},
+    "GBP": {Code: "GBP", Digits: 2, Symbol: "£", Thousands: ",", Decimal: "."},
+    "SGD": {Code: "SGD", Digits: 2, Symbol: "S$", Thousands: ",", Decimal: "."},
+    "MYR": {Code: "MYR", Digits: 2, Symbol: "RM", Thousands: ",", Decimal: "."},
+    "AUD": {Code: "AUD", Digits: 2, Symbol: "A$", Thousands: ",", Decimal: "."},
+    "JPY": {Code: "JPY", Digits: 0, Symbol: "¥", Thousands: ",", Decimal: "."},
+    "KRW": {Code: "KRW", Digits: 0, Symbol: "₩", Thousands: ",", Decimal: "."},
+    "KWD": {Code: "KWD", Digits: 3, Symbol: "KD", Thousands: ",", Decimal: "."},
+}
+
+var DefaultCurrency = "IDR"                   // Mata uang jika client tidak mengirim currency (diatur dari DEFAULT_CURRENCY)
+
+// LookupCurrency - mencari mata uang berdasarkan kode ISO 4217
+func LookupCurrency(code string) (Currency, bool) {  // Mengembalikan false jika mata uang tidak didukung
+    c, ok := currencies[strings.ToUpper(strings.TrimSpace(code))]
+    return c, ok
+}
+
+
+
+// {{{ Penjelasan Mata Uang }}}
+
+/*
+## Penjelasan Detail
+File currency.go ini berisi daftar mata uang ISO 4217 yang didukung. Berikut penjelasan detailnya:
+
+1. Digits :
+
+    - Menentukan jumlah minor unit, misal 1 USD = 100 cent (2 digit), JPY tidak memiliki minor unit (0 digit)
+    - Money.Amount selalu disimpan dalam minor unit, sehingga 12.50 USD disimpan sebagai 1250
+2. Format Tampilan :
+
+    - Symbol, Thousands, dan Decimal dipakai oleh Money.Format
+    - Contoh: 1250000 IDR (minor unit) menjadi "Rp12.500,00", 1250 USD menjadi "$12.50"
+3. DefaultCurrency :
+
+    - Dipakai saat JSON tidak menyertakan currency
+    - main.go mengisinya dari variabel lingkungan DEFAULT_CURRENCY
+Mata uang baru cukup ditambahkan ke map currencies.
+*/
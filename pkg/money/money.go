@@ -0,0 +1,276 @@
+package money                                 // Mendefinisikan package money
+
+import (
+    "bytes"                                   // Package untuk membaca JSON
+    "encoding/json"                           // Package untuk encoding/decoding JSON
+    "errors"                                  // Package untuk membuat error
+    "fmt"                                     // Package untuk formatting pesan error
+    "math/bits"                               // Package untuk perkalian 128-bit tanpa overflow
+    "strings"                                 // Package untuk manipulasi string
+)
+
+const StorageScale = 4                        // Jumlah digit desimal kolom DECIMAL(19,4) di database
+
+const maxIntegerDigits = 19 - StorageScale    // Digit sebelum koma yang muat di DECIMAL(19,4)
+
+var (
+    ErrUnknownCurrency = errors.New("unknown currency")  // Kode mata uang tidak didukung
+    ErrInvalidAmount   = errors.New("invalid amount")    // Format jumlah tidak valid
+    ErrCurrencyMismatch = errors.New("currency mismatch") // Operasi pada dua mata uang berbeda
+    ErrOverflow        = errors.New("amount too large")  // Hasil operasi tidak muat di DECIMAL(19,4)
+)
+
+type Money struct {                           // Mendefinisikan struct Money
+    Amount   int64                            // Jumlah dalam minor unit (cent, sen), tidak pernah float
+    Currency string                           // Kode mata uang ISO 4217
+}
+
+// New - membuat Money dari jumlah minor unit
+func New(amount int64, currency string) Money {  // Misal New(1250, "USD") = 12.50 USD
+    return Money{Amount: amount, Currency: strings.ToUpper(currency)}
+}
+
+// Parse - membuat Money dari string desimal seperti "12.50"
+func Parse(amount, currency string) (Money, error) {  // Parsing tanpa float sehingga tidak ada error pembulatan
+    cur, ok := LookupCurrency(currency)
+    if !ok {
+        return Money{}, fmt.Errorf("%w %q", ErrUnknownCurrency, currency)
+    }
+
+    s := strings.TrimSpace(amount)
+    negative := strings.HasPrefix(s, "-")     // Tanda negatif (ditolak oleh validasi, tetapi tetap bisa di-parse)
+    s = strings.TrimPrefix(s, "-")
+
+    whole, fraction, _ := strings.Cut(s, ".") // Pisahkan bagian bulat dan pecahan
+    if whole == "" || !isDigits(whole) || !isDigits(fraction) {
+        return Money{}, fmt.Errorf("%w %q", ErrInvalidAmount, amount)
+    }
+    whole = strings.TrimLeft(whole, "0")      // Buang nol di depan agar pengecekan panjang akurat
+    if len(whole) > maxIntegerDigits {
+        return Money{}, fmt.Errorf("%w %q: too large", ErrInvalidAmount, amount)
+    }
+
+    trimmed := strings.TrimRight(fraction, "0")  // Nol di belakang boleh melebihi jumlah digit (misal "12.5000" dari database)
+    if len(trimmed) > cur.Digits {
+        return Money{}, fmt.Errorf("%w %q: %s allows at most %d decimal places", ErrInvalidAmount, amount, cur.Code, cur.Digits)
+    }
+    fraction = trimmed + strings.Repeat("0", cur.Digits-len(trimmed))  // Lengkapi sampai jumlah digit mata uang
+
+    var minor int64
+    for _, r := range whole + fraction {      // Gabungkan menjadi satu bilangan bulat minor unit
+        minor = minor*10 + int64(r-'0')
+    }
+    if negative {
+        minor = -minor
+    }
+    return Money{Amount: minor, Currency: cur.Code}, nil
+}
+
+func isDigits(s string) bool {                // Fungsi untuk memeriksa string hanya berisi angka 0-9
+    for _, r := range s {
+        if r < '0' || r > '9' {
+            return false
+        }
+    }
+    return true
+}
+
+// Valid - memeriksa apakah mata uang didukung
+func (m Money) Valid() bool {
+    _, ok := LookupCurrency(m.Currency)
+    return ok
+}
+
+// IsNegative - memeriksa apakah jumlah kurang dari nol
+func (m Money) IsNegative() bool {
+    return m.Amount < 0
+}
+
+// Add - menjumlahkan dua Money dengan mata uang yang sama, gagal dengan ErrOverflow jika hasil tidak muat di DECIMAL(19,4)
+func (m Money) Add(other Money) (Money, error) {
+    if m.Currency != other.Currency {
+        return Money{}, fmt.Errorf("%w: %s and %s", ErrCurrencyMismatch, m.Currency, other.Currency)
+    }
+    sum := m.Amount + other.Amount
+    if (sum > m.Amount) != (other.Amount > 0) {  // Overflow int64 membalik tanda hasil
+        return Money{}, ErrOverflow
+    }
+    return checked(sum, m.Currency)
+}
+
+// Mul - mengalikan jumlah dengan bilangan bulat, misal harga satuan x quantity, gagal dengan ErrOverflow jika hasil tidak muat di DECIMAL(19,4)
+func (m Money) Mul(n int64) (Money, error) {
+    hi, lo := bits.Mul64(unsigned(m.Amount), unsigned(n))  // Hasil 128-bit sehingga overflow int64 selalu terdeteksi
+    if hi != 0 || lo > unsigned(maxAmount(m.Currency)) {
+        return Money{}, ErrOverflow
+    }
+    amount := int64(lo)
+    if (m.Amount < 0) != (n < 0) {
+        amount = -amount
+    }
+    return Money{Amount: amount, Currency: m.Currency}, nil
+}
+
+func checked(amount int64, currency string) (Money, error) {  // Fungsi untuk memeriksa jumlah terhadap batas kolom DECIMAL(19,4)
+    if unsigned(amount) > unsigned(maxAmount(currency)) {
+        return Money{}, ErrOverflow
+    }
+    return Money{Amount: amount, Currency: currency}, nil
+}
+
+func maxAmount(currency string) int64 {       // Fungsi untuk jumlah minor unit terbesar yang muat di DECIMAL(19,4), sama dengan batas Parse
+    cur, _ := LookupCurrency(currency)        // Mata uang tidak dikenal dihitung tanpa digit desimal
+    limit := int64(1)
+    for i := 0; i < maxIntegerDigits+cur.Digits; i++ {
+        limit *= 10
+    }
+    return limit - 1                          // Misal USD: 999999999999999.99 = 99999999999999999 cent
+}
+
+func unsigned(n int64) uint64 {               // Fungsi untuk nilai absolut sebagai uint64, benar juga untuk math.MinInt64
+    if n < 0 {
+        return uint64(-n)
+    }
+    return uint64(n)
+}
+
+// String - jumlah dalam bentuk desimal sesuai digit mata uang, misal "12.50"
+func (m Money) String() string {
+    cur, ok := LookupCurrency(m.Currency)
+    if !ok {
+        return fmt.Sprintf("%d", m.Amount)    // Mata uang tidak dikenal, tampilkan minor unit apa adanya
+    }
+    return decimalString(m.Amount, cur.Digits, ".", "")
+}
+
+// Decimal - jumlah dengan skala tertentu untuk disimpan ke kolom DECIMAL
+func (m Money) Decimal(scale int) string {    // Misal Decimal(4) untuk 1250 USD = "12.5000"
+    cur, _ := LookupCurrency(m.Currency)
+    s := decimalString(m.Amount, cur.Digits, ".", "")
+    if scale <= cur.Digits {
+        return s
+    }
+    if cur.Digits == 0 {
+        s += "."
+    }
+    return s + strings.Repeat("0", scale-cur.Digits)
+}
+
+// Format - jumlah untuk ditampilkan sesuai aturan mata uang, misal "$1,234.50" atau "Rp1.234,50"
+func (m Money) Format() string {
+    cur, ok := LookupCurrency(m.Currency)
+    if !ok {
+        return m.String() + " " + m.Currency
+    }
+    s := cur.Symbol + decimalString(abs(m.Amount), cur.Digits, cur.Decimal, cur.Thousands)
+    if m.IsNegative() {
+        return "-" + s
+    }
+    return s
+}
+
+func decimalString(amount int64, digits int, decimal, thousands string) string {  // Fungsi untuk mengubah minor unit menjadi string desimal
+    sign := ""
+    if amount < 0 {
+        sign = "-"
+    }
+    s := fmt.Sprintf("%0*d", digits+1, abs(amount))  // Minimal satu digit sebelum koma
+    whole, fraction := s[:len(s)-digits], s[len(s)-digits:]
+
+    if thousands != "" {                      // Sisipkan pemisah ribuan dari kanan
+        var b strings.Builder
+        for i, r := range whole {
+            if i > 0 && (len(whole)-i)%3 == 0 {
+                b.WriteString(thousands)
+            }
+            b.WriteRune(r)
+        }
+        whole = b.String()
+    }
+    if digits == 0 {
+        return sign + whole
+    }
+    return sign + whole + decimal + fraction
+}
+
+func abs(n int64) int64 {                     // Fungsi untuk nilai absolut
+    if n < 0 {
+        return -n
+    }
+    return n
+}
+
+type jsonMoney struct {                       // Bentuk JSON dari Money
+    Amount    json.RawMessage `json:"amount"`    // Jumlah sebagai string, misal "12.50"
+    Currency  string          `json:"currency"`  // Kode mata uang
+    Formatted string          `json:"formatted,omitempty"`  // Hanya untuk output, diabaikan saat input
+}
+
+func (m Money) MarshalJSON() ([]byte, error) {  // Encode sebagai {"amount": "12.50", "currency": "USD", "formatted": "$12.50"}
+    amount, _ := json.Marshal(m.String())     // Jumlah selalu string agar tidak kehilangan presisi di client
+    return json.Marshal(jsonMoney{Amount: amount, Currency: m.Currency, Formatted: m.Format()})
+}
+
+func (m *Money) UnmarshalJSON(data []byte) error {  // Decode dari {"amount": "12.50", "currency": "USD"}
+    decoder := json.NewDecoder(bytes.NewReader(data))
+    decoder.DisallowUnknownFields()           // Konsisten dengan utils.BindJSON
+    var raw jsonMoney
+    if err := decoder.Decode(&raw); err != nil {
+        return fmt.Errorf("money must be an object like {\"amount\": \"12.50\", \"currency\": \"USD\"}: %w", err)
+    }
+
+    var amount string
+    if err := json.Unmarshal(raw.Amount, &amount); err != nil {  // Angka JSON (float) ditolak untuk mencegah pembulatan
+        return errors.New("money amount must be a string, e.g. \"12.50\"")
+    }
+    currency := raw.Currency
+    if currency == "" {
+        currency = DefaultCurrency            // Mata uang default jika tidak dikirim
+    }
+
+    parsed, err := Parse(amount, currency)
+    if err != nil {
+        return err
+    }
+    *m = parsed
+    return nil
+}
+
+
+
+// {{{ Penjelasan Package Money }}}
+
+/*
+## Penjelasan Detail
+File money.go ini berisi tipe Money untuk menyimpan harga secara tepat. Berikut penjelasan detailnya:
+
+1. Masalah Sebelumnya :
+
+    - Harga disimpan sebagai float64 sehingga 0.1 + 0.2 tidak sama dengan 0.3
+    - Harga negatif dan pecahan sen (misal 12.345 USD) diterima
+2. Representasi :
+
+    - Amount adalah int64 dalam minor unit (1250 = 12.50 USD)
+    - Currency adalah kode ISO 4217, jumlah digit minor unit diambil dari currency.go
+    - Parse tidak pernah memakai float, string "12.50" langsung diubah menjadi 1250
+3. JSON :
+
+    - Output: {"amount": "12.50", "currency": "USD", "formatted": "$12.50"}
+    - Input: amount wajib berupa string, currency opsional (default DefaultCurrency)
+    - Angka JSON seperti 12.5 ditolak karena sudah melewati float di sisi client
+    - Digit pecahan melebihi aturan mata uang ditolak, misal "12.345" untuk USD
+4. Database :
+
+    - Decimal(StorageScale) menghasilkan string untuk kolom DECIMAL(19,4), misal "12.5000"
+    - Parse menerima nilai dari database karena nol di belakang diabaikan
+    - Entity menyimpan kolom bayangan dan mengisinya melalui hook BeforeSave/AfterFind
+5. Perhitungan :
+
+    - Add menjumlahkan dua Money dan menolak mata uang berbeda dengan ErrCurrencyMismatch
+    - Mul mengalikan dengan quantity, misal total baris order = harga satuan x quantity
+    - Add dan Mul gagal dengan ErrOverflow jika hasilnya melebihi batas DECIMAL(19,4) (15 digit sebelum koma), batas yang sama dengan Parse, sehingga total yang tersimpan selalu bisa dibaca kembali; overflow int64 juga terdeteksi
+6. Validasi :
+
+    - Aturan binding "money" di package validation memastikan mata uang didukung dan jumlah tidak negatif
+Semua perhitungan harga sebaiknya dilakukan dalam minor unit (int64), bukan float.
+*/
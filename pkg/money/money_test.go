@@ -0,0 +1,153 @@
+package money                                 // Mendefinisikan package money
+
+import (
+    "encoding/json"                           // Package untuk menguji encoding JSON
+    "errors"                                  // Package untuk pengecekan error
+    "math"                                    // Package untuk batas int64
+    "testing"                                 // Package testing bawaan Go
+)
+
+func TestParse(t *testing.T) {
+    tests := []struct {
+        amount   string
+        currency string
+        want     Money
+        err      error
+    }{
+        {"12.50", "USD", Money{1250, "USD"}, nil},
+        {"12.5", "usd", Money{1250, "USD"}, nil},
+        {"0012", "IDR", Money{1200, "IDR"}, nil},
+        {"12.5000", "USD", Money{1250, "USD"}, nil},  // Nol di belakang dari kolom DECIMAL(19,4)
+        {"1500", "JPY", Money{1500, "JPY"}, nil},
+        {"1.234", "KWD", Money{1234, "KWD"}, nil},
+        {"-3.10", "USD", Money{-310, "USD"}, nil},
+        {"999999999999999.99", "USD", Money{99999999999999999, "USD"}, nil},
+        {"1000000000000000", "USD", Money{}, ErrInvalidAmount},
+        {"12.345", "USD", Money{}, ErrInvalidAmount},
+        {"12.5", "JPY", Money{}, ErrInvalidAmount},
+        {"1e3", "USD", Money{}, ErrInvalidAmount},
+        {".50", "USD", Money{}, ErrInvalidAmount},
+        {"", "USD", Money{}, ErrInvalidAmount},
+        {"12.50", "XYZ", Money{}, ErrUnknownCurrency},
+    }
+    for _, tt := range tests {
+        got, err := Parse(tt.amount, tt.currency)
+        if !errors.Is(err, tt.err) || got != tt.want {
+            t.Errorf("Parse(%q, %q) = %v, %v; want %v, %v", tt.amount, tt.currency, got, err, tt.want, tt.err)
+        }
+    }
+}
+
+func TestAdd(t *testing.T) {
+    max := maxAmount("USD")
+    tests := []struct {
+        a, b Money
+        want Money
+        err  error
+    }{
+        {New(1250, "USD"), New(75, "USD"), New(1325, "USD"), nil},
+        {New(1250, "USD"), New(-1300, "USD"), New(-50, "USD"), nil},
+        {New(max-1, "USD"), New(1, "USD"), New(max, "USD"), nil},
+        {New(max, "USD"), New(1, "USD"), Money{}, ErrOverflow},
+        {New(-max, "USD"), New(-1, "USD"), Money{}, ErrOverflow},
+        {New(math.MaxInt64, "USD"), New(1, "USD"), Money{}, ErrOverflow},  // Overflow int64 sebelum batas DECIMAL
+        {New(100, "USD"), New(100, "IDR"), Money{}, ErrCurrencyMismatch},
+    }
+    for _, tt := range tests {
+        got, err := tt.a.Add(tt.b)
+        if !errors.Is(err, tt.err) || got != tt.want {
+            t.Errorf("%v.Add(%v) = %v, %v; want %v, %v", tt.a, tt.b, got, err, tt.want, tt.err)
+        }
+    }
+}
+
+func TestMul(t *testing.T) {
+    tests := []struct {
+        m    Money
+        n    int64
+        want Money
+        err  error
+    }{
+        {New(1250, "USD"), 3, New(3750, "USD"), nil},
+        {New(1250, "USD"), 0, New(0, "USD"), nil},
+        {New(-1250, "USD"), 2, New(-2500, "USD"), nil},
+        {New(1500, "JPY"), 1000000, New(1500000000, "JPY"), nil},
+        {New(maxAmount("USD"), "USD"), 1, New(maxAmount("USD"), "USD"), nil},
+        {New(maxAmount("USD"), "USD"), 2, Money{}, ErrOverflow},
+        {New(math.MaxInt64/2, "IDR"), 3, Money{}, ErrOverflow},  // Overflow int64 terdeteksi lewat hasil 128-bit
+        {New(1<<40, "KWD"), 1 << 40, Money{}, ErrOverflow},
+    }
+    for _, tt := range tests {
+        got, err := tt.m.Mul(tt.n)
+        if !errors.Is(err, tt.err) || got != tt.want {
+            t.Errorf("%v.Mul(%d) = %v, %v; want %v, %v", tt.m, tt.n, got, err, tt.want, tt.err)
+        }
+    }
+}
+
+func TestFormat(t *testing.T) {
+    tests := []struct {
+        m       Money
+        str     string
+        decimal string
+        format  string
+    }{
+        {New(123450, "USD"), "1234.50", "1234.5000", "$1,234.50"},
+        {New(123450, "IDR"), "1234.50", "1234.5000", "Rp1.234,50"},
+        {New(5, "USD"), "0.05", "0.0500", "$0.05"},
+        {New(-123450, "EUR"), "-1234.50", "-1234.5000", "-€1.234,50"},
+        {New(1234567, "JPY"), "1234567", "1234567.0000", "¥1,234,567"},
+        {New(1234, "KWD"), "1.234", "1.2340", "KD1.234"},
+    }
+    for _, tt := range tests {
+        if got := tt.m.String(); got != tt.str {
+            t.Errorf("%#v.String() = %q, want %q", tt.m, got, tt.str)
+        }
+        if got := tt.m.Decimal(StorageScale); got != tt.decimal {
+            t.Errorf("%#v.Decimal(%d) = %q, want %q", tt.m, StorageScale, got, tt.decimal)
+        }
+        if got := tt.m.Format(); got != tt.format {
+            t.Errorf("%#v.Format() = %q, want %q", tt.m, got, tt.format)
+        }
+    }
+}
+
+func TestJSON(t *testing.T) {
+    raw, err := json.Marshal(New(1250, "USD"))
+    if err != nil || string(raw) != `{"amount":"12.50","currency":"USD","formatted":"$12.50"}` {
+        t.Fatalf("Marshal = %s, %v", raw, err)
+    }
+
+    tests := []struct {
+        input string
+        want  Money
+        ok    bool
+    }{
+        {`{"amount": "12.50", "currency": "USD"}`, New(1250, "USD"), true},
+        {`{"amount": "12.50"}`, New(1250, DefaultCurrency), true},
+        {`{"amount": "12.50", "currency": "USD", "formatted": "$12.50"}`, New(1250, "USD"), true},  // Output Marshal bisa dikirim balik
+        {`{"amount": 12.5, "currency": "USD"}`, Money{}, false},  // Angka JSON ditolak agar tidak ada pembulatan float
+        {`{"amount": "12.50", "currency": "USD", "rate": 1}`, Money{}, false},
+        {`"12.50"`, Money{}, false},
+    }
+    for _, tt := range tests {
+        var got Money
+        err := json.Unmarshal([]byte(tt.input), &got)
+        if (err == nil) != tt.ok || got != tt.want {
+            t.Errorf("Unmarshal(%s) = %v, %v; want %v, ok %v", tt.input, got, err, tt.want, tt.ok)
+        }
+    }
+}
+
+
+// {{{ Penjelasan Test Money }}}
+
+/*
+## Penjelasan Detail
+File money_test.go ini menguji package money dengan table test. Berikut penjelasan detailnya:
+
+1. TestParse : Digit desimal per mata uang, nol di depan dan di belakang, batas DECIMAL(19,4), dan format yang ditolak
+2. TestAdd dan TestMul : Hasil normal, tepat di batas, ErrOverflow di atas batas DECIMAL(19,4) maupun overflow int64, dan ErrCurrencyMismatch
+3. TestFormat : String, Decimal(StorageScale), dan Format dengan simbol serta pemisah setiap mata uang, termasuk nilai negatif
+4. TestJSON : Bentuk JSON {"amount","currency","formatted"}, currency default, dan penolakan angka JSON serta field tidak dikenal
+*/
@@ -0,0 +1,126 @@
+package realtime                              // Mendefinisikan package realtime
+
+import (
+    "context"                                 // Package untuk context request handshake
+    "encoding/json"                           // Package untuk membaca pesan client
+    "sync"                                    // Package untuk menutup koneksi sekali saja
+    "time"                                    // Package untuk batas waktu baca dan tulis
+
+    "github.com/gorilla/websocket"            // Implementasi protokol WebSocket
+)
+
+const closeGracePeriod = time.Second          // Lama menunggu balasan close frame dari client
+
+// Client - satu koneksi WebSocket beserta topik langganannya
+type Client struct {
+    hub    *Hub                               // Hub pemilik koneksi
+    conn   *websocket.Conn                    // Koneksi WebSocket
+    user   User                               // User yang login saat handshake, untuk hak akses topik dan log
+    send   chan []byte                        // Antrean pesan keluar, tidak pernah ditutup
+    topics map[string]struct{}                // Topik langganan, dilindungi hub.mu
+
+    once      sync.Once                       // close hanya berjalan sekali
+    stop      chan struct{}                   // Ditutup saat koneksi harus diakhiri
+    closeCode int                             // Kode close frame, dibaca setelah stop ditutup
+    closeText string                          // Alasan close frame
+}
+
+type request struct {                         // Mendefinisikan struct pesan dari client
+    Action string   `json:"action"`           // subscribe atau unsubscribe
+    Topics []string `json:"topics"`           // Topik yang ditambah atau dilepas
+}
+
+func (c *Client) close(code int, text string) {  // Fungsi untuk meminta loop tulis mengirim close frame lalu berhenti
+    c.once.Do(func() {
+        c.closeCode, c.closeText = code, text
+        close(c.stop)
+    })
+}
+
+func (c *Client) readLoop(ctx context.Context) {  // Fungsi untuk membaca pesan client sampai koneksi berakhir; ctx diteruskan ke Options.Authorize
+    pongWait := 2 * c.hub.opts.PingInterval   // Client yang tidak membalas dua ping dianggap putus
+    c.conn.SetReadLimit(c.hub.opts.MaxMessageBytes)  // Pesan lebih besar ditolak dengan close frame 1009
+    c.conn.SetReadDeadline(time.Now().Add(pongWait))
+    c.conn.SetPongHandler(func(string) error {
+        select {
+        case <-c.stop:                        // Sedang menunggu balasan close frame, jangan perpanjang batas waktu
+            return nil
+        default:
+            return c.conn.SetReadDeadline(time.Now().Add(pongWait))
+        }
+    })
+
+    for {
+        kind, data, err := c.conn.ReadMessage()
+        if err != nil {                       // Close frame dari client, batas waktu, atau koneksi terputus
+            return
+        }
+        var req request
+        if kind != websocket.TextMessage || json.Unmarshal(data, &req) != nil {
+            c.reject([]string{}, "messages must be JSON text such as {\"action\":\"subscribe\",\"topics\":[\"product:42\"]}")
+            continue
+        }
+        switch req.Action {
+        case "subscribe":
+            if err := c.hub.subscribe(ctx, c, req.Topics); err != nil {
+                c.reject(req.Topics, err.Error())
+            }
+        case "unsubscribe":
+            c.hub.unsubscribe(c, req.Topics)
+        default:
+            c.reject(req.Topics, "action must be subscribe or unsubscribe")
+        }
+    }
+}
+
+func (c *Client) reject(topics []string, reason string) {  // Fungsi untuk mengirim pesan error tanpa memutus koneksi
+    c.hub.mu.Lock()
+    defer c.hub.mu.Unlock()
+    c.enqueue(Message{Type: "error", Topics: topics, Error: reason})
+}
+
+func (c *Client) writeLoop() {                // Fungsi untuk menulis pesan dan ping; satu-satunya goroutine yang menulis ke koneksi
+    ticker := time.NewTicker(c.hub.opts.PingInterval)
+    defer ticker.Stop()
+    for {
+        select {
+        case message := <-c.send:
+            c.conn.SetWriteDeadline(time.Now().Add(c.hub.opts.WriteTimeout))
+            if err := c.conn.WriteMessage(websocket.TextMessage, message); err != nil {
+                c.conn.Close()                // Loop baca ikut berhenti
+                return
+            }
+        case <-ticker.C:
+            if err := c.conn.WriteControl(websocket.PingMessage, nil, time.Now().Add(c.hub.opts.WriteTimeout)); err != nil {
+                c.conn.Close()
+                return
+            }
+        case <-c.stop:
+            c.conn.WriteControl(websocket.CloseMessage, websocket.FormatCloseMessage(c.closeCode, c.closeText), time.Now().Add(c.hub.opts.WriteTimeout))
+            c.conn.SetReadDeadline(time.Now().Add(closeGracePeriod))  // Loop baca berhenti saat client membalas atau batas waktu habis
+            return
+        }
+    }
+}
+
+
+// {{{ Penjelasan Client }}}
+
+/*
+## Penjelasan Detail
+File client.go ini berisi loop baca dan tulis untuk satu koneksi WebSocket. Berikut penjelasan detailnya:
+
+1. Protokol Client :
+
+    - {"action":"subscribe","topics":["product:42","category:7"]} menambah topik, {"action":"unsubscribe","topics":[...]} melepas topik
+    - Setiap perubahan dijawab pesan type subscriptions berisi semua topik koneksi
+    - Pesan yang tidak valid dan topik yang tidak diizinkan dijawab pesan type error tanpa memutus koneksi
+2. Loop Tulis :
+
+    - Satu goroutine per koneksi yang menulis pesan dari antrean send, karena gorilla/websocket tidak mengizinkan dua penulis bersamaan
+    - Ping dikirim setiap PingInterval; loop baca memperpanjang batas waktu baca setiap kali pong diterima
+3. Menutup Koneksi :
+
+    - close hanya menandai stop; loop tulis mengirim close frame dengan kode yang diminta (1001 shutdown, 1013 client lambat)
+    - Loop baca diberi closeGracePeriod untuk menerima balasan close frame, lalu Serve menutup koneksi TCP
+*/
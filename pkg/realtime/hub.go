@@ -0,0 +1,306 @@
+package realtime                              // Mendefinisikan package realtime
+
+import (
+    "context"                                 // Package untuk batas waktu shutdown
+    "encoding/json"                           // Package untuk pesan JSON
+    "errors"                                  // Package untuk membuat error
+    "fmt"                                     // Package untuk membungkus error
+    "log/slog"                                // Package structured logging bawaan Go
+    "net/http"                                // Package untuk request upgrade
+    "rest-api-go/pkg/auth"                    // Mengimpor peran user
+    "slices"                                  // Package untuk mengurutkan daftar topik
+    "sync"                                    // Package untuk mutex dan menunggu koneksi selesai
+    "time"                                    // Package untuk batas waktu tulis dan ping
+
+    "github.com/gorilla/websocket"            // Implementasi protokol WebSocket
+)
+
+// ErrClosed - hub sedang berhenti dan tidak menerima koneksi baru
+var ErrClosed = errors.New("realtime: hub is shutting down")
+
+// HandshakeError - permintaan upgrade ditolak; respons belum ditulis sehingga pemanggil dapat mengirim error dengan formatnya sendiri
+type HandshakeError struct {
+    Status int                                // Status HTTP yang disarankan
+    Reason string                             // Alasan penolakan
+}
+
+func (e *HandshakeError) Error() string {
+    return e.Reason
+}
+
+// User - user yang membuka koneksi, dari token saat handshake
+type User struct {
+    ID   uint                                 // ID user, juga dicatat di log
+    Role auth.Role                            // Peran user untuk Options.Authorize
+}
+
+// Options - pengaturan Hub, nilai kosong memakai default
+type Options struct {
+    SendBuffer      int                       // Pesan yang boleh antre untuk satu koneksi sebelum koneksi diputus, default 256
+    WriteTimeout    time.Duration             // Batas waktu menulis satu pesan, default 10 detik
+    PingInterval    time.Duration             // Interval ping; koneksi tanpa pong selama dua interval diputus, default 30 detik
+    MaxMessageBytes int64                     // Ukuran maksimum pesan dari client, default 4 KiB
+    MaxTopics       int                       // Jumlah topik per koneksi, default 100
+    ValidTopic      func(topic string) bool   // Memeriksa nama topik dari client, nil berarti semua topik selain string kosong diterima
+    Authorize       func(ctx context.Context, user User, topic string) error  // Memeriksa hak user atas topik yang namanya valid, nil berarti semua user boleh; error dikirim ke client
+}
+
+// Message - pesan JSON yang dikirim ke client
+type Message struct {
+    Type   string          `json:"type"`              // event, subscriptions, atau error
+    Topics []string        `json:"topics"`            // Topik event yang cocok, semua topik koneksi untuk subscriptions, atau topik request untuk error
+    Event  json.RawMessage `json:"event,omitempty"`   // Isi event untuk type event
+    Error  string          `json:"error,omitempty"`   // Pesan error untuk type error
+}
+
+// Hub - menyimpan koneksi WebSocket beserta topiknya dan meneruskan pesan ke koneksi yang berlangganan
+type Hub struct {
+    opts     Options                          // Pengaturan dengan default yang sudah diisi
+    upgrader websocket.Upgrader               // Upgrader dasar, disalin per request
+
+    mu      sync.Mutex                        // Melindungi seluruh field di bawah dan topik setiap Client
+    clients map[*Client]struct{}              // Koneksi yang sedang terbuka
+    topics  map[string]map[*Client]struct{}   // Koneksi per topik
+    closed  bool                              // Shutdown sudah dipanggil
+    wg      sync.WaitGroup                    // Koneksi yang belum selesai
+}
+
+// NewHub - membuat hub; koneksi diterima lewat Serve
+func NewHub(opts Options) *Hub {
+    if opts.SendBuffer <= 0 {
+        opts.SendBuffer = 256
+    }
+    if opts.WriteTimeout <= 0 {
+        opts.WriteTimeout = 10 * time.Second
+    }
+    if opts.PingInterval <= 0 {
+        opts.PingInterval = 30 * time.Second
+    }
+    if opts.MaxMessageBytes <= 0 {
+        opts.MaxMessageBytes = 4 << 10
+    }
+    if opts.MaxTopics <= 0 {
+        opts.MaxTopics = 100
+    }
+    return &Hub{
+        opts:     opts,
+        upgrader: websocket.Upgrader{HandshakeTimeout: opts.WriteTimeout},  // CheckOrigin bawaan menolak halaman dari origin lain
+        clients:  map[*Client]struct{}{},
+        topics:   map[string]map[*Client]struct{}{},
+    }
+}
+
+// Serve - meng-upgrade request menjadi WebSocket lalu melayani koneksi sampai ditutup; user dipakai untuk Options.Authorize dan log.
+// Error hanya dikembalikan jika upgrade gagal (HandshakeError) atau hub sedang berhenti (ErrClosed); keduanya belum menulis respons.
+func (h *Hub) Serve(w http.ResponseWriter, r *http.Request, user User) error {
+    h.mu.Lock()
+    if h.closed {
+        h.mu.Unlock()
+        return ErrClosed
+    }
+    h.wg.Add(1)                               // Shutdown menunggu koneksi ini selesai
+    h.mu.Unlock()
+    defer h.wg.Done()
+
+    var handshake *HandshakeError
+    upgrader := h.upgrader                    // Salinan agar penolakan dicatat per request
+    upgrader.Error = func(_ http.ResponseWriter, _ *http.Request, status int, reason error) {
+        handshake = &HandshakeError{Status: status, Reason: reason.Error()}
+    }
+    conn, err := upgrader.Upgrade(w, r, nil)
+    if err != nil {
+        if handshake != nil {
+            return handshake
+        }
+        return &HandshakeError{Status: http.StatusBadRequest, Reason: err.Error()}
+    }
+
+    client := &Client{
+        hub:    h,
+        conn:   conn,
+        user:   user,
+        send:   make(chan []byte, h.opts.SendBuffer),
+        topics: map[string]struct{}{},
+        stop:   make(chan struct{}),
+    }
+    h.mu.Lock()
+    if h.closed {                             // Shutdown dimulai selama handshake
+        client.close(websocket.CloseGoingAway, "server shutting down")
+    } else {
+        h.clients[client] = struct{}{}
+    }
+    h.mu.Unlock()
+
+    written := make(chan struct{})
+    go func() {
+        client.writeLoop()
+        close(written)
+    }()
+    client.readLoop(r.Context())              // Berhenti saat client menutup koneksi, koneksi error, atau close frame sudah dikirim
+    client.close(websocket.CloseNormalClosure, "")
+    <-written
+    conn.Close()
+    h.remove(client)
+    return nil
+}
+
+// Publish - mengirim payload ke setiap koneksi yang berlangganan salah satu topik; satu koneksi menerima satu pesan berisi topik yang cocok
+func (h *Hub) Publish(topics []string, payload any) error {
+    raw, err := json.Marshal(payload)
+    if err != nil {
+        return fmt.Errorf("realtime: encode payload: %w", err)
+    }
+
+    h.mu.Lock()
+    defer h.mu.Unlock()
+    matched := map[*Client][]string{}         // Topik yang cocok per koneksi
+    for _, topic := range topics {
+        for client := range h.topics[topic] {
+            matched[client] = append(matched[client], topic)
+        }
+    }
+    for client, clientTopics := range matched {
+        client.enqueue(Message{Type: "event", Topics: clientTopics, Event: raw})
+    }
+    return nil
+}
+
+// Shutdown - mengirim close frame ke semua koneksi dan menunggu koneksi selesai; koneksi WebSocket tidak ditunggu oleh http.Server.Shutdown
+func (h *Hub) Shutdown(ctx context.Context) error {
+    h.mu.Lock()
+    h.closed = true
+    for client := range h.clients {
+        client.close(websocket.CloseGoingAway, "server shutting down")  // Client tersambung ulang ke instance lain
+    }
+    h.mu.Unlock()
+
+    done := make(chan struct{})
+    go func() {
+        h.wg.Wait()
+        close(done)
+    }()
+    select {
+    case <-done:
+        return nil
+    case <-ctx.Done():
+        return fmt.Errorf("realtime: connections did not close: %w", ctx.Err())
+    }
+}
+
+func (h *Hub) subscribe(ctx context.Context, client *Client, topics []string) error {  // Fungsi untuk menambah topik koneksi; semua topik ditolak jika satu saja tidak valid atau tidak diizinkan
+    topics = slices.Compact(slices.Sorted(slices.Values(topics)))  // Topik duplikat di request dihitung sekali
+    for _, topic := range topics {
+        if topic == "" || (h.opts.ValidTopic != nil && !h.opts.ValidTopic(topic)) {
+            return fmt.Errorf("invalid topic %q", topic)
+        }
+    }
+    if h.opts.Authorize != nil {
+        for _, topic := range topics {        // Sebelum h.mu dikunci karena Authorize boleh membaca database
+            if err := h.opts.Authorize(ctx, client.user, topic); err != nil {
+                return err
+            }
+        }
+    }
+
+    h.mu.Lock()
+    defer h.mu.Unlock()
+    added := 0
+    for _, topic := range topics {
+        if _, ok := client.topics[topic]; !ok {
+            added++
+        }
+    }
+    if len(client.topics)+added > h.opts.MaxTopics {
+        return fmt.Errorf("a connection can subscribe to at most %d topics", h.opts.MaxTopics)
+    }
+    for _, topic := range topics {
+        client.topics[topic] = struct{}{}
+        if h.topics[topic] == nil {
+            h.topics[topic] = map[*Client]struct{}{}
+        }
+        h.topics[topic][client] = struct{}{}
+    }
+    client.enqueue(client.subscriptions())
+    return nil
+}
+
+func (h *Hub) unsubscribe(client *Client, topics []string) {  // Fungsi untuk melepas topik koneksi; topik yang tidak diikuti diabaikan
+    h.mu.Lock()
+    defer h.mu.Unlock()
+    for _, topic := range topics {
+        h.untrack(client, topic)
+    }
+    client.enqueue(client.subscriptions())
+}
+
+func (h *Hub) remove(client *Client) {        // Fungsi untuk melepas koneksi yang sudah selesai
+    h.mu.Lock()
+    defer h.mu.Unlock()
+    for topic := range client.topics {
+        h.untrack(client, topic)
+    }
+    delete(h.clients, client)
+}
+
+func (h *Hub) untrack(client *Client, topic string) {  // Fungsi untuk melepas satu topik koneksi; h.mu harus dipegang
+    delete(client.topics, topic)
+    delete(h.topics[topic], client)
+    if len(h.topics[topic]) == 0 {
+        delete(h.topics, topic)               // Topik tanpa koneksi tidak disimpan
+    }
+}
+
+func (c *Client) subscriptions() Message {    // Fungsi untuk membuat pesan berisi semua topik koneksi; hub.mu harus dipegang
+    topics := make([]string, 0, len(c.topics))
+    for topic := range c.topics {
+        topics = append(topics, topic)
+    }
+    slices.Sort(topics)
+    return Message{Type: "subscriptions", Topics: topics}
+}
+
+func (c *Client) enqueue(message Message) {   // Fungsi untuk mengantrekan pesan tanpa menunggu; koneksi yang antreannya penuh diputus
+    select {
+    case <-c.stop:                            // Koneksi sedang ditutup, pesan dibuang
+        return
+    default:
+    }
+    raw, err := json.Marshal(message)
+    if err != nil {
+        slog.Error("failed to encode websocket message", "error", err)
+        return
+    }
+    select {
+    case c.send <- raw:
+    default:                                  // Client tidak membaca cukup cepat; diputus agar tidak menahan memori dan client lain
+        slog.Warn("websocket client too slow, disconnecting", "user_id", c.user.ID)
+        c.close(websocket.CloseTryAgainLater, "client too slow")
+    }
+}
+
+
+// {{{ Penjelasan Hub }}}
+
+/*
+## Penjelasan Detail
+File hub.go ini berisi Hub yang mengelola koneksi WebSocket dan topik langganannya. Berikut penjelasan detailnya:
+
+1. Koneksi :
+
+    - Serve meng-upgrade request lalu menjalankan loop baca di goroutine request dan loop tulis di goroutine sendiri (lihat client.go)
+    - Penolakan upgrade dikembalikan sebagai HandshakeError tanpa menulis respons, sehingga handler dapat menjawab dengan format error aplikasi
+2. Topik :
+
+    - Nama topik bebas bagi Hub; modul yang memakai Hub memeriksa nama lewat Options.ValidTopic dan hak akses per user lewat Options.Authorize
+    - Authorize dipanggil untuk setiap topik sebelum ada yang ditambahkan, dengan context request handshake dan User (ID dan peran dari token)
+    - Publish mengirim satu pesan type event per koneksi berisi topik yang cocok, sehingga event yang cocok dengan dua topik tidak terkirim dua kali
+3. Backpressure :
+
+    - Publish tidak pernah menunggu; setiap koneksi punya antrean SendBuffer pesan
+    - Jika antrean penuh, koneksi ditutup dengan kode 1013 (try again later) dan client perlu tersambung ulang lalu memuat ulang data
+    - Setiap tulis dibatasi WriteTimeout, sehingga koneksi TCP yang macet ikut diputus
+4. Shutdown :
+
+    - Koneksi baru ditolak dengan ErrClosed, koneksi yang terbuka menerima close frame 1001 (going away)
+    - Koneksi hasil hijack tidak ditunggu oleh http.Server.Shutdown, sehingga Shutdown menunggu semua koneksi selesai sendiri
+*/
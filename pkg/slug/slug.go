@@ -0,0 +1,80 @@
+package slug                                  // Mendefinisikan package slug
+
+import (
+    "regexp"                                  // Package untuk memeriksa format slug
+    "strings"                                 // Package untuk manipulasi string
+    "unicode"                                 // Package untuk mengenali tanda diakritik
+
+    "golang.org/x/text/unicode/norm"          // Normalisasi Unicode untuk memisahkan huruf dan diakritik
+)
+
+const (
+    MaxLength = 191                           // Panjang maksimum slug (batas index utf8mb4)
+    maxBase   = MaxLength - 11                // Slug buatan disisakan ruang untuk suffix "-<angka>"
+)
+
+var (
+    pattern = regexp.MustCompile(`^[a-z0-9]+(?:-[a-z0-9]+)*$`)  // Huruf kecil, angka, dan satu tanda hubung di antaranya
+
+    // Huruf yang tidak memiliki bentuk dasar setelah normalisasi NFKD
+    special = strings.NewReplacer(
+        "ß", "ss", "æ", "ae", "Æ", "ae", "œ", "oe", "Œ", "oe",
+        "ø", "o", "Ø", "o", "đ", "d", "Đ", "d", "ð", "d", "Ð", "d",
+        "ł", "l", "Ł", "l", "þ", "th", "Þ", "th", "ı", "i",
+    )
+)
+
+// Make - mengubah teks bebas menjadi slug, misal "Café Crème Brûlée" menjadi "cafe-creme-brulee"
+func Make(s string) string {
+    s = norm.NFKD.String(special.Replace(s))  // "é" dipisah menjadi "e" + tanda aksen
+    var b strings.Builder
+    hyphen := false                           // Tanda hubung ditunda sampai ada huruf berikutnya
+    for _, r := range s {
+        switch {
+        case unicode.Is(unicode.Mn, r):       // Tanda diakritik dibuang
+            continue
+        case r >= 'a' && r <= 'z', r >= '0' && r <= '9':
+        case r >= 'A' && r <= 'Z':
+            r = unicode.ToLower(r)
+        default:                              // Spasi, tanda baca, dan huruf non-Latin menjadi pemisah
+            hyphen = b.Len() > 0
+            continue
+        }
+        if hyphen {
+            b.WriteByte('-')
+            hyphen = false
+        }
+        if b.Len() >= maxBase {
+            break
+        }
+        b.WriteRune(r)
+    }
+    return strings.TrimRight(b.String(), "-")
+}
+
+// Valid - memeriksa slug yang diisi manual
+func Valid(s string) bool {
+    return len(s) <= MaxLength && pattern.MatchString(s)
+}
+
+
+
+// {{{ Penjelasan Package Slug }}}
+
+/*
+## Penjelasan Detail
+File slug.go ini berisi fungsi untuk membuat slug URL dari judul atau nama. Berikut penjelasan detailnya:
+
+1. Make :
+
+    - Transliterasi: huruf beraksen diubah ke huruf dasarnya melalui normalisasi NFKD ("Crème" menjadi "creme")
+    - Huruf khusus seperti ß, æ, ø, ł diganti dengan padanan Latin-nya
+    - Semua karakter lain (spasi, tanda baca, huruf non-Latin) menjadi satu tanda hubung
+    - Hasil dipotong menjadi 180 karakter agar suffix "-2", "-3", dan seterusnya masih muat
+    - Teks tanpa huruf Latin sama sekali menghasilkan string kosong, pemanggil memakai nilai cadangan
+2. Valid :
+
+    - Dipakai aturan validasi "slug" untuk slug yang diisi manual
+    - Hanya huruf kecil, angka, dan tanda hubung tunggal di antaranya, maksimal 191 karakter
+Keunikan slug dan riwayat slug lama diatur di store.go.
+*/
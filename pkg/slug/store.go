@@ -0,0 +1,161 @@
+package slug                                  // Mendefinisikan package slug
+
+import (
+    "errors"                                  // Package untuk pengecekan error
+    "strconv"                                 // Package untuk suffix angka
+    "time"                                    // Package time untuk tipe data waktu
+
+    "gorm.io/gorm"                            // Mengimpor ORM GORM
+    "gorm.io/gorm/clause"                     // Klausa ON DUPLICATE KEY UPDATE
+)
+
+// Redirect - slug lama yang masih diarahkan ke record pemiliknya
+type Redirect struct {
+    ID        uint      `gorm:"primaryKey"`  // ID redirect sebagai primary key
+    Scope     string    `gorm:"size:64;not null;uniqueIndex:idx_slug_redirect"`  // Nama tabel pemilik slug, misal "products"
+    Slug      string    `gorm:"size:191;not null;uniqueIndex:idx_slug_redirect"`  // Slug lama
+    TargetID  uint      `gorm:"not null;index"`  // ID record yang memakai slug ini dulu
+    CreatedAt time.Time                         // Waktu slug berhenti dipakai
+}
+
+func (Redirect) TableName() string {          // Nama tabel dipakai bersama oleh semua modul
+    return "slug_redirects"
+}
+
+// Generate - membuat slug unik dari source untuk record id di tabel table (id 0 untuk record baru)
+func Generate(tx *gorm.DB, table string, id uint, source, fallback string) (string, error) {
+    return GenerateExcept(tx, table, id, source, fallback, nil)
+}
+
+// GenerateExcept - seperti Generate, tetapi juga menghindari slug yang reserved(slug) bernilai true, misal slug item lain yang belum disimpan
+func GenerateExcept(tx *gorm.DB, table string, id uint, source, fallback string, reserved func(string) bool) (string, error) {
+    base := Make(source)
+    if base == "" {
+        base = fallback                       // Judul tanpa huruf Latin
+    }
+
+    var current, old []string                 // Slug dengan awalan yang sama milik record lain
+    if err := tx.Table(table).Where("id <> ? AND slug LIKE ?", id, base+"%").Pluck("slug", &current).Error; err != nil {
+        return "", err
+    }
+    if err := tx.Model(&Redirect{}).Where("scope = ? AND target_id <> ? AND slug LIKE ?", table, id, base+"%").Pluck("slug", &old).Error; err != nil {
+        return "", err
+    }
+    taken := make(map[string]bool, len(current)+len(old))
+    for _, s := range append(current, old...) {
+        taken[s] = true
+    }
+
+    candidate := base
+    for n := 2; taken[candidate] || (reserved != nil && reserved(candidate)); n++ {  // "kaos", "kaos-2", "kaos-3", ...
+        candidate = base + "-" + strconv.Itoa(n)
+    }
+    return candidate, nil
+}
+
+// Available - memeriksa apakah slug belum dipakai record lain, termasuk sebagai slug lama
+func Available(tx *gorm.DB, table string, id uint, s string) (bool, error) {
+    var count int64
+    if err := tx.Table(table).Where("slug = ? AND id <> ?", s, id).Count(&count).Error; err != nil {
+        return false, err
+    }
+    if count > 0 {
+        return false, nil
+    }
+    if err := tx.Model(&Redirect{}).Where("scope = ? AND slug = ? AND target_id <> ?", table, s, id).Count(&count).Error; err != nil {
+        return false, err
+    }
+    return count == 0, nil
+}
+
+// Rename - menyimpan slug lama sebagai redirect setelah slug record berubah
+func Rename(tx *gorm.DB, table string, id uint, oldSlug, newSlug string) error {
+    if oldSlug == newSlug {
+        return nil
+    }
+    if err := tx.Where("scope = ? AND slug = ?", table, newSlug).Delete(&Redirect{}).Error; err != nil {  // Slug lama milik record ini dipakai lagi
+        return err
+    }
+    if oldSlug == "" {
+        return nil                            // Record belum pernah memiliki slug
+    }
+    return tx.Clauses(clause.OnConflict{      // Slug yang sama bisa menjadi slug lama lebih dari sekali
+        Columns:   []clause.Column{{Name: "scope"}, {Name: "slug"}},
+        DoUpdates: clause.AssignmentColumns([]string{"target_id", "created_at"}),
+    }).Create(&Redirect{Scope: table, Slug: oldSlug, TargetID: id}).Error
+}
+
+// Resolve - mencari record pemilik slug lama
+func Resolve(tx *gorm.DB, table, s string) (uint, bool, error) {
+    var redirect Redirect
+    err := tx.Where("scope = ? AND slug = ?", table, s).First(&redirect).Error
+    if errors.Is(err, gorm.ErrRecordNotFound) {
+        return 0, false, nil
+    }
+    if err != nil {
+        return 0, false, err
+    }
+    return redirect.TargetID, true, nil
+}
+
+// Forget - menghapus semua slug lama milik record yang dihapus
+func Forget(tx *gorm.DB, table string, id uint) error {
+    return tx.Where("scope = ? AND target_id = ?", table, id).Delete(&Redirect{}).Error
+}
+
+// Backfill - mengisi slug untuk record lama yang dibuat sebelum kolom slug ada
+func Backfill(db *gorm.DB, table, column, fallback string) error {
+    var rows []struct {
+        ID     uint
+        Source string
+    }
+    if err := db.Table(table).Select("id, "+column+" AS source").Where("slug IS NULL OR slug = ''").Order("id").Scan(&rows).Error; err != nil {
+        return err
+    }
+    for _, row := range rows {                // Satu per satu agar slug sebelumnya ikut diperhitungkan
+        s, err := Generate(db, table, row.ID, row.Source, fallback)
+        if err != nil {
+            return err
+        }
+        if err := db.Table(table).Where("id = ?", row.ID).Update("slug", s).Error; err != nil {
+            return err
+        }
+    }
+    return nil
+}
+
+
+
+// {{{ Penjelasan Store Slug }}}
+
+/*
+## Penjelasan Detail
+File store.go ini berisi fungsi database untuk slug yang dipakai bersama oleh modul product dan category. Berikut penjelasan detailnya:
+
+1. Redirect :
+
+    - Tabel slug_redirects menyimpan slug lama per scope (nama tabel) beserta ID record pemiliknya
+    - Redirect menunjuk ke ID, bukan ke slug baru, sehingga rantai redirect tidak pernah terbentuk
+2. Generate :
+
+    - Membuat slug dari judul dengan Make, lalu menambahkan suffix -2, -3, dan seterusnya jika sudah dipakai
+    - Slug yang sedang dipakai record lain maupun slug lama milik record lain dianggap terpakai
+    - Satu query per tabel untuk semua slug dengan awalan yang sama, bukan satu query per kandidat
+    - GenerateExcept juga melewati slug yang sudah dibagikan ke record yang belum disimpan, misal item lain dalam satu INSERT bulk
+3. Available :
+
+    - Dipakai saat slug diisi manual; service mengembalikan 409 jika slug tidak tersedia
+    - Slug lama milik record itu sendiri boleh dipakai lagi
+4. Rename :
+
+    - Dipanggil di dalam transaksi yang sama dengan update record
+    - Slug lama disimpan sebagai redirect, slug baru dihapus dari daftar redirect jika sebelumnya slug lama record ini
+5. Resolve dan Forget :
+
+    - Resolve dipakai endpoint by-slug untuk mengembalikan 301 ke slug terbaru
+    - Forget dipanggil saat record dihapus agar slug lamanya bisa dipakai record lain
+6. Backfill :
+
+    - Dipanggil oleh migration.Run untuk record yang sudah ada sebelum kolom slug ditambahkan
+Kolom slug di tabel product dan category memiliki unique index, sehingga dua request bersamaan yang menghasilkan slug sama tetap tidak dapat menyimpan duplikat.
+*/
@@ -0,0 +1,96 @@
+package storage                               // Mendefinisikan package storage
+
+import (
+    "context"                                 // Package untuk context request
+    "errors"                                  // Package untuk pengecekan error
+    "fmt"                                     // Package untuk formatting pesan error
+    "os"                                      // Package untuk operasi file
+    "path/filepath"                           // Package untuk path file lintas OS
+    "strings"                                 // Package untuk manipulasi string
+)
+
+// Local - Storage yang menyimpan file di folder lokal
+type Local struct {
+    dir     string                            // Folder tujuan
+    baseURL string                            // Awalan URL publik, misal "/uploads"
+}
+
+// NewLocal - membuat Local dan memastikan foldernya ada
+func NewLocal(dir, baseURL string) (*Local, error) {
+    if err := os.MkdirAll(dir, 0o755); err != nil {
+        return nil, err
+    }
+    return &Local{dir: dir, baseURL: strings.TrimRight(baseURL, "/")}, nil
+}
+
+func (l *Local) Put(ctx context.Context, key string, data []byte, contentType string) error {  // Menyimpan file secara atomik
+    path, err := l.path(key)
+    if err != nil {
+        return err
+    }
+    if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+        return err
+    }
+
+    tmp, err := os.CreateTemp(filepath.Dir(path), ".upload-*")  // Tulis ke file sementara dulu agar client tidak pernah membaca file setengah jadi
+    if err != nil {
+        return err
+    }
+    defer os.Remove(tmp.Name())               // Tidak berpengaruh setelah rename berhasil
+    if _, err := tmp.Write(data); err != nil {
+        tmp.Close()
+        return err
+    }
+    if err := tmp.Close(); err != nil {
+        return err
+    }
+    if err := os.Chmod(tmp.Name(), 0o644); err != nil {  // CreateTemp membuat file 0600
+        return err
+    }
+    return os.Rename(tmp.Name(), path)
+}
+
+func (l *Local) Delete(ctx context.Context, key string) error {  // Menghapus file
+    path, err := l.path(key)
+    if err != nil {
+        return err
+    }
+    if err := os.Remove(path); err != nil && !errors.Is(err, os.ErrNotExist) {
+        return err
+    }
+    return nil
+}
+
+func (l *Local) URL(key string) string {      // URL publik file
+    return l.baseURL + "/" + key
+}
+
+func (l *Local) path(key string) (string, error) {  // Fungsi untuk mengubah key menjadi path di dalam folder
+    path := filepath.Join(l.dir, filepath.FromSlash(key))
+    if rel, err := filepath.Rel(l.dir, path); err != nil || rel == "." || strings.HasPrefix(rel, "..") {
+        return "", fmt.Errorf("storage: invalid key %q", key)  // Key tidak boleh keluar dari folder
+    }
+    return path, nil
+}
+
+
+
+// {{{ Penjelasan Storage Local }}}
+
+/*
+## Penjelasan Detail
+File local.go ini berisi implementasi Storage yang menyimpan file di disk. Berikut penjelasan detailnya:
+
+1. Konfigurasi :
+
+    - STORAGE_LOCAL_DIR : Folder penyimpanan (default "uploads")
+    - STORAGE_PUBLIC_URL : Awalan URL (default "/uploads"), main.go menyajikan folder ini dengan r.Static
+2. Put :
+
+    - File ditulis ke file sementara di folder yang sama lalu di-rename, sehingga file selalu utuh
+    - Sub-folder dibuat otomatis sesuai key
+3. Keamanan :
+
+    - Key yang mengandung ".." sehingga keluar dari folder penyimpanan ditolak
+Cocok untuk development atau server tunggal; untuk beberapa instance gunakan S3.
+*/
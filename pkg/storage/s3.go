@@ -0,0 +1,204 @@
+package storage                               // Mendefinisikan package storage
+
+import (
+    "bytes"                                   // Package untuk body request
+    "context"                                 // Package untuk context request
+    "crypto/hmac"                             // HMAC untuk tanda tangan AWS Signature V4
+    "crypto/sha256"                           // Hash payload dan request
+    "encoding/hex"                            // Encoding hash
+    "errors"                                  // Package untuk membuat error
+    "fmt"                                     // Package untuk formatting string
+    "io"                                      // Package untuk membaca body respons
+    "net/http"                                // Client HTTP
+    "net/url"                                 // Package untuk membangun URL object
+    "sort"                                    // Package untuk mengurutkan header yang ditandatangani
+    "strings"                                 // Package untuk manipulasi string
+    "time"                                    // Package untuk waktu tanda tangan
+)
+
+// S3Config - konfigurasi layanan kompatibel S3
+type S3Config struct {
+    Endpoint  string                          // Misal "http://localhost:9000" untuk MinIO, kosong untuk AWS
+    Region    string                          // Region, misal "us-east-1"
+    Bucket    string                          // Nama bucket
+    AccessKey string                          // Access key ID
+    SecretKey string                          // Secret access key
+    PathStyle bool                            // true: endpoint/bucket/key (MinIO), false: bucket.endpoint/key (AWS)
+    PublicURL string                          // Awalan URL publik opsional (CDN), kosong memakai URL object
+}
+
+// S3 - Storage untuk AWS S3 dan layanan kompatibel S3
+type S3 struct {
+    cfg      S3Config
+    endpoint *url.URL
+    client   *http.Client
+    now      func() time.Time                 // Sumber waktu untuk tanda tangan
+}
+
+// NewS3 - membuat S3 dan memeriksa konfigurasinya
+func NewS3(cfg S3Config) (*S3, error) {
+    if cfg.Bucket == "" || cfg.AccessKey == "" || cfg.SecretKey == "" {
+        return nil, errors.New("storage: S3_BUCKET, S3_ACCESS_KEY and S3_SECRET_KEY are required")
+    }
+    if cfg.Region == "" {
+        cfg.Region = "us-east-1"
+    }
+    if cfg.Endpoint == "" {
+        cfg.Endpoint = "https://s3." + cfg.Region + ".amazonaws.com"
+    }
+    endpoint, err := url.Parse(strings.TrimRight(cfg.Endpoint, "/"))
+    if err != nil || endpoint.Host == "" {
+        return nil, fmt.Errorf("storage: invalid S3_ENDPOINT %q", cfg.Endpoint)
+    }
+    cfg.PublicURL = strings.TrimRight(cfg.PublicURL, "/")
+    return &S3{cfg: cfg, endpoint: endpoint, client: &http.Client{Timeout: 30 * time.Second}, now: time.Now}, nil
+}
+
+func (s *S3) Put(ctx context.Context, key string, data []byte, contentType string) error {  // PUT object
+    req, err := http.NewRequestWithContext(ctx, http.MethodPut, s.objectURL(key), bytes.NewReader(data))
+    if err != nil {
+        return err
+    }
+    req.Header.Set("Content-Type", contentType)
+    return s.do(req, data, http.StatusOK)
+}
+
+func (s *S3) Delete(ctx context.Context, key string) error {  // DELETE object, object yang tidak ada tetap dijawab 204
+    req, err := http.NewRequestWithContext(ctx, http.MethodDelete, s.objectURL(key), nil)
+    if err != nil {
+        return err
+    }
+    return s.do(req, nil, http.StatusNoContent, http.StatusOK, http.StatusNotFound)
+}
+
+func (s *S3) URL(key string) string {         // URL publik object
+    if s.cfg.PublicURL != "" {
+        return s.cfg.PublicURL + "/" + key
+    }
+    return s.objectURL(key)
+}
+
+func (s *S3) objectURL(key string) string {   // Fungsi untuk membangun URL object sesuai gaya path atau virtual host
+    u := *s.endpoint
+    if s.cfg.PathStyle {
+        u.Path = u.Path + "/" + s.cfg.Bucket + "/" + key
+    } else {
+        u.Host = s.cfg.Bucket + "." + u.Host
+        u.Path = u.Path + "/" + key
+    }
+    u.RawPath = escapePath(u.Path)
+    return u.String()
+}
+
+func (s *S3) do(req *http.Request, payload []byte, expected ...int) error {  // Fungsi untuk menandatangani dan mengirim request
+    sign(req, payload, s.cfg, s.now())
+    resp, err := s.client.Do(req)
+    if err != nil {
+        return err
+    }
+    defer resp.Body.Close()
+    for _, status := range expected {
+        if resp.StatusCode == status {
+            io.Copy(io.Discard, resp.Body)    // Habiskan body agar koneksi dapat dipakai ulang
+            return nil
+        }
+    }
+    body, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))  // Pesan error XML dari S3
+    return fmt.Errorf("storage: S3 %s %s: %s: %s", req.Method, req.URL.Path, resp.Status, strings.TrimSpace(string(body)))
+}
+
+// sign - menambahkan header Authorization AWS Signature Version 4
+func sign(req *http.Request, payload []byte, cfg S3Config, now time.Time) {
+    now = now.UTC()
+    amzDate := now.Format("20060102T150405Z")
+    date := now.Format("20060102")
+    payloadHash := sha256.Sum256(payload)
+
+    req.Header.Set("X-Amz-Date", amzDate)
+    req.Header.Set("X-Amz-Content-Sha256", hex.EncodeToString(payloadHash[:]))
+
+    headers := map[string]string{"host": req.URL.Host}  // Semua header yang sudah diisi ikut ditandatangani
+    for name, values := range req.Header {
+        headers[strings.ToLower(name)] = strings.TrimSpace(strings.Join(values, ","))
+    }
+    names := make([]string, 0, len(headers))
+    for name := range headers {
+        names = append(names, name)
+    }
+    sort.Strings(names)
+    var canonicalHeaders strings.Builder
+    for _, name := range names {
+        canonicalHeaders.WriteString(name + ":" + headers[name] + "\n")
+    }
+    signedHeaders := strings.Join(names, ";")
+
+    canonicalRequest := strings.Join([]string{
+        req.Method,
+        req.URL.EscapedPath(),
+        req.URL.Query().Encode(),             // Parameter query diurutkan berdasarkan nama
+        canonicalHeaders.String(),
+        signedHeaders,
+        hex.EncodeToString(payloadHash[:]),
+    }, "\n")
+    scope := date + "/" + cfg.Region + "/s3/aws4_request"
+    requestHash := sha256.Sum256([]byte(canonicalRequest))
+    stringToSign := "AWS4-HMAC-SHA256\n" + amzDate + "\n" + scope + "\n" + hex.EncodeToString(requestHash[:])
+
+    key := hmacSHA256([]byte("AWS4"+cfg.SecretKey), date)
+    key = hmacSHA256(key, cfg.Region)
+    key = hmacSHA256(key, "s3")
+    key = hmacSHA256(key, "aws4_request")
+    signature := hex.EncodeToString(hmacSHA256(key, stringToSign))
+
+    req.Header.Set("Authorization", fmt.Sprintf("AWS4-HMAC-SHA256 Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+        cfg.AccessKey, scope, signedHeaders, signature))
+}
+
+func hmacSHA256(key []byte, data string) []byte {  // Fungsi helper HMAC-SHA256
+    mac := hmac.New(sha256.New, key)
+    mac.Write([]byte(data))
+    return mac.Sum(nil)
+}
+
+func escapePath(path string) string {         // Fungsi untuk URI-encode path sesuai aturan AWS (hanya A-Z a-z 0-9 - _ . ~ dan /)
+    var b strings.Builder
+    for i := 0; i < len(path); i++ {
+        c := path[i]
+        if c >= 'A' && c <= 'Z' || c >= 'a' && c <= 'z' || c >= '0' && c <= '9' || strings.IndexByte("-_.~/", c) >= 0 {
+            b.WriteByte(c)
+        } else {
+            fmt.Fprintf(&b, "%%%02X", c)
+        }
+    }
+    return b.String()
+}
+
+
+
+// {{{ Penjelasan Storage S3 }}}
+
+/*
+## Penjelasan Detail
+File s3.go ini berisi implementasi Storage untuk layanan kompatibel S3 tanpa SDK tambahan. Berikut penjelasan detailnya:
+
+1. Konfigurasi :
+
+    - S3_ENDPOINT : Kosong untuk AWS (https://s3.<region>.amazonaws.com), atau misal http://localhost:9000 untuk MinIO
+    - S3_REGION, S3_BUCKET, S3_ACCESS_KEY, S3_SECRET_KEY : Kredensial dan bucket
+    - S3_PATH_STYLE : true untuk endpoint/bucket/key (MinIO), false untuk bucket.endpoint/key (AWS)
+    - S3_PUBLIC_URL : Awalan URL publik opsional, misal domain CDN di depan bucket
+2. Request :
+
+    - Put memakai PUT object dengan Content-Type file
+    - Delete memakai DELETE object; 404 dianggap berhasil
+    - Respons selain status yang diharapkan dikembalikan sebagai error beserta pesan dari S3
+3. AWS Signature Version 4 :
+
+    - Canonical request berisi method, path yang di-encode, query, header yang ditandatangani, dan hash SHA-256 payload
+    - Kunci tanda tangan diturunkan dengan HMAC berantai: tanggal, region, "s3", "aws4_request"
+    - Header x-amz-content-sha256 berisi hash payload sehingga isi file ikut terlindungi
+4. Pengujian Lokal :
+
+    - MinIO dapat dijalankan dengan Docker sebagai pengganti S3 (lihat README)
+File diunggah sebagai []byte karena ukuran gambar sudah dibatasi sebelumnya dan hash payload harus dihitung sebelum request dikirim.
+*/
@@ -0,0 +1,58 @@
+package storage                               // Mendefinisikan package storage
+
+import (
+    "context"                                 // Package untuk context request
+    "fmt"                                     // Package untuk formatting pesan error
+    "rest-api-go/pkg/config"                  // Mengimpor package config aplikasi
+)
+
+// Storage - tempat menyimpan file yang diunggah (gambar product, dll.)
+type Storage interface {
+    Put(ctx context.Context, key string, data []byte, contentType string) error  // Menyimpan file, menimpa jika key sudah ada
+    Delete(ctx context.Context, key string) error  // Menghapus file, tidak error jika file tidak ada
+    URL(key string) string                    // URL publik untuk file
+}
+
+// New - membuat Storage sesuai STORAGE_DRIVER
+func New(cfg *config.Config) (Storage, error) {
+    switch cfg.StorageDriver {
+    case "local":
+        return NewLocal(cfg.StorageLocalDir, cfg.StoragePublicURL)
+    case "s3":
+        return NewS3(S3Config{
+            Endpoint:  cfg.S3Endpoint,
+            Region:    cfg.S3Region,
+            Bucket:    cfg.S3Bucket,
+            AccessKey: cfg.S3AccessKey,
+            SecretKey: cfg.S3SecretKey,
+            PathStyle: cfg.S3PathStyle,
+            PublicURL: cfg.S3PublicURL,
+        })
+    default:
+        return nil, fmt.Errorf("unknown STORAGE_DRIVER %q (use local or s3)", cfg.StorageDriver)
+    }
+}
+
+
+
+// {{{ Penjelasan Package Storage }}}
+
+/*
+## Penjelasan Detail
+File storage.go ini mendefinisikan interface untuk penyimpanan file. Berikut penjelasan detailnya:
+
+1. Interface Storage :
+
+    - Put : Menyimpan isi file dengan key seperti "products/1/ab12cd/original.jpg"
+    - Delete : Menghapus file; file yang sudah tidak ada tidak dianggap error
+    - URL : Mengubah key menjadi URL yang bisa dibuka client
+2. Implementasi :
+
+    - Local (local.go) : Menyimpan file di folder lokal, disajikan oleh router di /uploads
+    - S3 (s3.go) : Menyimpan file di layanan kompatibel S3 (AWS S3, MinIO, Cloudflare R2, dll.)
+3. Fungsi New :
+
+    - Memilih implementasi berdasarkan STORAGE_DRIVER (local atau s3)
+    - Dipanggil sekali dari main.go lalu diteruskan ke modul yang membutuhkan
+Dengan interface ini, service tidak perlu tahu di mana file sebenarnya disimpan.
+*/
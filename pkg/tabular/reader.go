@@ -0,0 +1,193 @@
+package tabular                               // Mendefinisikan package tabular
+
+import (
+    "bufio"                                   // Package untuk membaca baris JSON Lines
+    "bytes"                                   // Package untuk decode JSON per baris
+    "encoding/csv"                            // Package untuk membaca CSV
+    "encoding/json"                           // Package untuk membaca JSON Lines
+    "errors"                                  // Package untuk pengecekan error
+    "fmt"                                     // Package untuk formatting pesan error
+    "io"                                      // Package untuk interface Reader
+    "net/http"                                // Package untuk konstanta HTTP
+    "rest-api-go/pkg/utils"                   // Mengimpor utilitas aplikasi untuk HTTPError
+    "strings"                                 // Package untuk manipulasi string
+)
+
+const maxLineBytes = 1 << 20                  // Panjang maksimum satu baris JSON Lines (1 MiB)
+
+// Record - satu baris data dengan nama kolom sebagai key
+type Record struct {
+    Row    int                                // Nomor baris di file (CSV: header = 1, JSON Lines: baris pertama = 1)
+    Values map[string]string                  // Nilai per kolom, selalu berupa teks
+}
+
+// RowError - baris yang tidak dapat dibaca; baris berikutnya tetap bisa dibaca
+type RowError struct {
+    Row int
+    Err error
+}
+
+func (e *RowError) Error() string {
+    return fmt.Sprintf("row %d: %v", e.Row, e.Err)
+}
+
+func (e *RowError) Unwrap() error {
+    return e.Err
+}
+
+// Reader - membaca baris data satu per satu; Read mengembalikan io.EOF di akhir file
+type Reader interface {
+    Read() (Record, error)
+}
+
+// NewReader - reader untuk format; XLSX tidak didukung untuk impor
+func NewReader(r io.Reader, format Format) (Reader, error) {
+    switch format {
+    case CSV:
+        return newCSVReader(r)
+    case JSONL:
+        scanner := bufio.NewScanner(r)
+        scanner.Buffer(make([]byte, 64<<10), maxLineBytes)
+        return &jsonlReader{scanner: scanner}, nil
+    }
+    return nil, utils.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("import supports csv and jsonl, got %q", format))
+}
+
+type csvReader struct {                       // Mendefinisikan struct reader CSV
+    in     *csv.Reader                        // Reader CSV standar
+    header []string                           // Nama kolom dari baris pertama
+}
+
+func newCSVReader(r io.Reader) (*csvReader, error) {  // Fungsi untuk membuat reader CSV dan membaca header
+    in := csv.NewReader(r)
+    in.ReuseRecord = true                     // Record dipakai ulang, nilai disalin ke map
+    header, err := in.Read()
+    if errors.Is(err, io.EOF) {
+        return nil, utils.NewHTTPError(http.StatusBadRequest, "file is empty, the first row must contain column names")
+    }
+    if err != nil {
+        return nil, csvError(err)
+    }
+    columns := make([]string, len(header))
+    for i, name := range header {
+        if i == 0 {
+            name = strings.TrimPrefix(name, "\ufeff")  // BOM yang ditulis Excel saat menyimpan CSV UTF-8
+        }
+        columns[i] = strings.TrimSpace(name)
+    }
+    return &csvReader{in: in, header: columns}, nil
+}
+
+func (r *csvReader) Read() (Record, error) {
+    fields, err := r.in.Read()
+    if err != nil {
+        var parseErr *csv.ParseError
+        if errors.As(err, &parseErr) {        // Baris rusak hanya menggagalkan baris itu
+            return Record{}, &RowError{Row: parseErr.StartLine, Err: parseErr.Err}
+        }
+        return Record{}, readError(err)       // io.EOF atau error saat membaca body
+    }
+    line, _ := r.in.FieldPos(0)
+    values := make(map[string]string, len(fields))
+    for i, value := range fields {
+        if r.header[i] != "" {                // Kolom tanpa nama diabaikan
+            values[r.header[i]] = unescapeFormula(value)
+        }
+    }
+    return Record{Row: line, Values: values}, nil
+}
+
+func csvError(err error) error {              // Fungsi untuk menerjemahkan error CSV pada baris header
+    var parseErr *csv.ParseError
+    if errors.As(err, &parseErr) {
+        return utils.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("invalid CSV header: %v", parseErr.Err))
+    }
+    return readError(err)
+}
+
+func readError(err error) error {             // Fungsi untuk menerjemahkan error saat membaca body
+    var maxBytesErr *http.MaxBytesError
+    if errors.As(err, &maxBytesErr) {         // Body melebihi batas ukuran, pesan sama dengan utils.BindJSON
+        return utils.NewHTTPError(http.StatusRequestEntityTooLarge, fmt.Sprintf("request body must not be larger than %d bytes", maxBytesErr.Limit))
+    }
+    return err
+}
+
+type jsonlReader struct {                     // Mendefinisikan struct reader JSON Lines
+    scanner *bufio.Scanner                    // Membaca satu baris per objek
+    line    int                               // Nomor baris terakhir
+}
+
+func (r *jsonlReader) Read() (Record, error) {
+    for r.scanner.Scan() {
+        r.line++
+        line := bytes.TrimSpace(r.scanner.Bytes())
+        if len(line) == 0 {
+            continue                          // Baris kosong dilewati
+        }
+        values, err := decodeObject(line)
+        if err != nil {
+            return Record{}, &RowError{Row: r.line, Err: err}
+        }
+        return Record{Row: r.line, Values: values}, nil
+    }
+    if err := r.scanner.Err(); err != nil {
+        if errors.Is(err, bufio.ErrTooLong) {
+            return Record{}, utils.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("line %d is longer than %d bytes", r.line+1, maxLineBytes))
+        }
+        return Record{}, readError(err)
+    }
+    return Record{}, io.EOF
+}
+
+func decodeObject(line []byte) (map[string]string, error) {  // Fungsi untuk membaca satu objek JSON menjadi nilai teks per key
+    decoder := json.NewDecoder(bytes.NewReader(line))
+    decoder.UseNumber()                       // Angka dibaca apa adanya tanpa float
+    var object map[string]any
+    if err := decoder.Decode(&object); err != nil || object == nil {
+        return nil, errors.New("line must be a JSON object")
+    }
+    values := make(map[string]string, len(object))
+    for key, value := range object {
+        switch v := value.(type) {
+        case nil:
+            values[key] = ""
+        case string:
+            values[key] = v
+        case json.Number:
+            values[key] = v.String()
+        case bool:
+            values[key] = fmt.Sprint(v)
+        default:
+            return nil, fmt.Errorf("field %q must be a string, number, boolean or null", key)
+        }
+    }
+    return values, nil
+}
+
+
+// {{{ Penjelasan Reader }}}
+
+/*
+## Penjelasan Detail
+File reader.go ini berisi reader untuk impor data tabel. Berikut penjelasan detailnya:
+
+1. Reader :
+
+    - Read mengembalikan satu Record per baris, io.EOF di akhir file
+    - File dibaca sedikit demi sedikit sehingga file besar tidak disimpan di memori
+    - Semua nilai dikembalikan sebagai teks, konversi ke tipe field dilakukan oleh pemanggil
+2. RowError :
+
+    - Baris yang rusak (CSV dengan tanda kutip salah atau jumlah kolom berbeda, JSON yang tidak valid) dikembalikan sebagai *RowError
+    - Pemanggil dapat mencatat error lalu lanjut membaca baris berikutnya
+    - Error lain menghentikan impor; body yang melebihi batas ukuran menjadi 413 dengan pesan yang sama dengan utils.BindJSON
+3. CSV :
+
+    - Baris pertama wajib berisi nama kolom, BOM UTF-8 dari Excel dan spasi di sekitar nama kolom dibuang
+    - Row adalah nomor baris di file, sama dengan nomor baris di spreadsheet
+4. JSON Lines :
+
+    - Satu objek JSON per baris, baris kosong dilewati
+    - Nilai harus skalar (string, angka, boolean, null); angka dibaca dengan json.Number sehingga tidak ada pembulatan
+*/
@@ -0,0 +1,113 @@
+package tabular                               // Mendefinisikan package tabular
+
+import (
+    "fmt"                                     // Package untuk formatting pesan error
+    "net/http"                                // Package untuk konstanta HTTP
+    "rest-api-go/pkg/utils"                   // Mengimpor utilitas aplikasi untuk HTTPError
+    "strconv"                                 // Package untuk konversi angka
+    "strings"                                 // Package untuk manipulasi string
+    "time"                                    // Package untuk format waktu
+)
+
+type Format string                            // Format file data tabel
+
+const (
+    CSV   Format = "csv"                      // Comma-separated values dengan baris header
+    JSONL Format = "jsonl"                    // JSON Lines, satu objek per baris
+    XLSX  Format = "xlsx"                     // Workbook Excel dengan satu sheet, hanya untuk ekspor
+)
+
+// ParseFormat - membaca nama format dari query string atau ekstensi file
+func ParseFormat(value string) (Format, error) {
+    switch Format(strings.ToLower(strings.TrimPrefix(value, "."))) {
+    case CSV:
+        return CSV, nil
+    case JSONL, "ndjson":
+        return JSONL, nil
+    case XLSX:
+        return XLSX, nil
+    }
+    return "", utils.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("format must be one of csv, jsonl, xlsx, got %q", value))
+}
+
+// FormatFromContentType - format dari header Content-Type, false jika tidak dikenal
+func FormatFromContentType(mediaType string) (Format, bool) {
+    switch mediaType {
+    case "text/csv", "application/csv":
+        return CSV, true
+    case "application/x-ndjson", "application/jsonl", "application/x-jsonlines":
+        return JSONL, true
+    }
+    return "", false
+}
+
+// ContentType - nilai header Content-Type untuk format
+func (f Format) ContentType() string {
+    switch f {
+    case JSONL:
+        return "application/x-ndjson"
+    case XLSX:
+        return "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+    }
+    return "text/csv; charset=utf-8"
+}
+
+func text(value any) string {                 // Fungsi untuk mengubah nilai sel menjadi teks
+    switch v := value.(type) {
+    case nil:
+        return ""
+    case string:
+        return v
+    case *string:
+        if v == nil {
+            return ""
+        }
+        return *v
+    case int:
+        return strconv.Itoa(v)
+    case int64:
+        return strconv.FormatInt(v, 10)
+    case uint:
+        return strconv.FormatUint(uint64(v), 10)
+    case uint64:
+        return strconv.FormatUint(v, 10)
+    case bool:
+        return strconv.FormatBool(v)
+    case time.Time:
+        return v.UTC().Format(time.RFC3339)
+    case fmt.Stringer:
+        return v.String()
+    }
+    return fmt.Sprint(value)
+}
+
+func numeric(value any) bool {                // Fungsi untuk memeriksa apakah nilai sel berupa angka
+    switch value.(type) {
+    case int, int64, uint, uint64:
+        return true
+    }
+    return false
+}
+
+
+// {{{ Penjelasan Package Tabular }}}
+
+/*
+## Penjelasan Detail
+File tabular.go ini berisi format file data tabel yang dipakai untuk impor dan ekspor. Berikut penjelasan detailnya:
+
+1. Format :
+
+    - csv : Baris pertama berisi nama kolom
+    - jsonl : Satu objek JSON per baris dengan nama kolom sebagai key (alias "ndjson")
+    - xlsx : Workbook Excel, hanya untuk ekspor
+2. ParseFormat dan FormatFromContentType :
+
+    - Format dibaca dari query string (?format=csv), ekstensi file (.csv), atau header Content-Type (text/csv)
+    - Format yang tidak dikenal ditolak dengan 400
+3. Nilai Sel :
+
+    - Writer menerima []any sehingga angka tetap angka di JSON Lines dan Excel
+    - Waktu ditulis dalam RFC 3339 UTC, *string nil dan nil ditulis sebagai sel kosong (null di JSON Lines)
+4. Tidak ada dependency tambahan: CSV dan JSON memakai library standar, XLSX ditulis langsung sebagai ZIP berisi XML (lihat xlsx.go).
+*/
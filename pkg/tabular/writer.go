@@ -0,0 +1,128 @@
+package tabular                               // Mendefinisikan package tabular
+
+import (
+    "bufio"                                   // Package untuk buffer output
+    "encoding/csv"                            // Package untuk menulis CSV
+    "encoding/json"                           // Package untuk menulis JSON Lines
+    "io"                                      // Package untuk interface Writer
+    "strings"                                 // Package untuk memeriksa awalan teks
+    "time"                                    // Package untuk format waktu
+)
+
+// Writer - menulis baris data satu per satu tanpa menyimpan seluruh file di memori
+type Writer interface {
+    Write(values []any) error                 // Menulis satu baris, urutan nilai sama dengan header
+    Flush() error                             // Mengirim data yang masih di buffer
+    Close() error                             // Menyelesaikan file, wajib dipanggil sekali di akhir
+}
+
+// NewWriter - writer untuk format dengan baris header columns
+func NewWriter(w io.Writer, format Format, columns []string) (Writer, error) {
+    switch format {
+    case JSONL:
+        return &jsonlWriter{out: bufio.NewWriter(w), columns: columns}, nil
+    case XLSX:
+        return newXLSXWriter(w, columns)
+    }
+    out := csv.NewWriter(w)
+    if err := out.Write(columns); err != nil {
+        return nil, err
+    }
+    return &csvWriter{out: out, record: make([]string, len(columns))}, nil
+}
+
+type csvWriter struct {                       // Mendefinisikan struct writer CSV
+    out    *csv.Writer                        // Writer CSV standar
+    record []string                           // Buffer satu baris, dipakai ulang
+}
+
+func (w *csvWriter) Write(values []any) error {
+    for i, value := range values {
+        w.record[i] = escapeFormula(text(value))
+    }
+    return w.out.Write(w.record)
+}
+
+func (w *csvWriter) Flush() error {
+    w.out.Flush()
+    return w.out.Error()
+}
+
+func (w *csvWriter) Close() error {
+    return w.Flush()
+}
+
+func escapeFormula(s string) string {         // Fungsi untuk mencegah teks dijalankan sebagai rumus saat CSV dibuka di spreadsheet
+    if s != "" && strings.ContainsRune("=+-@\t\r", rune(s[0])) {
+        return "'" + s
+    }
+    return s
+}
+
+func unescapeFormula(s string) string {       // Fungsi untuk membalik escapeFormula saat CSV diimpor
+    if len(s) > 1 && s[0] == '\'' && strings.ContainsRune("=+-@\t\r", rune(s[1])) {
+        return s[1:]
+    }
+    return s
+}
+
+type jsonlWriter struct {                     // Mendefinisikan struct writer JSON Lines
+    out     *bufio.Writer                     // Buffer output
+    columns []string                          // Key objek sesuai urutan kolom
+}
+
+func (w *jsonlWriter) Write(values []any) error {
+    w.out.WriteByte('{')
+    for i, value := range values {            // Objek ditulis manual agar urutan key sama dengan urutan kolom
+        if i > 0 {
+            w.out.WriteByte(',')
+        }
+        key, _ := json.Marshal(w.columns[i])
+        w.out.Write(key)
+        w.out.WriteByte(':')
+        if t, ok := value.(time.Time); ok {   // Waktu sama dengan CSV dan Excel
+            value = text(t)
+        }
+        if s, ok := value.(*string); ok && s != nil {
+            value = *s
+        }
+        encoded, err := json.Marshal(value)
+        if err != nil {
+            return err
+        }
+        w.out.Write(encoded)
+    }
+    w.out.WriteByte('}')
+    return w.out.WriteByte('\n')
+}
+
+func (w *jsonlWriter) Flush() error {
+    return w.out.Flush()
+}
+
+func (w *jsonlWriter) Close() error {
+    return w.Flush()
+}
+
+
+// {{{ Penjelasan Writer }}}
+
+/*
+## Penjelasan Detail
+File writer.go ini berisi writer untuk ekspor data tabel. Berikut penjelasan detailnya:
+
+1. Writer :
+
+    - Write menulis satu baris, Flush mengirim buffer ke client, Close menyelesaikan file
+    - Baris ditulis langsung ke io.Writer (misal respons HTTP), sehingga ekspor besar tidak disimpan di memori
+2. CSV :
+
+    - Baris pertama adalah header
+    - Teks yang diawali =, +, -, @, tab, atau carriage return diberi awalan ' agar tidak dijalankan sebagai rumus oleh spreadsheet (CSV injection)
+    - Reader CSV membuang awalan ' tersebut sehingga file hasil ekspor dapat diimpor kembali apa adanya
+3. JSON Lines :
+
+    - Satu objek per baris dengan key sesuai urutan kolom
+    - Angka tetap angka, nil menjadi null
+4. XLSX : Lihat xlsx.go.
+*/
@@ -0,0 +1,131 @@
+package tabular                               // Mendefinisikan package tabular
+
+import (
+    "archive/zip"                             // Package untuk membuat file ZIP (XLSX adalah ZIP berisi XML)
+    "bufio"                                   // Package untuk buffer output
+    "encoding/xml"                            // Package untuk escape teks XML
+    "io"                                      // Package untuk interface Writer
+    "strconv"                                 // Package untuk nomor baris
+)
+
+const xmlHeader = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>` + "\n"  // Deklarasi XML di awal setiap bagian
+
+var xlsxParts = []struct{ name, body string }{  // Bagian workbook yang isinya tetap, ditulis sebelum sheet
+    {"[Content_Types].xml", `<Types xmlns="http://schemas.openxmlformats.org/package/2006/content-types">` +
+        `<Default Extension="rels" ContentType="application/vnd.openxmlformats-package.relationships+xml"/>` +
+        `<Default Extension="xml" ContentType="application/xml"/>` +
+        `<Override PartName="/xl/workbook.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.sheet.main+xml"/>` +
+        `<Override PartName="/xl/worksheets/sheet1.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.worksheet+xml"/>` +
+        `</Types>`},
+    {"_rels/.rels", `<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">` +
+        `<Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/officeDocument" Target="xl/workbook.xml"/>` +
+        `</Relationships>`},
+    {"xl/workbook.xml", `<workbook xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main" xmlns:r="http://schemas.openxmlformats.org/officeDocument/2006/relationships">` +
+        `<sheets><sheet name="Sheet1" sheetId="1" r:id="rId1"/></sheets>` +
+        `</workbook>`},
+    {"xl/_rels/workbook.xml.rels", `<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">` +
+        `<Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/worksheet" Target="worksheets/sheet1.xml"/>` +
+        `</Relationships>`},
+}
+
+type xlsxWriter struct {                      // Mendefinisikan struct writer XLSX
+    archive *zip.Writer                       // File ZIP yang ditulis ke client
+    sheet   *bufio.Writer                     // Isi sheet1.xml, ditulis baris per baris
+    row     int                               // Nomor baris terakhir (header = 1)
+}
+
+func newXLSXWriter(w io.Writer, columns []string) (*xlsxWriter, error) {  // Fungsi untuk membuat writer XLSX dan menulis header
+    archive := zip.NewWriter(w)
+    for _, part := range xlsxParts {
+        f, err := archive.Create(part.name)
+        if err != nil {
+            return nil, err
+        }
+        if _, err := io.WriteString(f, xmlHeader+part.body); err != nil {
+            return nil, err
+        }
+    }
+
+    f, err := archive.Create("xl/worksheets/sheet1.xml")  // Bagian terakhir, tetap terbuka sampai Close
+    if err != nil {
+        return nil, err
+    }
+    w2 := &xlsxWriter{archive: archive, sheet: bufio.NewWriter(f)}
+    w2.sheet.WriteString(xmlHeader + `<worksheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main"><sheetData>`)
+    header := make([]any, len(columns))
+    for i, column := range columns {
+        header[i] = column
+    }
+    return w2, w2.Write(header)
+}
+
+func (w *xlsxWriter) Write(values []any) error {
+    w.row++
+    row := strconv.Itoa(w.row)
+    w.sheet.WriteString(`<row r="` + row + `">`)
+    for i, value := range values {
+        s := text(value)
+        if s == "" {
+            continue                          // Sel kosong tidak perlu ditulis
+        }
+        ref := columnName(i) + row
+        if numeric(value) {
+            w.sheet.WriteString(`<c r="` + ref + `"><v>` + s + `</v></c>`)
+            continue
+        }
+        w.sheet.WriteString(`<c r="` + ref + `" t="inlineStr"><is><t xml:space="preserve">`)  // Teks inline tidak pernah dijalankan sebagai rumus
+        if err := xml.EscapeText(w.sheet, []byte(s)); err != nil {
+            return err
+        }
+        w.sheet.WriteString(`</t></is></c>`)
+    }
+    _, err := w.sheet.WriteString(`</row>`)
+    return err
+}
+
+func (w *xlsxWriter) Flush() error {
+    if err := w.sheet.Flush(); err != nil {
+        return err
+    }
+    return w.archive.Flush()
+}
+
+func (w *xlsxWriter) Close() error {
+    w.sheet.WriteString(`</sheetData></worksheet>`)
+    if err := w.sheet.Flush(); err != nil {
+        return err
+    }
+    return w.archive.Close()                  // Menulis central directory ZIP
+}
+
+func columnName(index int) string {           // Fungsi untuk nama kolom Excel dari index 0 (A, B, ..., Z, AA, AB, ...)
+    name := ""
+    for index++; index > 0; index = (index - 1) / 26 {
+        name = string(rune('A'+(index-1)%26)) + name
+    }
+    return name
+}
+
+
+// {{{ Penjelasan XLSX }}}
+
+/*
+## Penjelasan Detail
+File xlsx.go ini berisi writer XLSX tanpa library tambahan. Berikut penjelasan detailnya:
+
+1. Struktur File :
+
+    - XLSX adalah file ZIP berisi XML (Office Open XML)
+    - [Content_Types].xml, _rels/.rels, xl/workbook.xml, dan xl/_rels/workbook.xml.rels isinya tetap
+    - xl/worksheets/sheet1.xml berisi data dan ditulis terakhir
+2. Streaming :
+
+    - Bagian ZIP untuk sheet tetap terbuka selama ekspor, setiap baris langsung dikompresi dan dikirim
+    - Close menutup sheet dan menulis central directory ZIP
+3. Sel :
+
+    - Angka ditulis sebagai sel numerik, teks sebagai inline string (tanpa tabel sharedStrings)
+    - Teks di-escape dengan xml.EscapeText, karakter yang tidak valid di XML diganti U+FFFD
+    - Inline string tidak pernah dianggap rumus, sehingga tidak perlu escape seperti CSV
+4. columnName : 0 = A, 25 = Z, 26 = AA.
+*/
@@ -0,0 +1,115 @@
+package tracing                               // Mendefinisikan package tracing
+
+import (
+    "errors"                                  // Package untuk pengecekan error
+
+    "go.opentelemetry.io/otel"                // API global OpenTelemetry
+    "go.opentelemetry.io/otel/attribute"      // Atribut span
+    "go.opentelemetry.io/otel/codes"          // Status span
+    "go.opentelemetry.io/otel/trace"          // API span
+    "gorm.io/gorm"                            // Mengimpor ORM GORM
+)
+
+const spanKey = "tracing:span"                // Key instance GORM untuk menyimpan span yang sedang berjalan
+
+// GormPlugin - plugin GORM yang membuat span untuk setiap query
+type GormPlugin struct {                      // Mendefinisikan struct plugin
+    dbSystem string                           // Nama sistem database (misal mysql)
+    dbName   string                           // Nama database
+}
+
+// NewGormPlugin - membuat plugin tracing GORM
+func NewGormPlugin(dbSystem, dbName string) *GormPlugin {  // Constructor untuk plugin
+    return &GormPlugin{dbSystem: dbSystem, dbName: dbName}  // Mengembalikan instance plugin
+}
+
+func (p *GormPlugin) Name() string {          // Nama plugin (wajib untuk interface gorm.Plugin)
+    return "tracing"
+}
+
+func (p *GormPlugin) Initialize(db *gorm.DB) error {  // Method yang dipanggil saat db.Use(plugin)
+    cb := db.Callback()                       // Mengambil registry callback GORM
+    errs := []error{                          // Daftarkan callback before dan after untuk setiap operasi
+        cb.Create().Before("gorm:create").Register("tracing:before_create", p.before("create")),
+        cb.Create().After("gorm:create").Register("tracing:after_create", p.after),
+        cb.Query().Before("gorm:query").Register("tracing:before_query", p.before("select")),
+        cb.Query().After("gorm:query").Register("tracing:after_query", p.after),
+        cb.Update().Before("gorm:update").Register("tracing:before_update", p.before("update")),
+        cb.Update().After("gorm:update").Register("tracing:after_update", p.after),
+        cb.Delete().Before("gorm:delete").Register("tracing:before_delete", p.before("delete")),
+        cb.Delete().After("gorm:delete").Register("tracing:after_delete", p.after),
+        cb.Row().Before("gorm:row").Register("tracing:before_row", p.before("row")),
+        cb.Row().After("gorm:row").Register("tracing:after_row", p.after),
+        cb.Raw().Before("gorm:raw").Register("tracing:before_raw", p.before("raw")),
+        cb.Raw().After("gorm:raw").Register("tracing:after_raw", p.after),
+    }
+    return errors.Join(errs...)               // Gabungkan error pendaftaran callback (nil jika semua berhasil)
+}
+
+func (p *GormPlugin) before(operation string) func(*gorm.DB) {  // Callback sebelum query: mulai span
+    return func(db *gorm.DB) {
+        ctx := db.Statement.Context           // Context dari db.WithContext(ctx) di service
+        if ctx == nil {
+            return                            // Query tanpa context tidak ditrace
+        }
+        _, span := otel.Tracer(instrumentationName).Start(ctx, "gorm."+operation,  // Span sebagai child dari span service
+            trace.WithSpanKind(trace.SpanKindClient),
+            trace.WithAttributes(
+                attribute.String("db.system", p.dbSystem),
+                attribute.String("db.name", p.dbName),
+                attribute.String("db.operation", operation),
+            ),
+        )
+        db.InstanceSet(spanKey, span)         // Simpan span untuk ditutup di callback after
+    }
+}
+
+func (p *GormPlugin) after(db *gorm.DB) {     // Callback setelah query: tutup span
+    value, ok := db.InstanceGet(spanKey)      // Ambil span yang dibuat di callback before
+    if !ok {
+        return
+    }
+    span, ok := value.(trace.Span)
+    if !ok {
+        return
+    }
+    defer span.End()                          // Selalu akhiri span
+
+    span.SetAttributes(                       // Lengkapi atribut setelah SQL diketahui
+        attribute.String("db.sql.table", db.Statement.Table),
+        attribute.String("db.statement", db.Statement.SQL.String()),
+        attribute.Int64("db.rows_affected", db.Statement.RowsAffected),
+    )
+    if db.Error != nil && !errors.Is(db.Error, gorm.ErrRecordNotFound) {  // Record tidak ditemukan bukan kegagalan
+        span.RecordError(db.Error)            // Simpan error sebagai event span
+        span.SetStatus(codes.Error, db.Error.Error())  // Tandai span gagal
+    }
+}
+
+
+
+// {{{ Penjelasan Plugin Tracing GORM }}}
+
+/*
+## Penjelasan Detail
+File gorm.go ini berisi plugin GORM yang membuat span OpenTelemetry untuk setiap query. Berikut penjelasan detailnya:
+
+1. Tujuan : Menampilkan setiap query database sebagai child span di dalam trace request.
+2. Cara Kerja :
+
+    - Callback before membuat span gorm.<operasi> dari context statement (db.Statement.Context)
+    - Span disimpan dengan db.InstanceSet agar tiap statement punya span sendiri
+    - Callback after menambahkan tabel, SQL, dan jumlah baris lalu menutup span
+3. Atribut Span :
+
+    - db.system, db.name, db.operation : Informasi database dan jenis operasi
+    - db.sql.table, db.statement : Tabel dan SQL yang dijalankan (dengan placeholder, tanpa nilai parameter)
+    - db.rows_affected : Jumlah baris yang terpengaruh
+4. Syarat :
+
+    - Service harus memakai db.WithContext(ctx) agar span query tersambung ke span request
+5. Penggunaan di main.go :
+
+    - db.Use(tracing.NewGormPlugin("mysql", cfg.DBName))
+Plugin ini bekerja berdampingan dengan plugin metrics karena keduanya memakai nama callback yang berbeda.
+*/
@@ -0,0 +1,125 @@
+package tracing                               // Mendefinisikan package tracing
+
+import (
+    "context"                                 // Package untuk context request
+    "fmt"                                     // Package untuk formatting error
+    "os"                                      // Package untuk output stdout
+    "rest-api-go/pkg/config"                  // Mengimpor package config aplikasi
+    "strings"                                 // Package untuk manipulasi string
+
+    "go.opentelemetry.io/otel"                // API global OpenTelemetry
+    "go.opentelemetry.io/otel/attribute"      // Atribut span
+    "go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"  // Exporter OTLP melalui HTTP
+    "go.opentelemetry.io/otel/exporters/stdout/stdouttrace"            // Exporter ke stdout untuk pengembangan lokal
+    "go.opentelemetry.io/otel/propagation"    // Propagasi W3C trace-context
+    "go.opentelemetry.io/otel/sdk/resource"   // Informasi resource (nama service)
+    sdktrace "go.opentelemetry.io/otel/sdk/trace"  // SDK tracing
+    "go.opentelemetry.io/otel/sdk/trace/tracetest"  // Exporter in-memory untuk pengujian
+    semconv "go.opentelemetry.io/otel/semconv/v1.26.0"  // Nama atribut standar
+    "go.opentelemetry.io/otel/trace"          // API span
+)
+
+const instrumentationName = "rest-api-go"     // Nama instrumentasi untuk tracer aplikasi
+
+var memoryExporter = tracetest.NewInMemoryExporter()  // Exporter in-memory yang dipakai saat TRACING_EXPORTER=memory
+
+// Setup - memasang TracerProvider global sesuai konfigurasi
+func Setup(ctx context.Context, cfg *config.Config) (func(context.Context) error, error) {  // Mengembalikan fungsi shutdown untuk flush span
+    otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(  // Propagasi header traceparent/tracestate dan baggage
+        propagation.TraceContext{},
+        propagation.Baggage{},
+    ))
+
+    exporter, err := newExporter(ctx, cfg)    // Membuat exporter sesuai konfigurasi
+    if err != nil {
+        return nil, err                       // Gagal membuat exporter
+    }
+    if exporter == nil {                      // Tracing dinonaktifkan
+        return func(context.Context) error { return nil }, nil  // Shutdown tidak melakukan apa pun
+    }
+
+    res, err := resource.Merge(resource.Default(), resource.NewWithAttributes(  // Resource berisi nama service
+        semconv.SchemaURL,
+        semconv.ServiceName(cfg.ServiceName),
+    ))
+    if err != nil {
+        return nil, err                       // Gagal membuat resource
+    }
+
+    var processor sdktrace.SpanProcessor      // Processor untuk mengirim span ke exporter
+    if strings.EqualFold(cfg.TracingExporter, "memory") {
+        processor = sdktrace.NewSimpleSpanProcessor(exporter)  // Langsung diekspor agar span bisa diperiksa segera
+    } else {
+        processor = sdktrace.NewBatchSpanProcessor(exporter)  // Dikirim per batch di background
+    }
+
+    provider := sdktrace.NewTracerProvider(   // Membuat TracerProvider
+        sdktrace.WithResource(res),
+        sdktrace.WithSpanProcessor(processor),
+        sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(cfg.TracingSampleRatio))),  // Ikuti keputusan sampling dari parent, jika tidak ada gunakan rasio
+    )
+    otel.SetTracerProvider(provider)          // Menjadikan provider ini global
+
+    return provider.Shutdown, nil             // Shutdown akan mengirim span yang tersisa
+}
+
+func newExporter(ctx context.Context, cfg *config.Config) (sdktrace.SpanExporter, error) {  // Fungsi untuk memilih exporter
+    switch strings.ToLower(cfg.TracingExporter) {
+    case "", "none":
+        return nil, nil                       // Tracing nonaktif
+    case "stdout":
+        return stdouttrace.New(stdouttrace.WithWriter(os.Stdout), stdouttrace.WithPrettyPrint())  // Span dicetak ke stdout
+    case "memory":
+        return memoryExporter, nil            // Span disimpan di memori
+    case "otlp":
+        opts := []otlptracehttp.Option{otlptracehttp.WithEndpoint(cfg.OTLPEndpoint)}  // Alamat collector
+        if cfg.OTLPInsecure {
+            opts = append(opts, otlptracehttp.WithInsecure())  // Tanpa TLS
+        }
+        return otlptracehttp.New(ctx, opts...)  // Exporter OTLP/HTTP
+    default:
+        return nil, fmt.Errorf("unknown tracing exporter %q", cfg.TracingExporter)  // Nilai konfigurasi tidak dikenal
+    }
+}
+
+// MemoryExporter - mengembalikan exporter in-memory untuk memeriksa span
+func MemoryExporter() *tracetest.InMemoryExporter {  // Dipakai saat TRACING_EXPORTER=memory
+    return memoryExporter
+}
+
+// Start - memulai span baru sebagai child dari span di context
+func Start(ctx context.Context, name string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {  // Helper untuk span di service
+    return otel.Tracer(instrumentationName).Start(ctx, name, trace.WithAttributes(attrs...))  // Tracer diambil dari provider global
+}
+
+
+
+// {{{ Penjelasan Package Tracing }}}
+
+/*
+## Penjelasan Detail
+File tracing.go ini berisi konfigurasi OpenTelemetry tracing untuk aplikasi. Berikut penjelasan detailnya:
+
+1. Tujuan : Mengetahui bagian mana yang lambat dalam sebuah request (Gin, service, atau database).
+2. Fungsi Setup :
+
+    - Memasang propagator W3C trace-context (header traceparent) dan baggage
+    - Memilih exporter dari config.TracingExporter
+    - Membuat TracerProvider dengan nama service dan sampler berbasis rasio
+    - Mengembalikan fungsi shutdown yang harus dipanggil saat aplikasi berhenti agar span terakhir terkirim
+3. Pilihan Exporter :
+
+    - none : Tracing nonaktif (default)
+    - stdout : Span dicetak ke stdout, cocok untuk pengembangan lokal
+    - memory : Span disimpan di memori (MemoryExporter), cocok untuk pengujian
+    - otlp : Span dikirim ke collector OpenTelemetry melalui OTLP/HTTP (OTLP_ENDPOINT)
+4. Helper Span :
+
+    - Start : Membuat child span dari span yang ada di context
+5. Hierarki Span :
+
+    - Middleware otelgin membuat span untuk setiap request HTTP
+    - Service membuat child span seperti ProductService.GetByID
+    - Plugin GORM (gorm.go) membuat child span untuk setiap query
+Dengan hierarki ini, satu trace menunjukkan waktu yang dihabiskan di setiap lapisan.
+*/
@@ -0,0 +1,115 @@
+package utils                                 // Mendefinisikan package utils
+
+import (
+    "bytes"                                   // Package untuk membaca JSON dari slice byte
+    "encoding/json"                           // Package untuk decoding JSON
+    "errors"                                  // Package untuk pengecekan tipe error
+    "fmt"                                     // Package untuk formatting pesan error
+    "io"                                      // Package untuk io.EOF
+    "mime"                                    // Package untuk parsing Content-Type
+    "net/http"                                // Package untuk konstanta HTTP
+    "strings"                                 // Package untuk manipulasi string
+
+    "github.com/gin-gonic/gin"                // Mengimpor framework web Gin
+    "github.com/gin-gonic/gin/binding"        // Validator bawaan Gin (tag binding)
+)
+
+func BindJSON(c *gin.Context, obj interface{}) error {  // Fungsi untuk binding JSON secara ketat
+    if err := requireJSON(c.GetHeader("Content-Type")); err != nil {  // Pastikan body berupa JSON
+        return err                            // 415 Unsupported Media Type
+    }
+
+    if err := decodeStrict(c.Request.Body, obj); err != nil {  // Body sudah dibatasi middleware BodyLimit
+        return err
+    }
+
+    if binding.Validator == nil {             // Validator Gin dinonaktifkan
+        return nil
+    }
+    return binding.Validator.ValidateStruct(obj)  // Validasi tag binding seperti ShouldBindJSON
+}
+
+// DecodeJSON - decoding ketat seperti BindJSON untuk JSON yang sudah dibaca, misal satu item dalam request bulk
+func DecodeJSON(data []byte, obj interface{}) error {
+    return decodeStrict(bytes.NewReader(data), obj)  // Validasi tag binding dilakukan oleh pemanggil
+}
+
+func decodeStrict(r io.Reader, obj interface{}) error {  // Fungsi untuk decoding satu objek JSON tanpa field yang tidak dikenal
+    decoder := json.NewDecoder(r)
+    decoder.DisallowUnknownFields()           // Tolak field yang tidak dikenal (misal categoryId, bukan category_id)
+
+    if err := decoder.Decode(obj); err != nil {  // Decode objek JSON pertama
+        return decodeError(err)               // Ubah error menjadi pesan yang menunjuk field bermasalah
+    }
+    if err := decoder.Decode(&struct{}{}); !errors.Is(err, io.EOF) {  // Body harus berakhir setelah satu objek JSON
+        return NewHTTPError(http.StatusBadRequest, "request body must contain a single JSON object")  // Ada data tambahan setelah objek
+    }
+    return nil
+}
+
+func requireJSON(contentType string) error {  // Fungsi untuk memeriksa header Content-Type
+    mediaType, _, err := mime.ParseMediaType(contentType)  // Abaikan parameter seperti charset=utf-8
+    if err != nil || (mediaType != "application/json" && !strings.HasSuffix(mediaType, "+json")) {  // Terima application/json dan tipe turunan +json
+        return NewHTTPError(http.StatusUnsupportedMediaType, "Content-Type must be application/json")
+    }
+    return nil
+}
+
+func decodeError(err error) error {           // Fungsi untuk menerjemahkan error decoding JSON
+    var syntaxErr *json.SyntaxError
+    var typeErr *json.UnmarshalTypeError
+    var maxBytesErr *http.MaxBytesError
+
+    switch {
+    case errors.As(err, &maxBytesErr):        // Body melebihi batas ukuran
+        return NewHTTPError(http.StatusRequestEntityTooLarge, fmt.Sprintf("request body must not be larger than %d bytes", maxBytesErr.Limit))
+    case errors.As(err, &syntaxErr):          // JSON tidak valid
+        return NewHTTPError(http.StatusBadRequest, fmt.Sprintf("request body contains malformed JSON at position %d", syntaxErr.Offset))
+    case errors.Is(err, io.ErrUnexpectedEOF): // JSON terpotong
+        return NewHTTPError(http.StatusBadRequest, "request body contains malformed JSON")
+    case errors.As(err, &typeErr):            // Tipe nilai tidak sesuai dengan field
+        if typeErr.Field != "" {
+            return NewHTTPError(http.StatusBadRequest, fmt.Sprintf("field %q must be of type %s", typeErr.Field, typeErr.Type))
+        }
+        return NewHTTPError(http.StatusBadRequest, fmt.Sprintf("request body must be a JSON %s", typeErr.Type))
+    case strings.HasPrefix(err.Error(), "json: unknown field "):  // Field tidak dikenal (encoding/json tidak punya tipe error khusus)
+        field := strings.TrimPrefix(err.Error(), "json: unknown field ")
+        return NewHTTPError(http.StatusBadRequest, fmt.Sprintf("unknown field %s", field))
+    case errors.Is(err, io.EOF):              // Body kosong
+        return NewHTTPError(http.StatusBadRequest, "request body must not be empty")
+    default:
+        return NewHTTPError(http.StatusBadRequest, err.Error())
+    }
+}
+
+
+
+// {{{ Penjelasan BindJSON }}}
+
+/*
+## Penjelasan Detail
+File bind.go ini berisi binding JSON yang lebih ketat dibanding c.ShouldBindJSON. Berikut penjelasan detailnya:
+
+1. Tujuan : Mencegah data salah yang diam-diam diterima, misal client mengirim categoryId alih-alih category_id dan product tersimpan dengan category 0.
+2. Pemeriksaan yang Dilakukan :
+
+    - Content-Type harus application/json (atau tipe +json), jika tidak 415 Unsupported Media Type
+    - Field yang tidak dikenal ditolak (DisallowUnknownFields)
+    - Body hanya boleh berisi satu objek JSON, data tambahan setelahnya ditolak
+    - Body yang melebihi batas middleware BodyLimit menghasilkan 413 Request Entity Too Large
+    - Setelah decoding, tag binding divalidasi dengan validator Gin
+3. Pesan Error :
+
+    - unknown field "categoryId"
+    - field "category_id" must be of type uint
+    - request body contains malformed JSON at position 42
+    - request body must not be empty
+4. Penggunaan di Handler :
+
+    - if err := utils.BindJSON(c, &product); err != nil { utils.HandleError(c, http.StatusBadRequest, err); return }
+5. DecodeJSON :
+
+    - Aturan decoding yang sama untuk JSON yang sudah ada di memori, misal setiap item pada request bulk
+    - Tidak memvalidasi tag binding; pemanggil menjalankan Validate() sendiri
+Semua error yang berasal dari format request dikembalikan sebagai HTTPError sehingga HandleError mengirim status yang tepat.
+*/
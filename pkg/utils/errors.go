@@ -0,0 +1,76 @@
+package utils                                 // Mendefinisikan package utils
+
+import (
+    "errors"                                  // Package untuk pengecekan tipe error
+    "net/http"                                // Package untuk konstanta HTTP
+    "rest-api-go/pkg/logger"                  // Mengimpor package logger untuk membaca request ID
+    "rest-api-go/pkg/validation"              // Mengimpor package validation untuk menerjemahkan error validasi
+
+    "github.com/gin-gonic/gin"                // Mengimpor framework web Gin
+)
+
+// HTTPError - error yang sudah membawa status HTTP yang tepat
+type HTTPError struct {                       // Mendefinisikan struct error dengan status HTTP
+    Status  int                               // Status HTTP yang dikirim ke client
+    Message string                            // Pesan error untuk client
+}
+
+func NewHTTPError(status int, message string) *HTTPError {  // Constructor untuk HTTPError
+    return &HTTPError{Status: status, Message: message}  // Mengembalikan instance error
+}
+
+func (e *HTTPError) Error() string {          // Implementasi interface error
+    return e.Message                          // Pesan error
+}
+
+func HandleError(c *gin.Context, fallbackStatus int, err error) {  // Fungsi untuk mengirim respons error berdasarkan jenis error
+    locale := validation.LocaleFromHeader(c.GetHeader("Accept-Language"))  // Bahasa pesan validasi
+    status, message, fields := Describe(err, fallbackStatus, locale)
+    response := ErrorResponse(message)
+    response.Errors = fields                  // Pesan per field, hanya untuk error validasi
+    response.RequestID = logger.RequestIDFromContext(c.Request.Context())
+    c.JSON(status, response)
+}
+
+// Describe - status HTTP, pesan, dan pesan per field untuk sebuah error
+func Describe(err error, fallbackStatus int, locale string) (int, string, map[string]string) {  // Dipakai HandleError dan laporan per item pada request bulk
+    if fields, ok := validation.Translate(err, locale); ok {  // Error validasi dari binding atau service
+        return http.StatusBadRequest, validation.Message(locale), fields  // Error validasi selalu 400
+    }
+
+    var httpErr *HTTPError
+    if errors.As(err, &httpErr) {             // Error sudah membawa status sendiri (misal 413, 415)
+        return httpErr.Status, httpErr.Message, nil
+    }
+    return fallbackStatus, err.Error(), nil   // Error lain memakai status yang diberikan handler
+}
+
+
+
+// {{{ Penjelasan HTTPError }}}
+
+/*
+## Penjelasan Detail
+File errors.go ini berisi tipe error yang membawa status HTTP. Berikut penjelasan detailnya:
+
+1. Tujuan : Agar fungsi di luar handler (binding, service) dapat menentukan status HTTP yang tepat tanpa bergantung pada Gin.
+2. HTTPError :
+
+    - Status : Status HTTP yang akan dikirim (misal 413 Request Entity Too Large, 415 Unsupported Media Type)
+    - Message : Pesan yang aman ditampilkan ke client
+3. HandleError :
+
+    - Jika error berasal dari validator (binding maupun Validate() di service), status 400 dengan pesan per field di field errors
+    - Jika error adalah *HTTPError (termasuk yang dibungkus dengan fmt.Errorf("%w")), status dan pesannya dipakai
+    - Jika bukan, status fallback dari handler yang dipakai bersama err.Error()
+    - Request ID selalu ikut dikirim
+4. Describe :
+
+    - Aturan yang sama tanpa menulis respons, mengembalikan status, pesan, dan pesan per field
+    - Dipakai oleh pkg/bulk untuk melaporkan error setiap item
+5. Penggunaan :
+
+    - utils.HandleError(c, http.StatusBadRequest, err) setelah BindJSON
+    - utils.HandleError(c, http.StatusInternalServerError, err) setelah memanggil service
+Pola ini menjaga handler tetap pendek sambil tetap mengembalikan status yang benar untuk setiap jenis error.
+*/
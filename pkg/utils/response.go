@@ -1,9 +1,19 @@
 package utils                                 // Mendefinisikan package utils
 
+import (
+    "net/url"                                 // Package untuk escape parameter path
+    "rest-api-go/pkg/logger"                  // Mengimpor package logger untuk membaca request ID
+    "strings"                                 // Package untuk mengganti parameter pada template route
+
+    "github.com/gin-gonic/gin"                // Mengimpor framework web Gin
+)
+
 type Response struct {                        // Mendefinisikan struct Response untuk format respons API
-    Success bool        `json:"success"`      // Field untuk menandakan status sukses/gagal, selalu ditampilkan dalam JSON
-    Data    interface{} `json:"data,omitempty"`  // Field untuk data respons, tidak ditampilkan jika kosong
-    Error   string      `json:"error,omitempty"` // Field untuk pesan error, tidak ditampilkan jika kosong
+    Success   bool        `json:"success"`    // Field untuk menandakan status sukses/gagal, selalu ditampilkan dalam JSON
+    Data      interface{} `json:"data,omitempty"`  // Field untuk data respons, tidak ditampilkan jika kosong
+    Error     string      `json:"error,omitempty"` // Field untuk pesan error, tidak ditampilkan jika kosong
+    RequestID string      `json:"request_id,omitempty"`  // Field untuk request ID pada respons error, tidak ditampilkan jika kosong
+    Errors    map[string]string `json:"errors,omitempty"`  // Field untuk pesan error per field (validasi), tidak ditampilkan jika kosong
 }
 
 func SuccessResponse(data interface{}) Response {  // Fungsi untuk membuat respons sukses
@@ -20,6 +30,19 @@ func ErrorResponse(err string) Response {     // Fungsi untuk membuat respons er
     }
 }
 
+func ErrorJSON(c *gin.Context, status int, err string) {  // Fungsi untuk mengirim respons error beserta request ID
+    response := ErrorResponse(err)            // Membuat respons error standar
+    response.RequestID = logger.RequestIDFromContext(c.Request.Context())  // Menyertakan request ID agar error mudah dilacak di log
+    c.JSON(status, response)                  // Mengirim respons JSON dengan status yang diberikan
+}
+
+func RedirectParam(c *gin.Context, status int, param, value string) {  // Fungsi untuk redirect ke route yang sama dengan nilai parameter lain
+    location := strings.Replace(c.FullPath(), ":"+param, url.PathEscape(value), 1)  // Template route, misal /api/products/by-slug/:slug
+    if query := c.Request.URL.RawQuery; query != "" {
+        location += "?" + query               // Query string ikut dibawa
+    }
+    c.Redirect(status, location)
+}
 
 
 // {{{ Penjelasan Struktur Response }}}
@@ -34,6 +57,8 @@ File response.go ini berisi implementasi format respons standar untuk API. Berik
     - Success : Boolean yang menunjukkan apakah request berhasil atau gagal
     - Data : Interface{} yang dapat menampung data respons dalam berbagai bentuk (object, array, string, dll.)
     - Error : String yang berisi pesan error jika request gagal
+    - RequestID : ID request yang sama dengan header X-Request-ID dan log server (hanya pada respons error)
+    - Errors : Pesan validasi per field dalam bahasa dari header Accept-Language (hanya pada error validasi)
 3. Tag JSON :
 
     - json:"success" : Field Success selalu ditampilkan dalam respons JSON
@@ -43,9 +68,12 @@ File response.go ini berisi implementasi format respons standar untuk API. Berik
 
     - SuccessResponse : Membuat respons sukses dengan data yang diberikan
     - ErrorResponse : Membuat respons error dengan pesan error yang diberikan
+    - ErrorJSON : Mengirim respons error lengkap dengan request ID dari context request
+    - RedirectParam : Redirect ke route yang sama dengan satu parameter diganti, misal slug lama ke slug terbaru dengan 301
 5. Penggunaan :
 
     - Fungsi-fungsi ini digunakan di handler untuk mengembalikan respons yang konsisten
     - Contoh: c.JSON(http.StatusOK, utils.SuccessResponse(data))
+    - Contoh error: utils.ErrorJSON(c, http.StatusNotFound, "Product not found")
 Format respons yang konsisten ini memudahkan client (frontend) untuk memproses respons API, karena struktur respons selalu sama terlepas dari endpoint yang dipanggil.
 */
\ No newline at end of file
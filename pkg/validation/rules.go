@@ -0,0 +1,105 @@
+package validation                            // Mendefinisikan package validation
+
+import (
+    "reflect"                                 // Package untuk membaca jenis nilai field
+    "rest-api-go/pkg/events"                  // Format pola event untuk aturan eventpattern
+    "rest-api-go/pkg/money"                   // Tipe Money untuk aturan money
+    "rest-api-go/pkg/slug"                    // Format slug untuk aturan slug
+    "strings"                                 // Package untuk manipulasi string
+
+    "github.com/go-playground/validator/v10"  // Library validator
+)
+
+func registerRules(v *validator.Validate) {   // Fungsi untuk mendaftarkan aturan kustom
+    _ = v.RegisterValidation("positive", positive)  // Angka harus lebih besar dari nol
+    _ = v.RegisterValidation("notblank", notBlank)  // String tidak boleh kosong atau hanya spasi
+    _ = v.RegisterValidation("money", validMoney)   // Money dengan mata uang didukung dan jumlah tidak negatif
+    _ = v.RegisterValidation("slug", validSlug)     // Slug URL: huruf kecil, angka, dan tanda hubung
+    _ = v.RegisterValidation("eventpattern", validEventPattern)  // Pola tipe domain event: "*", "product.*", atau "product.created"
+}
+
+func positive(fl validator.FieldLevel) bool { // Aturan: nilai numerik harus > 0
+    field := fl.Field()
+    switch field.Kind() {
+    case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+        return field.Int() > 0
+    case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+        return field.Uint() > 0
+    case reflect.Float32, reflect.Float64:
+        return field.Float() > 0
+    default:
+        return false                          // Tipe non-numerik tidak dianggap positif
+    }
+}
+
+func notBlank(fl validator.FieldLevel) bool { // Aturan: string tidak boleh kosong setelah spasi dibuang
+    field := fl.Field()
+    if field.Kind() != reflect.String {
+        return false                          // Hanya berlaku untuk string
+    }
+    return strings.TrimSpace(field.String()) != ""
+}
+
+func validMoney(fl validator.FieldLevel) bool {  // Aturan: money.Money valid dan tidak negatif
+    m, ok := fl.Field().Interface().(money.Money)
+    if !ok {
+        return false                          // Hanya berlaku untuk money.Money
+    }
+    return m.Valid() && !m.IsNegative()
+}
+
+func validSlug(fl validator.FieldLevel) bool {  // Aturan: string berformat slug
+    field := fl.Field()
+    if field.Kind() != reflect.String {
+        return false                          // Hanya berlaku untuk string
+    }
+    return slug.Valid(field.String())
+}
+
+func validEventPattern(fl validator.FieldLevel) bool {  // Aturan: string berformat pola tipe event
+    field := fl.Field()
+    if field.Kind() != reflect.String {
+        return false                          // Hanya berlaku untuk string
+    }
+    return events.ValidPattern(field.String())
+}
+
+
+// {{{ Penjelasan Aturan Validasi Kustom }}}
+
+/*
+## Penjelasan Detail
+File rules.go ini berisi aturan validasi tambahan yang tidak tersedia di validator bawaan. Berikut penjelasan detailnya:
+
+1. positive :
+
+    - Berlaku untuk semua tipe bilangan bulat dan desimal
+    - Nilai harus lebih besar dari nol (0 dan negatif ditolak)
+    - Contoh: Price float64 `binding:"positive"`
+2. notblank :
+
+    - Berlaku untuk string
+    - Menolak string kosong dan string yang hanya berisi spasi, tab, atau baris baru
+    - Berbeda dengan required yang menerima "   " sebagai nilai terisi
+    - Contoh: Title string `binding:"required,notblank,max=255"`
+3. money :
+
+    - Berlaku untuk money.Money
+    - Mata uang harus didukung (lihat pkg/money/currency.go) dan jumlah tidak boleh negatif, nol diperbolehkan
+    - Contoh: Price money.Money `binding:"money"`
+4. slug :
+
+    - Berlaku untuk string
+    - Hanya huruf kecil, angka, dan tanda hubung tunggal di antaranya (lihat pkg/slug)
+    - Contoh: Slug string `binding:"omitempty,slug"`
+5. eventpattern :
+
+    - Berlaku untuk string
+    - "*", tipe event lengkap, atau awalan diikuti ".*" (lihat events.ValidPattern)
+    - Contoh: Events []string `binding:"required,dive,eventpattern"`
+6. Pendaftaran :
+
+    - registerRules dipanggil sekali saat validator bersama dibuat
+    - Pesan error untuk aturan ini didaftarkan di translate.go
+Aturan baru cukup ditambahkan di file ini beserta terjemahannya di translate.go.
+*/
@@ -0,0 +1,137 @@
+package validation                            // Mendefinisikan package validation
+
+import (
+    "errors"                                  // Package untuk pengecekan tipe error
+    "strconv"                                 // Package untuk parsing bobot q
+    "strings"                                 // Package untuk manipulasi string
+
+    ut "github.com/go-playground/universal-translator"  // Penerjemah pesan validasi
+    "github.com/go-playground/validator/v10"  // Library validator
+)
+
+var customMessages = map[string]map[string]string{  // Pesan untuk aturan kustom per locale
+    LocaleEnglish: {
+        "positive": "{0} must be greater than zero",
+        "notblank": "{0} must not be blank",
+        "money":    "{0} must be a non-negative amount in a supported currency",
+        "slug":     "{0} may only contain lowercase letters, digits and single hyphens",
+        "eventpattern": "{0} must be \"*\", an event type such as product.created, or a prefix such as product.*",
+        "http_url": "{0} must be an http or https URL",
+    },
+    LocaleIndonesian: {
+        "positive": "{0} harus lebih besar dari nol",
+        "notblank": "{0} tidak boleh kosong",
+        "money":    "{0} harus berupa jumlah tidak negatif dengan mata uang yang didukung",
+        "slug":     "{0} hanya boleh berisi huruf kecil, angka, dan tanda hubung tunggal",
+        "eventpattern": "{0} harus berupa \"*\", tipe event seperti product.created, atau awalan seperti product.*",
+        "http_url": "{0} harus berupa URL http atau https",
+    },
+}
+
+func registerTranslations(v *validator.Validate, translators ...ut.Translator) {  // Fungsi untuk mendaftarkan pesan aturan kustom
+    for _, trans := range translators {       // Untuk setiap locale
+        messages := customMessages[trans.Locale()]
+        for tag, message := range messages {  // Untuk setiap aturan kustom
+            tag, message := tag, message      // Salin variabel untuk closure
+            _ = v.RegisterTranslation(tag, trans,
+                func(t ut.Translator) error { return t.Add(tag, message, true) },  // Daftarkan template pesan
+                func(t ut.Translator, fe validator.FieldError) string {  // Isi {0} dengan nama field
+                    msg, err := t.T(tag, fe.Field())
+                    if err != nil {
+                        return fe.Error()     // Fallback ke pesan bawaan validator
+                    }
+                    return msg
+                },
+            )
+        }
+    }
+}
+
+// Translate - mengubah error validasi menjadi pesan per field
+func Translate(err error, locale string) (map[string]string, bool) {  // Mengembalikan false jika err bukan error validasi
+    var verrs validator.ValidationErrors
+    if !errors.As(err, &verrs) {
+        return nil, false                     // Bukan error validasi
+    }
+
+    Validator()                               // Pastikan validator dan translator sudah dibuat
+    trans, _ := translator.GetTranslator(locale)  // Ambil penerjemah (fallback ke bahasa Inggris)
+
+    fields := make(map[string]string, len(verrs))  // Pesan per field
+    for _, fe := range verrs {
+        key := fieldPath(fe.Namespace())      // Path field dalam format JSON (misal items[0].quantity)
+        if _, exists := fields[key]; !exists {  // Simpan pesan pertama untuk setiap field
+            fields[key] = fe.Translate(trans)
+        }
+    }
+    return fields, true
+}
+
+func fieldPath(namespace string) string {     // Fungsi untuk membuang nama struct root dari namespace
+    if i := strings.Index(namespace, "."); i >= 0 {  // Namespace berbentuk Product.title
+        return namespace[i+1:]
+    }
+    return namespace
+}
+
+// Message - pesan ringkas untuk error validasi sesuai locale
+func Message(locale string) string {          // Dipakai sebagai field error di respons
+    if locale == LocaleIndonesian {
+        return "validasi gagal"
+    }
+    return "validation failed"
+}
+
+// LocaleFromHeader - memilih locale dari header Accept-Language
+func LocaleFromHeader(header string) string { // Contoh header: "id-ID,id;q=0.9,en;q=0.8"
+    best, bestQ := LocaleEnglish, -1.0        // Default bahasa Inggris
+    for _, part := range strings.Split(header, ",") {  // Periksa setiap bahasa
+        tag, q := parseLanguage(part)
+        base := strings.ToLower(strings.SplitN(tag, "-", 2)[0])  // id-ID menjadi id
+        if (base == LocaleEnglish || base == LocaleIndonesian) && q > bestQ {  // Hanya locale yang didukung
+            best, bestQ = base, q
+        }
+    }
+    return best
+}
+
+func parseLanguage(part string) (string, float64) {  // Fungsi untuk memisahkan tag bahasa dan bobot q
+    pieces := strings.Split(strings.TrimSpace(part), ";")
+    q := 1.0                                  // Bobot default 1
+    for _, p := range pieces[1:] {
+        p = strings.TrimSpace(p)
+        if strings.HasPrefix(p, "q=") {
+            if value, err := strconv.ParseFloat(strings.TrimPrefix(p, "q="), 64); err == nil {
+                q = value
+            }
+        }
+    }
+    return strings.TrimSpace(pieces[0]), q
+}
+
+
+
+// {{{ Penjelasan Terjemahan Validasi }}}
+
+/*
+## Penjelasan Detail
+File translate.go ini berisi terjemahan pesan validasi ke bahasa yang dipilih client. Berikut penjelasan detailnya:
+
+1. Tujuan : Mengganti pesan seperti "Key: 'Product.Title' Error:Field validation for 'Title' failed on the 'max' tag" dengan pesan yang mudah dibaca per field.
+2. Locale yang Didukung :
+
+    - en (default) dan id
+    - Dipilih dari header Accept-Language dengan memperhatikan bobot q
+3. Translate :
+
+    - Mengubah validator.ValidationErrors menjadi map nama field JSON ke pesan
+    - Contoh en: {"title": "title is a required field", "price": "price must be greater than zero"}
+    - Contoh id: {"title": "title wajib diisi", "price": "price harus lebih besar dari nol"}
+4. Pesan Aturan Kustom :
+
+    - customMessages berisi template untuk positive, notblank, money, slug, eventpattern, dan http_url (belum diterjemahkan validator) di setiap locale
+5. Penggunaan :
+
+    - utils.HandleError memanggil Translate dan mengirim pesan per field di field errors pada respons
+Dengan cara ini, frontend dapat menampilkan pesan error langsung di samping input yang salah.
+*/
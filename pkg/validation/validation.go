@@ -0,0 +1,148 @@
+package validation                            // Mendefinisikan package validation
+
+import (
+    "errors"                                  // Package untuk pengecekan tipe error
+    "reflect"                                 // Package untuk membaca tipe dan nilai struct
+    "strings"                                 // Package untuk manipulasi string
+    "sync"                                    // Package untuk inisialisasi satu kali
+
+    "github.com/gin-gonic/gin/binding"        // Interface validator milik Gin
+    "github.com/go-playground/locales/en"     // Data locale bahasa Inggris
+    "github.com/go-playground/locales/id"     // Data locale bahasa Indonesia
+    ut "github.com/go-playground/universal-translator"  // Penerjemah pesan validasi
+    "github.com/go-playground/validator/v10"  // Library validator
+    enTranslations "github.com/go-playground/validator/v10/translations/en"  // Pesan bawaan bahasa Inggris
+    idTranslations "github.com/go-playground/validator/v10/translations/id"  // Pesan bawaan bahasa Indonesia
+)
+
+const (
+    LocaleEnglish    = "en"                   // Kode locale bahasa Inggris (default)
+    LocaleIndonesian = "id"                   // Kode locale bahasa Indonesia
+)
+
+var (
+    once       sync.Once                      // Memastikan validator hanya dibuat sekali
+    validate   *validator.Validate            // Instance validator yang dipakai bersama seluruh aplikasi
+    translator *ut.UniversalTranslator        // Penerjemah untuk semua locale yang didukung
+)
+
+// Validator - mengembalikan instance validator bersama
+func Validator() *validator.Validate {        // Fungsi untuk mendapatkan validator yang sudah dikonfigurasi
+    once.Do(setup)                            // Buat dan konfigurasi validator pada pemanggilan pertama
+    return validate
+}
+
+// Struct - memvalidasi struct berdasarkan tag binding
+func Struct(s interface{}) error {            // Fungsi yang dipakai method Validate() pada entity
+    return Validator().Struct(s)              // Validasi dengan validator bersama
+}
+
+func setup() {                                // Fungsi untuk membuat dan mengonfigurasi validator
+    validate = validator.New(validator.WithRequiredStructEnabled())  // Validator baru, required juga berlaku untuk struct
+    validate.SetTagName("binding")            // Membaca tag binding, sama seperti validasi bawaan Gin
+    validate.RegisterTagNameFunc(jsonFieldName)  // Nama field di pesan error memakai nama JSON (category_id, bukan CategoryID)
+
+    registerRules(validate)                   // Daftarkan aturan kustom (positive, notblank, ...)
+
+    english := en.New()                       // Locale bahasa Inggris
+    translator = ut.New(english, english, id.New())  // Inggris sebagai fallback, Indonesia sebagai tambahan
+
+    enTrans, _ := translator.GetTranslator(LocaleEnglish)  // Penerjemah bahasa Inggris
+    idTrans, _ := translator.GetTranslator(LocaleIndonesian)  // Penerjemah bahasa Indonesia
+    _ = enTranslations.RegisterDefaultTranslations(validate, enTrans)  // Pesan bawaan untuk tag standar (required, max, email, ...)
+    _ = idTranslations.RegisterDefaultTranslations(validate, idTrans)
+    registerTranslations(validate, enTrans, idTrans)  // Pesan untuk aturan kustom
+}
+
+func jsonFieldName(field reflect.StructField) string {  // Fungsi untuk mengambil nama field dari tag json
+    name := strings.SplitN(field.Tag.Get("json"), ",", 2)[0]  // Bagian sebelum koma (abaikan omitempty)
+    if name == "-" {
+        return ""                             // Field tidak muncul di JSON
+    }
+    if name == "" {
+        return field.Name                     // Tanpa tag json, pakai nama field Go
+    }
+    return name
+}
+
+// GinValidator - adapter agar Gin memakai validator bersama yang sama
+type GinValidator struct{}                    // Implementasi binding.StructValidator
+
+func (GinValidator) ValidateStruct(obj interface{}) error {  // Dipanggil Gin dan utils.BindJSON setelah decoding
+    if obj == nil {
+        return nil
+    }
+    value := reflect.ValueOf(obj)
+    for value.Kind() == reflect.Ptr {         // Ikuti pointer sampai nilai sebenarnya
+        if value.IsNil() {
+            return nil
+        }
+        value = value.Elem()
+    }
+
+    switch value.Kind() {
+    case reflect.Struct:
+        return Validator().Struct(obj)        // Validasi satu struct
+    case reflect.Slice, reflect.Array:        // Validasi setiap elemen (misal request bulk)
+        var all validator.ValidationErrors
+        for i := 0; i < value.Len(); i++ {
+            if err := (GinValidator{}).ValidateStruct(value.Index(i).Interface()); err != nil {
+                var verrs validator.ValidationErrors
+                if !errors.As(err, &verrs) {
+                    return err
+                }
+                all = append(all, verrs...)
+            }
+        }
+        if len(all) > 0 {
+            return all
+        }
+        return nil
+    default:
+        return nil                            // Tipe lain tidak memiliki tag validasi
+    }
+}
+
+func (GinValidator) Engine() interface{} {    // Mengembalikan engine validator (dipakai Gin untuk registrasi kustom)
+    return Validator()
+}
+
+// Register - menjadikan validator bersama sebagai validator Gin
+func Register() {                             // Dipanggil sekali di main.go
+    binding.Validator = GinValidator{}        // Gin (ShouldBind*) dan utils.BindJSON memakai validator ini
+}
+
+var _ binding.StructValidator = GinValidator{}  // Memastikan GinValidator memenuhi interface saat compile
+
+
+
+// {{{ Penjelasan Package Validation }}}
+
+/*
+## Penjelasan Detail
+File validation.go ini berisi satu instance validator yang dipakai bersama oleh handler (binding) dan service (Validate). Berikut penjelasan detailnya:
+
+1. Masalah Sebelumnya :
+
+    - Setiap pemanggilan Validate() membuat validator.New() baru (mahal karena cache struct hilang)
+    - Entity hanya memiliki tag binding, sedangkan validator.New() membaca tag validate, sehingga validasi di service tidak melakukan apa pun
+2. Validator Bersama :
+
+    - Dibuat sekali dengan sync.Once
+    - SetTagName("binding") sehingga tag yang sama berlaku di binding Gin dan di service
+    - RegisterTagNameFunc membuat nama field di error memakai nama JSON
+3. GinValidator :
+
+    - Mengimplementasikan binding.StructValidator
+    - Register() memasangnya sebagai binding.Validator sehingga Gin dan utils.BindJSON memakai aturan yang sama
+    - Slice divalidasi per elemen
+4. Aturan Kustom dan Terjemahan :
+
+    - Didefinisikan di rules.go dan translate.go
+    - Pesan tersedia dalam bahasa Inggris (default) dan Indonesia
+5. Penggunaan :
+
+    - Entity: func (p *Product) Validate() error { return validation.Struct(p) }
+    - main.go: validation.Register()
+Dengan satu sumber aturan validasi, data yang lolos dari handler pasti juga lolos dari service, dan sebaliknya.
+*/
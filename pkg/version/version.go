@@ -0,0 +1,59 @@
+package version                               // Mendefinisikan package version
+
+import (
+    "net/http"                                // Package untuk konstanta HTTP
+    "rest-api-go/pkg/utils"                   // Mengimpor utilitas aplikasi
+    "runtime"                                 // Package untuk versi Go
+
+    "github.com/gin-gonic/gin"                // Mengimpor framework web Gin
+)
+
+// Nilai berikut diisi saat build dengan -ldflags, contoh:
+// go build -ldflags "-X rest-api-go/pkg/version.Version=v1.2.0 -X rest-api-go/pkg/version.Commit=$(git rev-parse --short HEAD) -X rest-api-go/pkg/version.BuildTime=$(date -u +%Y-%m-%dT%H:%M:%SZ)" ./cmd/main
+var (
+    Version   = "dev"                         // Versi rilis aplikasi
+    Commit    = "unknown"                     // Hash commit git
+    BuildTime = "unknown"                     // Waktu build (UTC, RFC 3339)
+)
+
+type Info struct {                            // Mendefinisikan struct informasi build
+    Version   string `json:"version"`         // Versi rilis
+    Commit    string `json:"commit"`          // Hash commit git
+    BuildTime string `json:"build_time"`      // Waktu build
+    GoVersion string `json:"go_version"`      // Versi Go yang dipakai untuk build
+}
+
+func Get() Info {                             // Fungsi untuk mendapatkan informasi build
+    return Info{                              // Mengembalikan struct Info
+        Version:   Version,
+        Commit:    Commit,
+        BuildTime: BuildTime,
+        GoVersion: runtime.Version(),
+    }
+}
+
+func Handler(c *gin.Context) {                // Handler untuk GET /version
+    c.JSON(http.StatusOK, utils.SuccessResponse(Get()))  // Respons sukses dengan informasi build
+}
+
+
+
+// {{{ Penjelasan Package Version }}}
+
+/*
+## Penjelasan Detail
+File version.go ini berisi informasi build aplikasi. Berikut penjelasan detailnya:
+
+1. Tujuan : Mengetahui versi dan commit mana yang sedang berjalan di sebuah environment.
+2. Variabel Build :
+
+    - Version, Commit, dan BuildTime bernilai default "dev"/"unknown"
+    - Nilai sebenarnya disuntikkan saat build dengan flag -ldflags "-X rest-api-go/pkg/version.Version=..."
+3. Endpoint :
+
+    - GET /version mengembalikan version, commit, build_time, dan go_version dalam format utils.Response
+4. Penggunaan :
+
+    - Didaftarkan di main.go dengan r.GET("/version", version.Handler)
+Dengan endpoint ini, tim operasional dapat memastikan deployment terbaru sudah benar-benar berjalan.
+*/